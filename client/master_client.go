@@ -35,7 +35,17 @@ type MasterClient interface {
 	) (resp *pb.ExecWorkloadResponse, err error)
 	SubmitJob(ctx context.Context, req *pb.SubmitJobRequest) (resp *pb.SubmitJobResponse, err error)
 	PauseJob(ctx context.Context, req *pb.PauseJobRequest) (resp *pb.PauseJobResponse, err error)
+	ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (resp *pb.ResumeJobResponse, err error)
 	CancelJob(ctx context.Context, req *pb.CancelJobRequest) (resp *pb.CancelJobResponse, err error)
+	QueryJob(ctx context.Context, req *pb.QueryJobRequest) (resp *pb.QueryJobResponse, err error)
+	ListJobs(ctx context.Context, req *pb.ListJobsRequest) (resp *pb.ListJobsResponse, err error)
+	UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) (resp *pb.UpdateJobConfigResponse, err error)
+	CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (resp *pb.CreateScheduleResponse, err error)
+	DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (resp *pb.DeleteScheduleResponse, err error)
+	ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (resp *pb.ListSchedulesResponse, err error)
+	CordonExecutor(ctx context.Context, req *pb.CordonExecutorRequest) (resp *pb.CordonExecutorResponse, err error)
+	DrainExecutor(ctx context.Context, req *pb.DrainExecutorRequest) (resp *pb.DrainExecutorResponse, err error)
+	QueryExecutors(ctx context.Context, req *pb.QueryExecutorsRequest) (resp *pb.QueryExecutorsResponse, err error)
 	QueryMetaStore(
 		ctx context.Context, req *pb.QueryMetaStoreRequest, timeout time.Duration,
 	) (resp *pb.QueryMetaStoreResponse, err error)
@@ -44,6 +54,11 @@ type MasterClient interface {
 		req *pb.TaskSchedulerRequest,
 		timeout time.Duration,
 	) (resp *pb.TaskSchedulerResponse, err error)
+	ReleaseResource(
+		ctx context.Context,
+		req *pb.ReleaseResourceRequest,
+		timeout time.Duration,
+	) (resp *pb.ReleaseResourceResponse, err error)
 	Close() (err error)
 	GetLeaderClient() pb.MasterClient
 }
@@ -194,11 +209,61 @@ func (c *MasterClientImpl) PauseJob(ctx context.Context, req *pb.PauseJobRequest
 	return
 }
 
+func (c *MasterClientImpl) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (resp *pb.ResumeJobResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
 func (c *MasterClientImpl) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (resp *pb.CancelJobResponse, err error) {
 	err = c.rpcWrap(ctx, req, &resp)
 	return
 }
 
+func (c *MasterClientImpl) QueryJob(ctx context.Context, req *pb.QueryJobRequest) (resp *pb.QueryJobResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (resp *pb.ListJobsResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) (resp *pb.UpdateJobConfigResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (resp *pb.CreateScheduleResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (resp *pb.DeleteScheduleResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (resp *pb.ListSchedulesResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) CordonExecutor(ctx context.Context, req *pb.CordonExecutorRequest) (resp *pb.CordonExecutorResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) DrainExecutor(ctx context.Context, req *pb.DrainExecutorRequest) (resp *pb.DrainExecutorResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
+func (c *MasterClientImpl) QueryExecutors(ctx context.Context, req *pb.QueryExecutorsRequest) (resp *pb.QueryExecutorsResponse, err error) {
+	err = c.rpcWrap(ctx, req, &resp)
+	return
+}
+
 func (c *MasterClientImpl) QueryMetaStore(
 	ctx context.Context, req *pb.QueryMetaStoreRequest, timeout time.Duration,
 ) (resp *pb.QueryMetaStoreResponse, err error) {
@@ -221,6 +286,19 @@ func (c *MasterClientImpl) ScheduleTask(
 	return
 }
 
+// ReleaseResource sends ReleaseResourceRequest to server master to release
+// the resource reserved for the given workers
+func (c *MasterClientImpl) ReleaseResource(
+	ctx context.Context,
+	req *pb.ReleaseResourceRequest,
+	timeout time.Duration,
+) (resp *pb.ReleaseResourceResponse, err error) {
+	ctx1, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err = c.rpcWrap(ctx1, req, &resp)
+	return
+}
+
 func (c *MasterClientImpl) ReportExecutorWorkload(
 	ctx context.Context,
 	req *pb.ExecWorkloadRequest,
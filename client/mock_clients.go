@@ -82,6 +82,14 @@ func (c *MockServerMasterClient) PauseJob(ctx context.Context, req *pb.PauseJobR
 	return args.Get(0).(*pb.PauseJobResponse), args.Error(1)
 }
 
+func (c *MockServerMasterClient) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (resp *pb.ResumeJobResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.ResumeJobResponse), args.Error(1)
+}
+
 func (c *MockServerMasterClient) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (resp *pb.CancelJobResponse, err error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -90,6 +98,78 @@ func (c *MockServerMasterClient) CancelJob(ctx context.Context, req *pb.CancelJo
 	return args.Get(0).(*pb.CancelJobResponse), args.Error(1)
 }
 
+func (c *MockServerMasterClient) QueryJob(ctx context.Context, req *pb.QueryJobRequest) (resp *pb.QueryJobResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.QueryJobResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (resp *pb.ListJobsResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.ListJobsResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) (resp *pb.UpdateJobConfigResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.UpdateJobConfigResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (resp *pb.CreateScheduleResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.CreateScheduleResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (resp *pb.DeleteScheduleResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.DeleteScheduleResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (resp *pb.ListSchedulesResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.ListSchedulesResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) CordonExecutor(ctx context.Context, req *pb.CordonExecutorRequest) (resp *pb.CordonExecutorResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.CordonExecutorResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) DrainExecutor(ctx context.Context, req *pb.DrainExecutorRequest) (resp *pb.DrainExecutorResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.DrainExecutorResponse), args.Error(1)
+}
+
+func (c *MockServerMasterClient) QueryExecutors(ctx context.Context, req *pb.QueryExecutorsRequest) (resp *pb.QueryExecutorsResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Mock.Called(ctx, req)
+	return args.Get(0).(*pb.QueryExecutorsResponse), args.Error(1)
+}
+
 func (c *MockServerMasterClient) QueryMetaStore(
 	ctx context.Context,
 	req *pb.QueryMetaStoreRequest,
@@ -136,3 +216,15 @@ func (c *MockServerMasterClient) ScheduleTask(
 	args := c.Called(ctx, req, timeout)
 	return args.Get(0).(*pb.TaskSchedulerResponse), args.Error(1)
 }
+
+func (c *MockServerMasterClient) ReleaseResource(
+	ctx context.Context,
+	req *pb.ReleaseResourceRequest,
+	timeout time.Duration,
+) (resp *pb.ReleaseResourceResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args := c.Called(ctx, req, timeout)
+	return args.Get(0).(*pb.ReleaseResourceResponse), args.Error(1)
+}
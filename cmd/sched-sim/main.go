@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hanfei1991/microcosm/servermaster/resource"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+// sched-sim replays a trace of ScheduleTask requests in a simulated cluster
+// with the resource manager of the server master, and prints the placement,
+// utilization and rejection statistics of each scheduling strategy. It needs
+// neither etcd nor network, see example.json for the format of the scenario.
+func main() {
+	fs := flag.NewFlagSet("sched-sim", flag.ExitOnError)
+	scenarioFile := fs.String("scenario", "", "path to the scenario file")
+	strategies := fs.String("strategy",
+		strings.Join([]string{resource.StrategySpread, resource.StrategyBinPack, resource.StrategyRandom}, ","),
+		"comma separated scheduling strategies to compare")
+	verbose := fs.Bool("v", false, "print the placement and the rejection of every task")
+	logLevel := fs.String("L", "error", "log level: debug, info, warn, error, fatal")
+	_ = fs.Parse(os.Args[1:])
+	if *scenarioFile == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	if err := log.InitLogger(&log.Config{Level: *logLevel}); err != nil {
+		fmt.Fprintf(os.Stderr, "err: %v\n", err)
+		os.Exit(1)
+	}
+	scenario, err := loadScenario(*scenarioFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "err: %v\n", err)
+		os.Exit(1)
+	}
+	for i, strategy := range strings.Split(*strategies, ",") {
+		sim, err := newSimulator(strings.TrimSpace(strategy), scenario)
+		if err == nil {
+			err = sim.run(scenario.Trace)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "err: %v\n", err)
+			os.Exit(1)
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		sim.report(os.Stdout, *verbose)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
+	"github.com/pingcap/errors"
+)
+
+// Scenario is a simulated cluster and the trace replayed in it.
+type Scenario struct {
+	Executors []*ExecutorSpec `json:"executors"`
+	Trace     []*Event        `json:"trace"`
+}
+
+// ExecutorSpec describes a group of identical executors. If Count is larger
+// than 1, the executors are named by ID with an index suffix.
+type ExecutorSpec struct {
+	ID          string            `json:"id"`
+	Addr        string            `json:"addr"`
+	Capacity    model.Resource    `json:"capacity"`
+	Labels      map[string]string `json:"labels"`
+	WorkerTypes []int64           `json:"worker_types"`
+	Count       int               `json:"count"`
+}
+
+// Event is an entry of the trace, it's either a ScheduleTask request or the
+// exit of some workers.
+type Event struct {
+	Request *pb.TaskSchedulerRequest `json:"request,omitempty"`
+	Release []string                 `json:"release,omitempty"`
+}
+
+// loadScenario loads a scenario from a json file.
+func loadScenario(path string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	scenario := &Scenario{}
+	if err := json.Unmarshal(data, scenario); err != nil {
+		return nil, errors.Annotatef(err, "parse scenario %s", path)
+	}
+	if err := scenario.adjust(); err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
+
+// defaultMasterID is the master of the tasks that don't specify one, the
+// workers without a master can't be preempted.
+const defaultMasterID = "master"
+
+// adjust validates the scenario, and fills the task IDs, worker IDs and
+// master IDs that are not specified.
+func (s *Scenario) adjust() error {
+	if len(s.Executors) == 0 {
+		return errors.New("no executor in the scenario")
+	}
+	for _, spec := range s.Executors {
+		if spec.ID == "" {
+			return errors.New("executor id is empty")
+		}
+	}
+	for i, event := range s.Trace {
+		if (event.Request == nil) == (len(event.Release) == 0) {
+			return errors.Errorf("event %d should be either a request or a release", i)
+		}
+		for j, task := range event.Request.GetTasks() {
+			// The tasks are identified by the index in the response.
+			task.Task = &pb.TaskRequest{Id: int64(j)}
+			if task.WorkerId == "" {
+				task.WorkerId = fmt.Sprintf("worker-%d-%d", i, j)
+			}
+			if task.MasterId == "" {
+				task.MasterId = defaultMasterID
+			}
+			if name := task.GetStrategy(); name != "" {
+				if _, err := resource.NewSchedulingStrategy(name); err != nil {
+					return errors.Annotatef(err, "event %d", i)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// executors returns the resources of all of the executors, ordered as they
+// are described.
+func (s *Scenario) executors() []*resource.ExecutorResource {
+	ret := make([]*resource.ExecutorResource, 0, len(s.Executors))
+	for _, spec := range s.Executors {
+		if spec.Count <= 1 {
+			ret = append(ret, spec.newExecutor(spec.ID))
+			continue
+		}
+		for i := 0; i < spec.Count; i++ {
+			ret = append(ret, spec.newExecutor(fmt.Sprintf("%s-%d", spec.ID, i)))
+		}
+	}
+	return ret
+}
+
+func (spec *ExecutorSpec) newExecutor(id string) *resource.ExecutorResource {
+	return &resource.ExecutorResource{
+		ID:          model.ExecutorID(id),
+		Status:      model.Running,
+		Capacity:    spec.Capacity,
+		Addr:        spec.Addr,
+		Labels:      spec.Labels,
+		WorkerTypes: spec.WorkerTypes,
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
+	"github.com/pingcap/errors"
+)
+
+// placement is a worker placed in an executor.
+type placement struct {
+	executorID model.ExecutorID
+	masterID   string
+	resource   model.Resource
+}
+
+// simulator replays a trace in a resource manager. The executors report the
+// workers placed in them after every event, as if the workers were started
+// right after they're scheduled, and exited right after they're released or
+// preempted. There is no time in the simulation, so the gang requests don't
+// wait for resource.
+type simulator struct {
+	strategy  string
+	mgr       resource.RescMgr
+	executors []*resource.ExecutorResource
+	workers   map[string]*placement
+
+	requests         int
+	rejectedRequests int
+	placedTasks      int
+	rejectedTasks    int
+	// unsatisfiable is the rejected tasks that don't fit any executor even
+	// if the cluster is empty.
+	unsatisfiable   int
+	preemptedTasks  int
+	peakUtilization float64
+	// logs records the placements and the rejections of the tasks.
+	logs []string
+}
+
+func newSimulator(strategy string, scenario *Scenario) (*simulator, error) {
+	st, err := resource.NewSchedulingStrategy(strategy)
+	if err != nil {
+		return nil, err
+	}
+	s := &simulator{
+		strategy:  strategy,
+		mgr:       resource.NewCapRescMgr(st),
+		executors: scenario.executors(),
+		workers:   make(map[string]*placement),
+	}
+	for _, exec := range s.executors {
+		s.mgr.Register(exec.ID, exec.Addr, exec.Capacity, exec.Labels, exec.WorkerTypes)
+	}
+	if err := s.heartbeat(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// run replays the trace.
+func (s *simulator) run(trace []*Event) error {
+	for i, event := range trace {
+		if event.Request != nil {
+			s.schedule(i, event.Request)
+		}
+		for _, workerID := range event.Release {
+			delete(s.workers, workerID)
+		}
+		if err := s.heartbeat(); err != nil {
+			return err
+		}
+		if utilization := s.utilization(); utilization > s.peakUtilization {
+			s.peakUtilization = utilization
+		}
+	}
+	return nil
+}
+
+// schedule schedules the tasks as the server master does, the running
+// workers of lower priority are preempted if the tasks can't be allocated.
+func (s *simulator) schedule(event int, req *pb.TaskSchedulerRequest) {
+	s.requests++
+	tasks := req.GetTasks()
+	var (
+		success bool
+		resp    *pb.TaskSchedulerResponse
+	)
+	if req.GetGang() {
+		success, resp = s.mgr.AllocateGang(context.Background(), tasks, 0)
+	} else {
+		success, resp = s.mgr.Allocate(tasks)
+	}
+	s.place(event, tasks, resp)
+	if success {
+		return
+	}
+
+	remaining := make([]*pb.ScheduleTask, 0, len(tasks))
+	for _, task := range tasks {
+		if _, ok := resp.GetSchedule()[task.GetTask().Id]; !ok {
+			remaining = append(remaining, task)
+		}
+	}
+	if victims, ok := s.mgr.Preempt(remaining); ok {
+		for _, victim := range victims {
+			delete(s.workers, victim.WorkerID)
+			s.preemptedTasks++
+			s.logs = append(s.logs, fmt.Sprintf("event %d: worker %s in %s is preempted by %s",
+				event, victim.WorkerID, victim.ExecutorID, victim.PreemptedBy))
+		}
+		// The error is ignored since all of the executors are registered.
+		_ = s.heartbeat()
+		success, resp = s.mgr.AllocateGang(context.Background(), remaining, 0)
+		if success {
+			s.place(event, remaining, resp)
+			return
+		}
+	}
+
+	s.rejectedRequests++
+	for _, task := range remaining {
+		s.rejectedTasks++
+		reason := "not enough resource"
+		if !s.satisfiable(task) {
+			s.unsatisfiable++
+			reason = "no executor fits"
+		}
+		s.logs = append(s.logs, fmt.Sprintf("event %d: worker %s is rejected, %s",
+			event, task.GetWorkerId(), reason))
+	}
+}
+
+// place records the tasks allocated in the response.
+func (s *simulator) place(event int, tasks []*pb.ScheduleTask, resp *pb.TaskSchedulerResponse) {
+	for _, task := range tasks {
+		result, ok := resp.GetSchedule()[task.GetTask().Id]
+		if !ok {
+			continue
+		}
+		s.placedTasks++
+		s.workers[task.GetWorkerId()] = &placement{
+			executorID: model.ExecutorID(result.GetExecutorId()),
+			masterID:   task.GetMasterId(),
+			resource:   model.NewResourceFromPB(task.GetResource(), task.GetCost()),
+		}
+		s.logs = append(s.logs, fmt.Sprintf("event %d: worker %s is placed in %s",
+			event, task.GetWorkerId(), result.GetExecutorId()))
+	}
+}
+
+// satisfiable returns whether the task fits any executor of the cluster if
+// the cluster is empty. The affinity of the task is not considered.
+func (s *simulator) satisfiable(task *pb.ScheduleTask) bool {
+	cost := model.NewResourceFromPB(task.GetResource(), task.GetCost())
+	for _, exec := range s.executors {
+		if !exec.MatchLabels(task.GetLabelSelector()) || !exec.SupportsWorkerType(task.GetWorkerType()) {
+			continue
+		}
+		if task.GetLocationRequired() && !exec.MatchLocation(task.GetPreferredLocation()) {
+			continue
+		}
+		if cost.Fits(exec.Capacity) {
+			return true
+		}
+	}
+	return false
+}
+
+// heartbeat reports the workers placed in each executor to the resource
+// manager, the resource of the exited workers is released.
+func (s *simulator) heartbeat() error {
+	workers := make(map[model.ExecutorID][]*pb.RunningWorker, len(s.executors))
+	for workerID, p := range s.workers {
+		workers[p.executorID] = append(workers[p.executorID], &pb.RunningWorker{
+			WorkerId: workerID,
+			MasterId: p.masterID,
+			Resource: p.resource.ToPB(),
+		})
+	}
+	for _, exec := range s.executors {
+		if err := s.mgr.Update(exec.ID, model.Resource{}, workers[exec.ID], model.Running); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// reserved returns the resource reserved in each executor and the number of
+// workers placed in it.
+func (s *simulator) reserved() (map[model.ExecutorID]model.Resource, map[model.ExecutorID]int) {
+	reserved := make(map[model.ExecutorID]model.Resource, len(s.executors))
+	count := make(map[model.ExecutorID]int, len(s.executors))
+	for _, p := range s.workers {
+		reserved[p.executorID] = reserved[p.executorID].Add(p.resource)
+		count[p.executorID]++
+	}
+	return reserved, count
+}
+
+// utilization returns the ratio of the reserved cpu to the cpu capacity of
+// the cluster.
+func (s *simulator) utilization() float64 {
+	reserved, _ := s.reserved()
+	var used, capacity model.RescUnit
+	for _, exec := range s.executors {
+		used += reserved[exec.ID].CPU
+		capacity += exec.Capacity.CPU
+	}
+	return ratio(int64(used), int64(capacity))
+}
+
+// report prints the statistics of the simulation, the placements and the
+// rejections of the tasks are printed if verbose is set.
+func (s *simulator) report(w io.Writer, verbose bool) {
+	reserved, count := s.reserved()
+	inUse := 0
+	for _, exec := range s.executors {
+		if count[exec.ID] > 0 {
+			inUse++
+		}
+	}
+
+	fmt.Fprintf(w, "strategy: %s\n", s.strategy)
+	fmt.Fprintf(w, "  requests: %d, rejected: %d\n", s.requests, s.rejectedRequests)
+	fmt.Fprintf(w, "  tasks placed: %d, rejected: %d (no executor fits: %d), preempted: %d\n",
+		s.placedTasks, s.rejectedTasks, s.unsatisfiable, s.preemptedTasks)
+	fmt.Fprintf(w, "  cpu utilization: %.1f%% (peak %.1f%%), executors in use: %d/%d\n",
+		s.utilization()*100, s.peakUtilization*100, inUse, len(s.executors))
+	if verbose {
+		for _, line := range s.logs {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  EXECUTOR\tCPU\tMEMORY\tDISK\tWORKERS")
+	for _, exec := range s.executors {
+		r := reserved[exec.ID]
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%d\n", exec.ID,
+			usage(int64(r.CPU), int64(exec.Capacity.CPU)),
+			usage(r.Memory, exec.Capacity.Memory),
+			usage(r.Disk, exec.Capacity.Disk),
+			count[exec.ID])
+	}
+	tw.Flush()
+}
+
+// usage formats the reserved resource in a dimension, it's "-" if the
+// dimension is not limited.
+func usage(used, capacity int64) string {
+	if capacity <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d (%.1f%%)", used, capacity, ratio(used, capacity)*100)
+}
+
+func ratio(used, capacity int64) float64 {
+	if capacity <= 0 {
+		return 0
+	}
+	return float64(used) / float64(capacity)
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hanfei1991/microcosm/servermaster/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulator(t *testing.T) {
+	t.Parallel()
+
+	scenario, err := loadScenario("example.json")
+	require.NoError(t, err)
+	require.Len(t, scenario.executors(), 4)
+
+	sim, err := newSimulator(resource.StrategySpread, scenario)
+	require.NoError(t, err)
+	require.NoError(t, sim.run(scenario.Trace))
+	require.Equal(t, 5, sim.requests)
+	require.Equal(t, 2, sim.rejectedRequests)
+	require.Equal(t, 8, sim.placedTasks)
+	require.Equal(t, 2, sim.rejectedTasks)
+	require.Equal(t, 1, sim.unsatisfiable)
+	require.Equal(t, 1, sim.preemptedTasks)
+	// the job masters are spread
+	require.Equal(t, "hdd-0", string(sim.workers["job-master-1"].executorID))
+	require.Equal(t, "hdd-1", string(sim.workers["job-master-2"].executorID))
+
+	// bin-pack packs the job masters into the same executor
+	binPack, err := newSimulator(resource.StrategyBinPack, scenario)
+	require.NoError(t, err)
+	require.NoError(t, binPack.run(scenario.Trace))
+	require.Equal(t, "hdd-0", string(binPack.workers["job-master-2"].executorID))
+
+	var buf bytes.Buffer
+	sim.report(&buf, true)
+	require.Contains(t, buf.String(), "strategy: spread")
+	require.Contains(t, buf.String(), "worker gpu-1 is rejected, no executor fits")
+
+	_, err = newSimulator("round-robin", scenario)
+	require.Error(t, err)
+}
+
+func TestScenarioAdjust(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, (&Scenario{}).adjust())
+	require.Error(t, (&Scenario{
+		Executors: []*ExecutorSpec{{ID: "executor"}},
+		Trace:     []*Event{{}},
+	}).adjust())
+}
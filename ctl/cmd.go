@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/hanfei1991/microcosm/pb"
 	"github.com/hanfei1991/microcosm/pkg/errors"
@@ -23,6 +24,9 @@ func NewRunFake() *cobra.Command {
 	cmd.Flags().StringP("executor-id", "", "", "the targeted executor id")
 	cmd.Flags().StringP("job-type", "", "", "job type")
 	cmd.Flags().StringP("job-config", "", "", "config file for the demo job")
+	cmd.Flags().StringP("job-name", "", "", "the unique name of the job, a random job id is generated if not provided")
+	cmd.Flags().StringSliceP("depends-on", "", nil, "the ids of the jobs that must be finished before the job is started")
+	cmd.Flags().Int32P("priority", "", 0, "the priority of the job, a job with higher priority is admitted first when the cluster resource is not enough")
 	return cmd
 }
 
@@ -64,13 +68,31 @@ func runFakeFunc(cmd *cobra.Command, _ []string) error {
 		fmt.Print("error in parse job-config")
 		return err
 	}
+	jobName, err := cmd.Flags().GetString("job-name")
+	if err != nil {
+		fmt.Print("error in parse `--job-name`")
+		return err
+	}
+	priority, err := cmd.Flags().GetInt32("priority")
+	if err != nil {
+		fmt.Print("error in parse `--priority`")
+		return err
+	}
+	dependsOn, err := cmd.Flags().GetStringSlice("depends-on")
+	if err != nil {
+		fmt.Print("error in parse `--depends-on`")
+		return err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
 	defer cancel()
 
 	resp, err := cltManager.MasterClient().SubmitJob(ctx, &pb.SubmitJobRequest{
-		Tp:     jobType,
-		Config: jobConfig,
-		User:   "hanfei",
+		Tp:        jobType,
+		Config:    jobConfig,
+		User:      "hanfei",
+		JobName:   jobName,
+		Priority:  priority,
+		DependsOn: dependsOn,
 	})
 	if err != nil {
 		log.L().Error("failed to submit job", zap.Error(err))
@@ -79,3 +101,427 @@ func runFakeFunc(cmd *cobra.Command, _ []string) error {
 	log.L().Info("resp", zap.Any("resp", resp))
 	return nil
 }
+
+func NewCancelJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-job",
+		Short: "Cancel a running job",
+		RunE:  runCancelJobFunc,
+	}
+	cmd.Flags().StringP("job-id", "", "", "the id of the job to cancel")
+	return cmd
+}
+
+func runCancelJobFunc(cmd *cobra.Command, _ []string) error {
+	id, err := cmd.Flags().GetString("job-id")
+	if err != nil {
+		fmt.Print("error in parse `--job-id`")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().CancelJob(ctx, &pb.CancelJobRequest{
+		JobIdStr: id,
+	})
+	if err != nil {
+		log.L().Error("failed to cancel job", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewPauseJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-job",
+		Short: "Pause a running job",
+		RunE:  runPauseJobFunc,
+	}
+	cmd.Flags().StringP("job-id", "", "", "the id of the job to pause")
+	return cmd
+}
+
+func runPauseJobFunc(cmd *cobra.Command, _ []string) error {
+	id, err := cmd.Flags().GetString("job-id")
+	if err != nil {
+		fmt.Print("error in parse `--job-id`")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().PauseJob(ctx, &pb.PauseJobRequest{
+		JobIdStr: id,
+	})
+	if err != nil {
+		log.L().Error("failed to pause job", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewResumeJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-job",
+		Short: "Resume a paused job",
+		RunE:  runResumeJobFunc,
+	}
+	cmd.Flags().StringP("job-id", "", "", "the id of the job to resume")
+	return cmd
+}
+
+func runResumeJobFunc(cmd *cobra.Command, _ []string) error {
+	id, err := cmd.Flags().GetString("job-id")
+	if err != nil {
+		fmt.Print("error in parse `--job-id`")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().ResumeJob(ctx, &pb.ResumeJobRequest{
+		JobIdStr: id,
+	})
+	if err != nil {
+		log.L().Error("failed to resume job", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewQueryJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-job",
+		Short: "Query the status of a job, or list all jobs if job id is not given",
+		RunE:  runQueryJobFunc,
+	}
+	cmd.Flags().StringP("job-id", "", "", "the id of the job to query")
+	return cmd
+}
+
+func runQueryJobFunc(cmd *cobra.Command, _ []string) error {
+	id, err := cmd.Flags().GetString("job-id")
+	if err != nil {
+		fmt.Print("error in parse `--job-id`")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	var resp interface{}
+	if id == "" {
+		resp, err = cltManager.MasterClient().ListJobs(ctx, &pb.ListJobsRequest{})
+	} else {
+		resp, err = cltManager.MasterClient().QueryJob(ctx, &pb.QueryJobRequest{
+			JobIdStr: id,
+		})
+	}
+	if err != nil {
+		log.L().Error("failed to query job", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewUpdateJobConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-job-config",
+		Short: "Update the config of a running job in place",
+		RunE:  runUpdateJobConfigFunc,
+	}
+	cmd.Flags().StringP("job-id", "", "", "the id of the job to update")
+	cmd.Flags().StringP("job-config", "", "", "the file of the updated config")
+	return cmd
+}
+
+func runUpdateJobConfigFunc(cmd *cobra.Command, _ []string) error {
+	id, err := cmd.Flags().GetString("job-id")
+	if err != nil {
+		fmt.Print("error in parse `--job-id`")
+		return err
+	}
+	path, err := cmd.Flags().GetString("job-config")
+	if err != nil {
+		fmt.Print("error in parse `--job-config`")
+		return err
+	}
+	jobConfig, err := openFileAndReadString(path)
+	if err != nil {
+		fmt.Print("error in parse job-config")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		JobIdStr: id,
+		Config:   jobConfig,
+	})
+	if err != nil {
+		log.L().Error("failed to update job config", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewCreateSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-schedule",
+		Short: "Create a schedule that submits a job periodically",
+		RunE:  runCreateScheduleFunc,
+	}
+	cmd.Flags().StringP("name", "", "", "the unique name of the schedule")
+	cmd.Flags().StringP("cron", "", "", "the cron expression in UTC, such as \"0 2 * * *\" or \"@daily\"")
+	cmd.Flags().StringP("job-type", "", "", "job type")
+	cmd.Flags().StringP("job-config", "", "", "config file for the job")
+	cmd.Flags().Int32P("priority", "", 0, "the priority of the submitted jobs")
+	cmd.Flags().StringP("concurrency-policy", "", pb.ConcurrencyPolicy_Allow.String(),
+		"what to do if the jobs submitted before are still running: Allow, Forbid or Replace")
+	return cmd
+}
+
+func runCreateScheduleFunc(cmd *cobra.Command, _ []string) error {
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		fmt.Print("error in parse `--name`")
+		return err
+	}
+	cronSpec, err := cmd.Flags().GetString("cron")
+	if err != nil {
+		fmt.Print("error in parse `--cron`")
+		return err
+	}
+	tp, err := cmd.Flags().GetString("job-type")
+	if err != nil {
+		fmt.Print("error in parse `--job-type`")
+		return err
+	}
+	jobType, err := validJobType(tp)
+	if err != nil {
+		return err
+	}
+	path, err := cmd.Flags().GetString("job-config")
+	if err != nil {
+		fmt.Print("error in parse `--job-config`")
+		return err
+	}
+	var jobConfig []byte
+	if path != "" {
+		jobConfig, err = openFileAndReadString(path)
+		if err != nil {
+			fmt.Print("error in parse job-config")
+			return err
+		}
+	}
+	priority, err := cmd.Flags().GetInt32("priority")
+	if err != nil {
+		fmt.Print("error in parse `--priority`")
+		return err
+	}
+	policyStr, err := cmd.Flags().GetString("concurrency-policy")
+	if err != nil {
+		fmt.Print("error in parse `--concurrency-policy`")
+		return err
+	}
+	policy, ok := pb.ConcurrencyPolicy_value[policyStr]
+	if !ok {
+		return fmt.Errorf("invalid concurrency policy: %s", policyStr)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().CreateSchedule(ctx, &pb.CreateScheduleRequest{
+		Name:     name,
+		Cron:     cronSpec,
+		Tp:       jobType,
+		Config:   jobConfig,
+		Priority: priority,
+		Policy:   pb.ConcurrencyPolicy(policy),
+	})
+	if err != nil {
+		log.L().Error("failed to create schedule", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewDeleteSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-schedule",
+		Short: "Delete a schedule, the jobs it has submitted are not affected",
+		RunE:  runDeleteScheduleFunc,
+	}
+	cmd.Flags().StringP("name", "", "", "the name of the schedule to delete")
+	return cmd
+}
+
+func runDeleteScheduleFunc(cmd *cobra.Command, _ []string) error {
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		fmt.Print("error in parse `--name`")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().DeleteSchedule(ctx, &pb.DeleteScheduleRequest{
+		Name: name,
+	})
+	if err != nil {
+		log.L().Error("failed to delete schedule", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewListSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-schedules",
+		Short: "List all schedules and the jobs they have submitted",
+		RunE:  runListSchedulesFunc,
+	}
+	return cmd
+}
+
+func runListSchedulesFunc(cmd *cobra.Command, _ []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().ListSchedules(ctx, &pb.ListSchedulesRequest{})
+	if err != nil {
+		log.L().Error("failed to list schedules", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewCordonExecutor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cordon-executor",
+		Short: "Stop scheduling new workers to an executor, the running workers are not affected",
+		RunE:  runCordonExecutorFunc,
+	}
+	cmd.Flags().StringP("executor-id", "", "", "the id of the executor to cordon")
+	cmd.Flags().BoolP("uncordon", "", false, "resume scheduling workers to the executor")
+	return cmd
+}
+
+func runCordonExecutorFunc(cmd *cobra.Command, _ []string) error {
+	id, err := cmd.Flags().GetString("executor-id")
+	if err != nil {
+		fmt.Print("error in parse `--executor-id`")
+		return err
+	}
+	uncordon, err := cmd.Flags().GetBool("uncordon")
+	if err != nil {
+		fmt.Print("error in parse `--uncordon`")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().CordonExecutor(ctx, &pb.CordonExecutorRequest{
+		ExecutorId: id,
+		Uncordon:   uncordon,
+	})
+	if err != nil {
+		log.L().Error("failed to cordon executor", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+func NewQueryExecutors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-executors",
+		Short: "Query the resource of executors, including the usage of each workload type",
+		RunE:  runQueryExecutorsFunc,
+	}
+	cmd.Flags().StringSliceP("executor-ids", "", nil, "the ids of the executors to query, all executors are queried if it's empty")
+	return cmd
+}
+
+func runQueryExecutorsFunc(cmd *cobra.Command, _ []string) error {
+	ids, err := cmd.Flags().GetStringSlice("executor-ids")
+	if err != nil {
+		fmt.Print("error in parse `--executor-ids`")
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	resp, err := cltManager.MasterClient().QueryExecutors(ctx, &pb.QueryExecutorsRequest{
+		ExecutorIds: ids,
+	})
+	if err != nil {
+		log.L().Error("failed to query executors", zap.Error(err))
+		os.Exit(1)
+	}
+	log.L().Info("resp", zap.Any("resp", resp))
+	return nil
+}
+
+// drainPollInterval is the interval of reporting the progress of draining.
+const drainPollInterval = time.Second
+
+func NewDrainExecutor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain-executor",
+		Short: "Cordon an executor and move its workers elsewhere, wait until it is empty",
+		RunE:  runDrainExecutorFunc,
+	}
+	cmd.Flags().StringP("executor-id", "", "", "the id of the executor to drain")
+	cmd.Flags().DurationP("timeout", "", 10*time.Minute, "how long to wait for the executor to be empty")
+	return cmd
+}
+
+func runDrainExecutorFunc(cmd *cobra.Command, _ []string) error {
+	id, err := cmd.Flags().GetString("executor-id")
+	if err != nil {
+		fmt.Print("error in parse `--executor-id`")
+		return err
+	}
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		fmt.Print("error in parse `--timeout`")
+		return err
+	}
+	deadline := time.Now().Add(timeout)
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+		resp, err := cltManager.MasterClient().DrainExecutor(ctx, &pb.DrainExecutorRequest{
+			ExecutorId: id,
+		})
+		cancel()
+		if err != nil {
+			log.L().Error("failed to drain executor", zap.Error(err))
+			os.Exit(1)
+		}
+		if resp.Err != nil {
+			log.L().Error("failed to drain executor", zap.Any("err", resp.Err))
+			os.Exit(1)
+		}
+		remaining := resp.GetRemainingWorkers()
+		if len(remaining) == 0 {
+			log.L().Info("executor is drained", zap.String("executor-id", id))
+			return nil
+		}
+		log.L().Info("executor is being drained", zap.String("executor-id", id),
+			zap.Int("remaining-worker-count", len(remaining)), zap.Strings("remaining-workers", remaining))
+		if time.Now().After(deadline) {
+			log.L().Error("executor is not drained in time", zap.String("executor-id", id))
+			os.Exit(1)
+		}
+		time.Sleep(drainPollInterval)
+	}
+}
@@ -30,6 +30,17 @@ func NewRootCmd() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(NewRunFake())
+	cmd.AddCommand(NewCancelJob())
+	cmd.AddCommand(NewPauseJob())
+	cmd.AddCommand(NewResumeJob())
+	cmd.AddCommand(NewQueryJob())
+	cmd.AddCommand(NewUpdateJobConfig())
+	cmd.AddCommand(NewCreateSchedule())
+	cmd.AddCommand(NewDeleteSchedule())
+	cmd.AddCommand(NewListSchedules())
+	cmd.AddCommand(NewCordonExecutor())
+	cmd.AddCommand(NewDrainExecutor())
+	cmd.AddCommand(NewQueryExecutors())
 	helpCmd := &cobra.Command{
 		Use:   "help [command]",
 		Short: "Gets help about any commands",
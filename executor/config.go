@@ -37,6 +37,10 @@ var (
 	defaultDiscoverTicker    = 3 * time.Second
 )
 
+// defaultCPUCapacity is the cpu capacity of an executor in resource units,
+// the unit in which the cost of a task is given.
+const defaultCPUCapacity = 100
+
 // NewConfig creates a new base config for worker.
 func NewConfig() *Config {
 	cfg := &Config{}
@@ -56,6 +60,9 @@ func NewConfig() *Config {
 	fs.StringVar(&cfg.Join, "join", "", `join to an existing cluster (usage: dm-master cluster's "${master-addr}")`)
 	fs.StringVar(&cfg.Name, "name", "", "human-readable name for DM-worker member")
 	fs.StringVar(&cfg.KeepAliveTTLStr, "keepalive-ttl", defaultKeepAliveTTL, "dm-worker's TTL for keepalive with etcd (in seconds)")
+	fs.Int64Var(&cfg.CPUCapacity, "cpu-capacity", 0, "cpu capacity of the executor in resource units, the unit of task cost (default 100)")
+	fs.Int64Var(&cfg.MemoryCapacity, "memory-capacity", 0, "memory capacity of the executor in bytes")
+	fs.Int64Var(&cfg.DiskCapacity, "disk-capacity", 0, "disk capacity of the executor in bytes")
 
 	return cfg
 }
@@ -85,6 +92,17 @@ type Config struct {
 
 	PollConcurrency int `toml:"poll-concurrency" json:"poll-concurrency"`
 
+	// CPUCapacity is in resource units, the unit of task cost, MemoryCapacity
+	// and DiskCapacity are in bytes. Tasks requiring memory or disk are not
+	// scheduled to the executor if the corresponding capacity is not set.
+	CPUCapacity    int64 `toml:"cpu-capacity" json:"cpu-capacity"`
+	MemoryCapacity int64 `toml:"memory-capacity" json:"memory-capacity"`
+	DiskCapacity   int64 `toml:"disk-capacity" json:"disk-capacity"`
+
+	// Labels are free-form attributes of the executor, such as zone or rack,
+	// which tasks can prefer to be scheduled to.
+	Labels map[string]string `toml:"labels" json:"labels"`
+
 	KeepAliveTTL      time.Duration `toml:"-" json:"-"`
 	KeepAliveInterval time.Duration `toml:"-" json:"-"`
 	RPCTimeout        time.Duration `toml:"-" json:"-"`
@@ -177,6 +195,9 @@ func (c *Config) Parse(arguments []string) error {
 	if c.PollConcurrency == 0 {
 		c.PollConcurrency = runtime.NumCPU()
 	}
+	if c.CPUCapacity == 0 {
+		c.CPUCapacity = defaultCPUCapacity
+	}
 
 	if c.AdvertiseAddr == "" {
 		c.AdvertiseAddr = c.WorkerAddr
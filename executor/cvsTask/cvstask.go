@@ -11,12 +11,15 @@ import (
 	"github.com/hanfei1991/microcosm/pb"
 	dcontext "github.com/hanfei1991/microcosm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
 
 const (
 	BUFFERSIZE = 1024
+
+	pauseCheckInterval = time.Millisecond * 100
 )
 
 type strPair struct {
@@ -44,6 +47,9 @@ type cvsTask struct {
 	cancelFn func()
 	buffer   chan strPair
 	isEOF    bool
+	// paused stops sending lines to the downstream, the progress is kept in
+	// the buffer and in the reading stream.
+	paused atomic.Bool
 }
 
 func RegisterWorker() {
@@ -120,6 +126,20 @@ func (task *cvsTask) OnMasterFailover(reason lib.MasterFailoverReason) error {
 	return nil
 }
 
+// OnPause is called when the master asks the worker to pause.
+func (task *cvsTask) OnPause(ctx context.Context) error {
+	log.L().Info("pause the task", zap.Any("task id :", task.ID()))
+	task.paused.Store(true)
+	return nil
+}
+
+// OnResume is called when the master asks the worker to resume.
+func (task *cvsTask) OnResume(ctx context.Context) error {
+	log.L().Info("resume the task", zap.Any("task id :", task.ID()))
+	task.paused.Store(false)
+	return nil
+}
+
 // CloseImpl tells the WorkerImpl to quitrunStatusWorker and release resources.
 func (task *cvsTask) CloseImpl(ctx context.Context) error {
 	task.cancelFn()
@@ -184,6 +204,15 @@ func (task *cvsTask) Send(ctx context.Context) error {
 		return err
 	}
 	for {
+		if task.paused.Load() {
+			select {
+			case <-ctx.Done():
+				task.status = lib.WorkerStatusError
+				return nil
+			case <-time.After(pauseCheckInterval):
+			}
+			continue
+		}
 		select {
 		case kv, more := <-task.buffer:
 			if !more {
@@ -1,8 +1,6 @@
 package runtime
 
 import (
-	"math/rand"
-
 	"github.com/hanfei1991/microcosm/model"
 )
 
@@ -40,7 +38,8 @@ func (stru *SimpleTRU) GetCapacity() model.RescUnit {
 	return model.RescUnit(3)
 }
 
-// GetType implements TaskRescUnit.GetUsage
+// GetType implements TaskRescUnit.GetUsage, a simple task always uses one
+// unit of resource.
 func (stru *SimpleTRU) GetUsage() model.RescUnit {
-	return model.RescUnit(1 + rand.Intn(3))
+	return model.RescUnit(1)
 }
@@ -17,6 +17,5 @@ func TestResourceSnapshot(t *testing.T) {
 	}
 	resc := r.Resource()
 	require.Equal(t, 1, len(resc))
-	require.GreaterOrEqual(t, resc[model.Benchmark], 2)
-	require.LessOrEqual(t, resc[model.Benchmark], 6)
+	require.Equal(t, model.RescUnit(2), resc[model.Benchmark])
 }
@@ -3,6 +3,7 @@ package executor
 import (
 	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hanfei1991/microcosm/client"
@@ -18,6 +19,7 @@ import (
 	"github.com/hanfei1991/microcosm/pkg/metadata"
 	"github.com/hanfei1991/microcosm/pkg/p2p"
 	"github.com/hanfei1991/microcosm/pkg/serverutils"
+	"github.com/hanfei1991/microcosm/pkg/version"
 	"github.com/hanfei1991/microcosm/test"
 	"github.com/hanfei1991/microcosm/test/mock"
 	"github.com/pingcap/tiflow/dm/pkg/log"
@@ -49,6 +51,10 @@ type Server struct {
 
 	lastHearbeatTime time.Time
 
+	// dispatchedWorkers records how the workers in workerRtm are scheduled,
+	// it maps worker IDs to *pb.RunningWorker.
+	dispatchedWorkers sync.Map
+
 	mockSrv mock.GrpcServer
 
 	// etcdCli connects to server master embed etcd, it should be used in service
@@ -149,6 +155,9 @@ func (s *Server) DispatchTask(ctx context.Context, req *pb.DispatchTaskRequest)
 		ID:     req.GetWorkerId(),
 		Tp:     lib.WorkerType(req.GetTaskTypeId()),
 		Config: req.GetTaskConfig(),
+		// The workers created by a job master inherit the priority of the
+		// job.
+		Priority: req.GetPriority(),
 	}
 	metaBytes, err := masterMeta.Marshal()
 	if err != nil {
@@ -181,12 +190,48 @@ func (s *Server) DispatchTask(ctx context.Context, req *pb.DispatchTaskRequest)
 		}, nil
 	}
 
+	s.dispatchedWorkers.Store(req.GetWorkerId(), &pb.RunningWorker{
+		WorkerId:       req.GetWorkerId(),
+		MasterId:       req.GetMasterId(),
+		Resource:       req.GetResource(),
+		Priority:       req.GetPriority(),
+		NonPreemptible: req.GetNonPreemptible(),
+		WorkerType:     req.GetTaskTypeId(),
+		Pinned:         req.GetPinned(),
+	})
+
 	return &pb.DispatchTaskResponse{
 		ErrorCode: pb.DispatchTaskErrorCode_OK,
 		WorkerId:  req.GetWorkerId(),
 	}, nil
 }
 
+// runningWorkers returns the workers in workerRtm along with how they are
+// scheduled, and forgets the workers that have exited.
+func (s *Server) runningWorkers() []*pb.RunningWorker {
+	dispatched := make(map[string]*pb.RunningWorker)
+	s.dispatchedWorkers.Range(func(key, value interface{}) bool {
+		dispatched[key.(string)] = value.(*pb.RunningWorker)
+		return true
+	})
+	// The workers are recorded after they are submitted to workerRtm, so the
+	// ones missing below have exited.
+	workerIDs := s.workerRtm.TaskIDs()
+	ret := make([]*pb.RunningWorker, 0, len(workerIDs))
+	for _, workerID := range workerIDs {
+		worker, ok := dispatched[workerID]
+		if !ok {
+			worker = &pb.RunningWorker{WorkerId: workerID}
+		}
+		ret = append(ret, worker)
+		delete(dispatched, workerID)
+	}
+	for workerID := range dispatched {
+		s.dispatchedWorkers.Delete(workerID)
+	}
+	return ret
+}
+
 func (s *Server) Stop() {
 	if s.grpcSrv != nil {
 		s.grpcSrv.Stop()
@@ -399,19 +444,42 @@ func (s *Server) selfRegister(ctx context.Context) (err error) {
 		return err
 	}
 	log.L().Logger.Info("master client init successful")
+	workerTypes := registry.GlobalWorkerRegistry().WorkerTypes()
 	registerReq := &pb.RegisterExecutorRequest{
 		Address:    s.cfg.AdvertiseAddr,
-		Capability: 100,
+		Version:    version.ReleaseVersion,
+		Capability: s.cfg.CPUCapacity,
+		ResourceCapacity: &pb.Resource{
+			Cpu:    s.cfg.CPUCapacity,
+			Memory: s.cfg.MemoryCapacity,
+			Disk:   s.cfg.DiskCapacity,
+		},
+		Labels:          s.cfg.Labels,
+		ProtocolVersion: version.ProtocolVersion,
+		WorkerTypes:     make([]int64, 0, len(workerTypes)),
+	}
+	for _, tp := range workerTypes {
+		registerReq.WorkerTypes = append(registerReq.WorkerTypes, int64(tp))
 	}
 
 	resp, err := s.cli.RegisterExecutor(ctx, registerReq, s.cfg.RPCTimeout)
 	if err != nil {
 		return err
 	}
+	if resp.Err != nil {
+		return errors.ErrExecutorRegister.GenWithStackByArgs(resp.Err.GetMessage())
+	}
+	// The node info is persisted by the discovery keepaliver, the new leader
+	// of server master registers the executor with it after failover.
 	s.info = &model.NodeInfo{
-		Type: model.NodeTypeExecutor,
-		ID:   model.ExecutorID(resp.ExecutorId),
-		Addr: s.cfg.AdvertiseAddr,
+		Type:            model.NodeTypeExecutor,
+		ID:              model.ExecutorID(resp.ExecutorId),
+		Addr:            s.cfg.AdvertiseAddr,
+		Capability:      model.NewResourceFromPB(registerReq.ResourceCapacity, registerReq.Capability),
+		Labels:          s.cfg.Labels,
+		Version:         registerReq.Version,
+		ProtocolVersion: registerReq.ProtocolVersion,
+		WorkerTypes:     registerReq.WorkerTypes,
 	}
 	log.L().Logger.Info("register successful", zap.Any("info", s.info))
 	return nil
@@ -447,6 +515,15 @@ func (s *Server) keepHeartbeat(ctx context.Context) error {
 				// executor actually wait for a timeout when ttl is nearly up.
 				Ttl: uint64(s.cfg.KeepAliveTTL.Milliseconds() + s.cfg.RPCTimeout.Milliseconds()),
 			}
+			if s.workerRtm != nil {
+				// The server master releases the resource of the workers
+				// that are not running anymore, and rebuilds the resource
+				// reserved for the running ones after failover.
+				req.Workers = s.runningWorkers()
+				for _, worker := range req.Workers {
+					req.WorkerIds = append(req.WorkerIds, worker.GetWorkerId())
+				}
+			}
 			resp, err := s.cli.Heartbeat(ctx, req, s.cfg.RPCTimeout)
 			if err != nil {
 				log.L().Error("heartbeat rpc meet error", zap.Error(err))
@@ -491,8 +568,7 @@ func getJoinURLs(addrs string) []string {
 func (s *Server) reportTaskRescOnce(ctx context.Context) error {
 	rescs := s.sch.Resource()
 	req := &pb.ExecWorkloadRequest{
-		// TODO: use which field as ExecutorId is more accurate
-		ExecutorId: s.cfg.WorkerAddr,
+		ExecutorId: string(s.info.ID),
 		Workloads:  make([]*pb.ExecWorkload, 0, len(rescs)),
 	}
 	for tp, resc := range rescs {
@@ -6,9 +6,18 @@ import (
 	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/hanfei1991/microcosm/client"
+	"github.com/hanfei1991/microcosm/executor/worker"
+	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/metadata"
+	"github.com/hanfei1991/microcosm/pkg/p2p"
 	"github.com/phayes/freeport"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -63,3 +72,59 @@ func testPprof(t *testing.T, addr string) {
 		require.Nil(t, err)
 	}
 }
+
+func TestDispatchTaskPriority(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewServer(NewConfig(), nil)
+	s.info = &model.NodeInfo{ID: "executor-1", Addr: "127.0.0.1:10001"}
+	s.grpcSrv = grpc.NewServer()
+	wg, ctx := errgroup.WithContext(ctx)
+	require.NoError(t, s.startMsgService(ctx, wg))
+	s.p2pMsgRouter = p2p.NewMessageRouter(p2p.NodeID(s.info.ID), s.info.Addr)
+	s.metastore = metadata.NewMetaMock()
+	s.workerRtm = worker.NewRuntime(ctx, 10)
+	go s.workerRtm.Start(ctx, 1)
+
+	// The job master is dispatched by the job manager.
+	err := lib.NewMasterMetadataClient(lib.JobManagerUUID, s.metastore).Store(ctx, &lib.MasterMetaKVData{
+		ID:     lib.JobManagerUUID,
+		NodeID: "server-master-1",
+	})
+	require.NoError(t, err)
+
+	// The workers created by the job master are scheduled with the priority
+	// of the job.
+	cli := &client.MockServerMasterClient{}
+	tasks := make(chan *pb.ScheduleTask, 1)
+	cli.On("ScheduleTask", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		select {
+		case tasks <- args.Get(1).(*pb.TaskSchedulerRequest).Tasks[0]:
+		default:
+		}
+	}).Return(&pb.TaskSchedulerResponse{
+		Err: &pb.Error{Code: pb.ErrorCode_NotEnoughResource},
+	}, nil)
+	s.cli = cli
+
+	resp, err := s.DispatchTask(ctx, &pb.DispatchTaskRequest{
+		TaskTypeId: int64(lib.FakeJobMaster),
+		TaskConfig: []byte("{}"),
+		MasterId:   lib.JobManagerUUID,
+		WorkerId:   "fake-job",
+		Priority:   10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pb.DispatchTaskErrorCode_OK, resp.GetErrorCode())
+
+	select {
+	case task := <-tasks:
+		require.Equal(t, int32(10), task.GetPriority())
+		require.Equal(t, int64(lib.FakeTask), task.GetWorkerType())
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "the worker is not scheduled")
+	}
+}
@@ -239,6 +239,16 @@ func (r *Runtime) SubmitTask(task Runnable) error {
 	return nil
 }
 
+// TaskIDs returns the IDs of the tasks in the runtime.
+func (r *Runtime) TaskIDs() []RunnableID {
+	var ret []RunnableID
+	r.taskList.Range(func(key, _ interface{}) bool {
+		ret = append(ret, key.(RunnableID))
+		return true
+	})
+	return ret
+}
+
 func (r *Runtime) Workload() model.RescUnit {
 	ret := model.RescUnit(0)
 	r.taskList.Range(func(_, value interface{}) bool {
@@ -2,6 +2,8 @@ package cvs
 
 import (
 	"context"
+	"encoding/json"
+	"net"
 
 	"github.com/hanfei1991/microcosm/executor/worker"
 
@@ -13,6 +15,7 @@ import (
 	dcontext "github.com/hanfei1991/microcosm/pkg/context"
 	"github.com/hanfei1991/microcosm/pkg/p2p"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -28,6 +31,9 @@ type workerInfo struct {
 	file   string
 	curLoc int64
 	handle lib.WorkerHandle
+	// stale is set if the worker is created before the config is updated,
+	// the worker is restarted with the updated config.
+	stale bool
 }
 
 type errorInfo struct {
@@ -39,11 +45,15 @@ func (e *errorInfo) Error() string {
 }
 
 type JobMaster struct {
-	lib.BaseMaster
+	lib.BaseJobMaster
 	syncInfo      *Config
 	syncFilesInfo map[lib.WorkerID]*workerInfo
 	counter       int64
 	workerID      lib.WorkerID
+
+	// finished is set after all files are synced, the job master reports
+	// WorkerStatusFinished to the job manager then.
+	finished atomic.Bool
 }
 
 func RegisterWorker() {
@@ -54,16 +64,18 @@ func RegisterWorker() {
 	registry.GlobalWorkerRegistry().MustRegisterWorkerType(lib.CvsJobMaster, factory)
 }
 
-func NewCVSJobMaster(ctx *dcontext.Context, workerID lib.WorkerID, _ lib.MasterID, conf lib.WorkerConfig) *JobMaster {
+func NewCVSJobMaster(ctx *dcontext.Context, workerID lib.WorkerID, masterID lib.MasterID, conf lib.WorkerConfig) *JobMaster {
 	jm := &JobMaster{}
 	jm.workerID = workerID
 	jm.syncInfo = conf.(*Config)
 	jm.syncFilesInfo = make(map[lib.WorkerID]*workerInfo)
 	deps := ctx.Dependencies
 
-	base := lib.NewBaseMaster(
+	base := lib.NewBaseJobMaster(
 		ctx,
 		jm,
+		jm,
+		masterID,
 		workerID,
 		deps.MessageHandlerManager,
 		deps.MessageRouter,
@@ -71,7 +83,7 @@ func NewCVSJobMaster(ctx *dcontext.Context, workerID lib.WorkerID, _ lib.MasterI
 		deps.ExecutorClientManager,
 		deps.ServerMasterClient,
 	)
-	jm.BaseMaster = base
+	jm.BaseJobMaster = base
 	log.L().Info("new cvs jobmaster ", zap.Any("id :", jm.workerID))
 	return jm
 }
@@ -95,7 +107,8 @@ func (jm *JobMaster) InitImpl(ctx context.Context) error {
 		dstDir := jm.syncInfo.DstDir + "/" + file
 		srcDir := jm.syncInfo.SrcDir + "/" + file
 		conf := cvsTask.Config{SrcHost: jm.syncInfo.SrcHost, SrcDir: srcDir, DstHost: jm.syncInfo.DstHost, DstDir: dstDir, StartLoc: 0}
-		workerID, err := jm.CreateWorker(lib.CvsTask, conf, 10 /* TODO add cost */)
+		workerID, err := jm.CreateWorker(lib.CvsTask, conf, 10, /* TODO add cost */
+			lib.WithPreferredLocation(jm.srcLocation(), false))
 		if err != nil {
 			// todo : handle the error case
 			return err
@@ -105,13 +118,28 @@ func (jm *JobMaster) InitImpl(ctx context.Context) error {
 	return nil
 }
 
+// srcLocation returns the host of the source, the tasks prefer to run next to
+// the source data to avoid cross-rack traffic.
+func (jm *JobMaster) srcLocation() string {
+	host, _, err := net.SplitHostPort(jm.syncInfo.SrcHost)
+	if err != nil {
+		return jm.syncInfo.SrcHost
+	}
+	return host
+}
+
 func (jm *JobMaster) Tick(ctx context.Context) error {
 	jm.counter = 0
+	allFinished := len(jm.syncFilesInfo) > 0
 	for _, worker := range jm.syncFilesInfo {
 		if worker.handle == nil {
+			allFinished = false
 			continue
 		}
 		status := worker.handle.Status()
+		if status.Code != lib.WorkerStatusFinished {
+			allFinished = false
+		}
 		if status.Code == lib.WorkerStatusNormal {
 			num := status.Ext.(*int64)
 			worker.curLoc = *num
@@ -126,6 +154,10 @@ func (jm *JobMaster) Tick(ctx context.Context) error {
 		}
 	}
 	log.L().Info("cvs job master status  ", zap.Any("id :", jm.workerID), zap.Int64("counter: ", jm.counter))
+	if allFinished && !jm.finished.Load() {
+		log.L().Info("cvs job finished", zap.Any("id :", jm.workerID))
+		jm.finished.Store(true)
+	}
 	return nil
 }
 
@@ -146,6 +178,10 @@ func (jm *JobMaster) OnWorkerOnline(worker lib.WorkerHandle) error {
 		log.L().Info("worker online ", zap.Any("fileName", syncInfo.file))
 	}
 	syncInfo.handle = worker
+	if syncInfo.stale {
+		// restart the worker with the updated config
+		return jm.StopWorker(context.Background(), worker.ID())
+	}
 	return nil
 }
 
@@ -159,7 +195,8 @@ func (jm *JobMaster) OnWorkerOffline(worker lib.WorkerHandle, reason error) erro
 	dstDir := jm.syncInfo.DstDir + "/" + syncInfo.file
 	srcDir := jm.syncInfo.SrcDir + "/" + syncInfo.file
 	conf := cvsTask.Config{SrcHost: jm.syncInfo.SrcHost, SrcDir: srcDir, DstHost: jm.syncInfo.DstHost, DstDir: dstDir, StartLoc: syncInfo.curLoc}
-	workerID, err := jm.CreateWorker(lib.CvsTask, conf, 10)
+	workerID, err := jm.CreateWorker(lib.CvsTask, conf, 10,
+		lib.WithPreferredLocation(jm.srcLocation(), false))
 	if err != nil {
 		log.L().Info("create worker failed ", zap.String(" information :", err.Error()))
 	}
@@ -169,6 +206,51 @@ func (jm *JobMaster) OnWorkerOffline(worker lib.WorkerHandle, reason error) erro
 	return err
 }
 
+func (jm *JobMaster) OnPause(ctx context.Context) error {
+	log.L().Info("cvs job master paused", zap.Any("id :", jm.workerID))
+	return nil
+}
+
+func (jm *JobMaster) OnResume(ctx context.Context) error {
+	log.L().Info("cvs job master resumed", zap.Any("id :", jm.workerID))
+	return nil
+}
+
+// OnConfigUpdated applies the updated config, only the destination of the
+// sync can be changed. The workers that are not finished are restarted from
+// their current locations with the new destination.
+func (jm *JobMaster) OnConfigUpdated(ctx context.Context, config []byte) error {
+	syncInfo := &Config{}
+	if err := json.Unmarshal(config, syncInfo); err != nil {
+		return err
+	}
+	if syncInfo.SrcHost != jm.syncInfo.SrcHost || syncInfo.SrcDir != jm.syncInfo.SrcDir {
+		return &errorInfo{info: "the source of a running sync can't be changed"}
+	}
+	if syncInfo.DstHost == syncInfo.SrcHost && syncInfo.SrcDir == syncInfo.DstDir {
+		return &errorInfo{info: "bad configure file ,make sure the source address is not the same as the destination"}
+	}
+	if *syncInfo == *jm.syncInfo {
+		return nil
+	}
+	log.L().Info("cvs job master config updated", zap.Any("id :", jm.workerID), zap.Any("config", syncInfo))
+	jm.syncInfo = syncInfo
+	for workerID, worker := range jm.syncFilesInfo {
+		if worker.handle == nil {
+			worker.stale = true
+			continue
+		}
+		if status := worker.handle.Status(); status != nil && status.Code == lib.WorkerStatusFinished {
+			continue
+		}
+		worker.stale = true
+		if err := jm.StopWorker(ctx, workerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (jm *JobMaster) OnWorkerMessage(worker lib.WorkerHandle, topic p2p.Topic, message interface{}) error {
 	return nil
 }
@@ -191,6 +273,9 @@ func (jm *JobMaster) OnMasterFailover(reason lib.MasterFailoverReason) error {
 }
 
 func (jm *JobMaster) Status() lib.WorkerStatus {
+	if jm.finished.Load() {
+		return lib.WorkerStatus{Code: lib.WorkerStatusFinished, Ext: jm.counter}
+	}
 	return lib.WorkerStatus{Code: lib.WorkerStatusNormal, Ext: jm.counter}
 }
 
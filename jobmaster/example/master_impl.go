@@ -88,6 +88,21 @@ func (e *exampleMaster) OnWorkerMessage(worker lib.WorkerHandle, topic p2p.Topic
 	return nil
 }
 
+func (e *exampleMaster) OnPause(ctx context.Context) error {
+	log.L().Info("OnPause")
+	return nil
+}
+
+func (e *exampleMaster) OnResume(ctx context.Context) error {
+	log.L().Info("OnResume")
+	return nil
+}
+
+func (e *exampleMaster) OnConfigUpdated(ctx context.Context, config []byte) error {
+	log.L().Info("OnConfigUpdated")
+	return nil
+}
+
 func (e *exampleMaster) CloseImpl(ctx context.Context) error {
 	log.L().Info("CloseImpl")
 	return nil
@@ -75,6 +75,16 @@ func (w *exampleWorker) OnMasterFailover(reason lib.MasterFailoverReason) error
 	return nil
 }
 
+func (w *exampleWorker) OnPause(ctx context.Context) error {
+	log.L().Info("OnPause")
+	return nil
+}
+
+func (w *exampleWorker) OnResume(ctx context.Context) error {
+	log.L().Info("OnResume")
+	return nil
+}
+
 func (w *exampleWorker) CloseImpl(ctx context.Context) error {
 	log.L().Info("CloseImpl")
 	w.wg.Wait()
@@ -50,6 +50,9 @@ type Master struct {
 	scheduleRateLimit *rate.Limiter
 }
 
+// scheduleWaitTimeout is how long a group of tasks waits for resource.
+const scheduleWaitTimeout = 30 * time.Second
+
 type TaskStatus int
 
 const (
@@ -208,12 +211,20 @@ func (m *Master) scheduleTask(group scheduleGroup) error {
 	for _, task := range group {
 		reqTasks = append(reqTasks, task.ToScheduleTaskPB())
 	}
-	req := &pb.TaskSchedulerRequest{Tasks: reqTasks}
+	// The tasks of a group are useless if only some of them start.
+	req := &pb.TaskSchedulerRequest{
+		Tasks:         reqTasks,
+		Gang:          true,
+		WaitTimeoutMs: scheduleWaitTimeout.Milliseconds(),
+	}
 	resp, err := m.clients.MasterClient().ScheduleTask(m.ctx, req, time.Minute)
 	if err != nil {
 		// TODO: convert grpc error to rfc error
 		return err
 	}
+	if resp.Err != nil {
+		return stdErrors.New(resp.Err.Message)
+	}
 	for _, task := range group {
 		schedule, ok := resp.GetSchedule()[int64(task.ID)]
 		if !ok {
@@ -2,14 +2,20 @@ package lib
 
 import (
 	"context"
+	"encoding/json"
+	"sync"
 
 	"github.com/hanfei1991/microcosm/client"
 	"github.com/hanfei1991/microcosm/executor/worker"
 	"github.com/hanfei1991/microcosm/model"
 	dcontext "github.com/hanfei1991/microcosm/pkg/context"
+	derror "github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/hanfei1991/microcosm/pkg/metadata"
 	"github.com/hanfei1991/microcosm/pkg/p2p"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
 )
 
 type BaseJobMaster interface {
@@ -18,8 +24,13 @@ type BaseJobMaster interface {
 }
 
 type defaultBaseJobMaster struct {
-	master BaseMaster
-	worker BaseWorker
+	master     BaseMaster
+	worker     BaseWorker
+	workerImpl *jobMasterWorkerImpl
+
+	// stoppingWorkers records the workers that have been asked to exit
+	// after the job master itself is asked to stop.
+	stoppingWorkers map[WorkerID]struct{}
 }
 
 func NewBaseJobMaster(
@@ -41,12 +52,19 @@ func NewBaseJobMaster(
 	baseMaster := NewBaseMaster(
 		ctx, masterImpl, workerID, messageHandlerManager,
 		messageRouter, metaKVClient, executorClientManager, serverMasterClient)
+	jobMasterImpl := &jobMasterWorkerImpl{
+		WorkerImpl: workerImpl,
+		master:     baseMaster,
+		masterImpl: masterImpl,
+	}
 	baseWorker := NewBaseWorker(
-		workerImpl, messageHandlerManager, messageRouter, metaKVClient,
+		jobMasterImpl, messageHandlerManager, messageRouter, metaKVClient,
 		workerID, masterID)
 	return &defaultBaseJobMaster{
-		master: baseMaster,
-		worker: baseWorker,
+		master:          baseMaster,
+		worker:          baseWorker,
+		workerImpl:      jobMasterImpl,
+		stoppingWorkers: make(map[WorkerID]struct{}),
 	}
 }
 
@@ -61,11 +79,22 @@ func (d *defaultBaseJobMaster) Init(ctx context.Context) error {
 	if err := d.master.Init(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	d.workerImpl.masterInitialized.Store(true)
+	if d.master.IsPaused() {
+		// The job master has been paused before failover, the worker role
+		// should stay paused as well.
+		if w, ok := d.worker.(*DefaultBaseWorker); ok {
+			w.restorePaused()
+		}
+	}
 	return nil
 }
 
 func (d *defaultBaseJobMaster) Poll(ctx context.Context) error {
 	if err := d.worker.Poll(ctx); err != nil {
+		if derror.ErrWorkerStopped.Equal(err) {
+			return d.stopWorkers(ctx, err)
+		}
 		return errors.Trace(err)
 	}
 	if err := d.master.Poll(ctx); err != nil {
@@ -98,8 +127,83 @@ func (d *defaultBaseJobMaster) OnError(err error) {
 	d.master.OnError(err)
 }
 
-func (d *defaultBaseJobMaster) CreateWorker(workerType WorkerType, config WorkerConfig, cost model.RescUnit) (WorkerID, error) {
-	return d.master.CreateWorker(workerType, config, cost)
+func (d *defaultBaseJobMaster) CreateWorker(
+	workerType WorkerType, config WorkerConfig, cost model.RescUnit, opts ...CreateWorkerOpt,
+) (WorkerID, error) {
+	return d.master.CreateWorker(workerType, config, cost, opts...)
+}
+
+func (d *defaultBaseJobMaster) StopWorker(ctx context.Context, workerID WorkerID) error {
+	return d.master.StopWorker(ctx, workerID)
+}
+
+// stopWorkers is called after the job master is asked to stop by the job
+// manager. It asks all workers of the job master to exit, and returns exitErr
+// to quit the job master after all of the workers have gone offline.
+func (d *defaultBaseJobMaster) stopWorkers(ctx context.Context, exitErr error) error {
+	allOffline := true
+	for workerID, handle := range d.master.GetWorkers() {
+		if handle.IsTombStone() {
+			continue
+		}
+		allOffline = false
+		if _, ok := d.stoppingWorkers[workerID]; ok {
+			continue
+		}
+		if err := d.master.StopWorker(ctx, workerID); err != nil {
+			if derror.ErrMessageNotDelivered.Equal(err) {
+				// retried in the next Poll
+				log.L().Warn("failed to stop worker", zap.String("worker-id", workerID), zap.Error(err))
+				continue
+			}
+			return errors.Trace(err)
+		}
+		d.stoppingWorkers[workerID] = struct{}{}
+	}
+	if allOffline {
+		return errors.Trace(exitErr)
+	}
+	return nil
+}
+
+func (d *defaultBaseJobMaster) EvictWorker(ctx context.Context, workerID WorkerID, reason error) error {
+	return d.master.EvictWorker(ctx, workerID, reason)
+}
+
+func (d *defaultBaseJobMaster) MigrateWorker(workerID WorkerID, target model.ExecutorID) error {
+	return d.master.MigrateWorker(workerID, target)
+}
+
+func (d *defaultBaseJobMaster) PauseWorker(ctx context.Context, workerID WorkerID) error {
+	return d.master.PauseWorker(ctx, workerID)
+}
+
+func (d *defaultBaseJobMaster) ResumeWorker(ctx context.Context, workerID WorkerID) error {
+	return d.master.ResumeWorker(ctx, workerID)
+}
+
+func (d *defaultBaseJobMaster) UpdateWorkerConfig(ctx context.Context, workerID WorkerID, config []byte, version int64) error {
+	return d.master.UpdateWorkerConfig(ctx, workerID, config, version)
+}
+
+func (d *defaultBaseJobMaster) Pause(ctx context.Context) error {
+	return d.master.Pause(ctx)
+}
+
+func (d *defaultBaseJobMaster) Resume(ctx context.Context) error {
+	return d.master.Resume(ctx)
+}
+
+func (d *defaultBaseJobMaster) RecoverWorker(ctx context.Context, workerID WorkerID) error {
+	return d.master.RecoverWorker(ctx, workerID)
+}
+
+func (d *defaultBaseJobMaster) IsMasterReady() bool {
+	return d.master.IsMasterReady()
+}
+
+func (d *defaultBaseJobMaster) IsPaused() bool {
+	return d.master.IsPaused()
 }
 
 func (d *defaultBaseJobMaster) GetWorkerStatusExtTypeInfo() interface{} {
@@ -113,3 +217,96 @@ func (d *defaultBaseJobMaster) Workload() model.RescUnit {
 func (d *defaultBaseJobMaster) ID() worker.RunnableID {
 	return d.worker.ID()
 }
+
+// JobMasterStatusExt is the Ext of the WorkerStatus that a job master reports
+// to the job manager, it carries the statuses of all workers of the job.
+type JobMasterStatusExt struct {
+	// ExtBytes is the serialized Ext of the status returned by WorkerImpl.
+	ExtBytes []byte `json:"ext-bytes"`
+	// Workers are the statuses of the workers, in which the Ext field is
+	// serialized to ExtBytes.
+	Workers map[WorkerID]WorkerStatus `json:"workers"`
+	// RejectedConfigVersion is the version of the last config rejected by
+	// MasterImpl.OnConfigUpdated, and ConfigError is why it is rejected.
+	RejectedConfigVersion int64  `json:"rejected-config-version,omitempty"`
+	ConfigError           string `json:"config-error,omitempty"`
+}
+
+// jobMasterWorkerImpl is the WorkerImpl of the worker role of a job master.
+// When the job manager pauses or resumes the job master, the master role is
+// paused or resumed, which in turn drives all the workers of the job.
+// MasterImpl.OnPause and MasterImpl.OnResume are called instead of the ones
+// of WorkerImpl. The config updates sent by the job manager are handled by
+// MasterImpl.OnConfigUpdated.
+type jobMasterWorkerImpl struct {
+	WorkerImpl
+	master     BaseMaster
+	masterImpl MasterImpl
+
+	configMu              sync.Mutex
+	rejectedConfigVersion int64
+	configError           string
+
+	// masterInitialized is set after the master role is initialized, the
+	// workers of the job are not available before that.
+	masterInitialized atomic.Bool
+}
+
+// Status wraps the status returned by WorkerImpl with the statuses of all
+// workers of the job.
+func (w *jobMasterWorkerImpl) Status() WorkerStatus {
+	status := w.WorkerImpl.Status()
+	ext := &JobMasterStatusExt{
+		Workers: make(map[WorkerID]WorkerStatus),
+	}
+	if status.Ext != nil {
+		extBytes, err := json.Marshal(status.Ext)
+		if err != nil {
+			log.L().Warn("failed to marshal job master status", zap.Error(err))
+		}
+		ext.ExtBytes = extBytes
+	}
+	w.configMu.Lock()
+	ext.RejectedConfigVersion = w.rejectedConfigVersion
+	ext.ConfigError = w.configError
+	w.configMu.Unlock()
+	if w.masterInitialized.Load() {
+		for workerID, handle := range w.master.GetWorkers() {
+			workerStatus := handle.Status()
+			if workerStatus == nil {
+				continue
+			}
+			copied := *workerStatus
+			if err := copied.marshalExt(); err != nil {
+				log.L().Warn("failed to marshal worker status",
+					zap.String("worker-id", workerID), zap.Error(err))
+			}
+			ext.Workers[workerID] = copied
+		}
+	}
+	status.Ext = ext
+	return status
+}
+
+func (w *jobMasterWorkerImpl) onConfigUpdated(ctx context.Context, msg *UpdateConfigMessage) {
+	err := w.masterImpl.OnConfigUpdated(ctx, msg.Config)
+	if err == nil {
+		log.L().Info("job config is updated", zap.String("worker-id", msg.WorkerID),
+			zap.Int64("config-version", msg.ConfigVersion))
+		return
+	}
+	log.L().Warn("job config is rejected", zap.String("worker-id", msg.WorkerID),
+		zap.Int64("config-version", msg.ConfigVersion), zap.Error(err))
+	w.configMu.Lock()
+	defer w.configMu.Unlock()
+	w.rejectedConfigVersion = msg.ConfigVersion
+	w.configError = err.Error()
+}
+
+func (w *jobMasterWorkerImpl) OnPause(ctx context.Context) error {
+	return w.master.Pause(ctx)
+}
+
+func (w *jobMasterWorkerImpl) OnResume(ctx context.Context) error {
+	return w.master.Resume(ctx)
+}
@@ -125,6 +125,45 @@ func StatusUpdateTopic(masterID MasterID, workerID WorkerID) p2p.Topic {
 	return fmt.Sprintf("status-update-%s-%s", masterID, workerID)
 }
 
+// StopWorkerTopic is the topic sent through WorkerHandle.SendMessage to ask
+// a worker to exit.
+const StopWorkerTopic = p2p.Topic("stop-worker")
+
+// PauseWorkerTopic and ResumeWorkerTopic are the topics sent through
+// WorkerHandle.SendMessage to pause or resume a worker.
+const (
+	PauseWorkerTopic  = p2p.Topic("pause-worker")
+	ResumeWorkerTopic = p2p.Topic("resume-worker")
+)
+
+// UpdateConfigTopic is the topic sent through WorkerHandle.SendMessage to
+// deliver the updated config of a job to its job master.
+const UpdateConfigTopic = p2p.Topic("update-config")
+
+// HandOffCheckpointTopic is the topic sent through WorkerHandle.SendMessage
+// to hand off the checkpoint of a migrated worker to the new worker.
+const HandOffCheckpointTopic = p2p.Topic("hand-off-checkpoint")
+
+// PreemptWorkerTopic is the topic on which a master is asked by the server
+// master to stop a worker, so that the resource can be used by a worker of
+// higher priority.
+func PreemptWorkerTopic(masterID MasterID) p2p.Topic {
+	return fmt.Sprintf("preempt-worker-%s", masterID)
+}
+
+// MigrateWorkerTopic is the topic on which a master is asked by the server
+// master to move a worker out of an executor being drained, or to another
+// executor for rebalancing.
+func MigrateWorkerTopic(masterID MasterID) p2p.Topic {
+	return fmt.Sprintf("migrate-worker-%s", masterID)
+}
+
+// workerMessageTopic returns the topic on which a worker receives messages
+// sent through WorkerHandle.SendMessage.
+func workerMessageTopic(workerID WorkerID, topic p2p.Topic) p2p.Topic {
+	return fmt.Sprintf("worker-message/%s/%s", workerID, topic)
+}
+
 type HeartbeatPingMessage struct {
 	SendTime     clock.MonotonicTime `json:"send-time"`
 	FromWorkerID WorkerID            `json:"from-worker-id"`
@@ -143,18 +182,80 @@ type StatusUpdateMessage struct {
 	Status   WorkerStatus `json:"status"`
 }
 
+type StopWorkerMessage struct {
+	WorkerID WorkerID `json:"worker-id"`
+	Epoch    Epoch    `json:"epoch"`
+}
+
+type PauseWorkerMessage struct {
+	WorkerID WorkerID `json:"worker-id"`
+	Epoch    Epoch    `json:"epoch"`
+}
+
+type ResumeWorkerMessage struct {
+	WorkerID WorkerID `json:"worker-id"`
+	Epoch    Epoch    `json:"epoch"`
+}
+
+// UpdateConfigMessage carries the updated config of a job, ConfigVersion is
+// increased each time the config is updated.
+type UpdateConfigMessage struct {
+	WorkerID      WorkerID `json:"worker-id"`
+	Epoch         Epoch    `json:"epoch"`
+	Config        []byte   `json:"config"`
+	ConfigVersion int64    `json:"config-version"`
+}
+
+// PreemptWorkerMessage asks a master to stop a worker, Reason is passed to
+// the master in OnWorkerOffline.
+type PreemptWorkerMessage struct {
+	WorkerID WorkerID `json:"worker-id"`
+	Reason   string   `json:"reason"`
+}
+
+// MigrateWorkerMessage asks a master to move a worker elsewhere. If Target
+// is set, the worker is moved to the executor by DefaultBaseMaster.MigrateWorker,
+// otherwise it is stopped and Reason is passed to the master in OnWorkerOffline.
+type MigrateWorkerMessage struct {
+	WorkerID WorkerID         `json:"worker-id"`
+	Reason   string           `json:"reason"`
+	Target   model.ExecutorID `json:"target,omitempty"`
+}
+
+// HandOffCheckpointMessage carries the checkpoint of a migrated worker, which
+// is the Ext of its latest status, to the worker that replaces it.
+type HandOffCheckpointMessage struct {
+	WorkerID   WorkerID `json:"worker-id"`
+	Epoch      Epoch    `json:"epoch"`
+	Checkpoint []byte   `json:"checkpoint"`
+}
+
 type WorkloadReportMessage struct {
 	WorkerID WorkerID       `json:"worker-id"`
 	Workload model.RescUnit `json:"workload"`
 }
 
+// MasterStatusCode is the status of a job master, it is maintained by the
+// master of the job master, a.k.a. the JobManager, and by the job master
+// itself when it is paused or resumed.
+type MasterStatusCode int32
+
+const (
+	MasterStatusNormal = MasterStatusCode(iota)
+	MasterStatusCanceled
+	MasterStatusPaused
+	MasterStatusFailed
+	MasterStatusFinished
+)
+
 type (
 	MasterMetaKVData struct {
-		ID          MasterID   `json:"id"`
-		Addr        string     `json:"addr"`
-		NodeID      p2p.NodeID `json:"node-id"`
-		Epoch       Epoch      `json:"epoch"`
-		Initialized bool       `json:"initialized"`
+		ID          MasterID         `json:"id"`
+		Addr        string           `json:"addr"`
+		NodeID      p2p.NodeID       `json:"node-id"`
+		Epoch       Epoch            `json:"epoch"`
+		Initialized bool             `json:"initialized"`
+		StatusCode  MasterStatusCode `json:"status"`
 
 		// Ext holds business-specific data
 		MasterMetaExt *MasterMetaExt `json:"meta-ext"`
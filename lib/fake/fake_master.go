@@ -17,7 +17,10 @@ import (
 
 type Config struct{}
 
-var _ lib.BaseJobMaster = (*Master)(nil)
+var (
+	_ lib.BaseJobMaster  = (*Master)(nil)
+	_ lib.WorkerMigrator = (*Master)(nil)
+)
 
 const (
 	fakeWorkerCount = 20
@@ -108,6 +111,24 @@ func (m *Master) OnWorkerDispatched(worker lib.WorkerHandle, result error) error
 	return nil
 }
 
+// OnWorkerMigrated implements lib.WorkerMigrator, the new worker takes the
+// place of the old one in the worker list.
+func (m *Master) OnWorkerMigrated(oldWorker lib.WorkerHandle, newWorker lib.WorkerHandle) error {
+	log.L().Info("FakeMaster: OnWorkerMigrated",
+		zap.String("worker-id", oldWorker.ID()),
+		zap.String("new-worker-id", newWorker.ID()))
+
+	m.workerListMu.Lock()
+	defer m.workerListMu.Unlock()
+
+	for i, handle := range m.workerList {
+		if handle != nil && handle.ID() == oldWorker.ID() {
+			m.workerList[i] = newWorker
+		}
+	}
+	return nil
+}
+
 func (m *Master) OnWorkerOnline(worker lib.WorkerHandle) error {
 	log.L().Info("FakeMaster: OnWorkerOnline",
 		zap.String("worker-id", worker.ID()))
@@ -131,6 +152,21 @@ func (m *Master) OnWorkerMessage(worker lib.WorkerHandle, topic p2p.Topic, messa
 	return nil
 }
 
+func (m *Master) OnPause(ctx context.Context) error {
+	log.L().Info("FakeMaster: OnPause")
+	return nil
+}
+
+func (m *Master) OnResume(ctx context.Context) error {
+	log.L().Info("FakeMaster: OnResume")
+	return nil
+}
+
+func (m *Master) OnConfigUpdated(ctx context.Context, config []byte) error {
+	log.L().Info("FakeMaster: OnConfigUpdated", zap.ByteString("config", config))
+	return nil
+}
+
 func (m *Master) CloseImpl(ctx context.Context) error {
 	log.L().Info("FakeMaster: Close", zap.Stack("stack"))
 	return nil
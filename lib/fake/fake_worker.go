@@ -2,6 +2,7 @@ package fake
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"sync/atomic"
 
@@ -12,7 +13,10 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ lib.Worker = (*dummyWorker)(nil)
+var (
+	_ lib.Worker             = (*dummyWorker)(nil)
+	_ lib.CheckpointReceiver = (*dummyWorker)(nil)
+)
 
 type (
 	Worker      = dummyWorker
@@ -48,6 +52,19 @@ func (d *dummyWorker) Tick(ctx context.Context) error {
 	return nil
 }
 
+// OnCheckpoint implements lib.CheckpointReceiver, the worker continues
+// from the ticks of the worker it replaces.
+func (d *dummyWorker) OnCheckpoint(_ context.Context, checkpoint []byte) error {
+	var tick int64
+	if err := json.Unmarshal(checkpoint, &tick); err != nil {
+		return err
+	}
+	if tick > d.tick {
+		d.tick = tick
+	}
+	return nil
+}
+
 func (d *dummyWorker) Status() lib.WorkerStatus {
 	if d.init {
 		return lib.WorkerStatus{Code: lib.WorkerStatusNormal, Ext: d.tick}
@@ -63,6 +80,14 @@ func (d *dummyWorker) OnMasterFailover(_ lib.MasterFailoverReason) error {
 	return nil
 }
 
+func (d *dummyWorker) OnPause(_ context.Context) error {
+	return nil
+}
+
+func (d *dummyWorker) OnResume(_ context.Context) error {
+	return nil
+}
+
 func (d *dummyWorker) CloseImpl(ctx context.Context) error {
 	atomic.StoreInt32(&d.closed, 1)
 	return nil
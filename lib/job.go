@@ -2,6 +2,7 @@ package lib
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // MasterMetaExt holds the config of a job and used for failover
@@ -13,6 +14,21 @@ type MasterMetaExt struct {
 	// reflected to real type via DeserializeConfig
 	Config     []byte `json:"config"`
 	Checkpoint []byte `json:"checkpoint"`
+
+	// RestartPolicy is taken from the "restart-policy" field of the raw
+	// config, the default policy is used if it is nil.
+	RestartPolicy *RestartPolicy `json:"restart-policy,omitempty"`
+	// SubmitTime is when the job is submitted, it is the start time of the job.
+	SubmitTime time.Time `json:"submit-time"`
+	// Priority decides the order in which the job is admitted when the
+	// cluster resource is not enough, higher is first.
+	Priority int32 `json:"priority,omitempty"`
+	// DependsOn are the upstream jobs that must be finished before the job
+	// is started.
+	DependsOn []MasterID `json:"depends-on,omitempty"`
+	// ConfigVersion is increased each time Config is updated after the job
+	// is submitted.
+	ConfigVersion int64 `json:"config-version,omitempty"`
 }
 
 func (meta *MasterMetaExt) Marshal() ([]byte, error) {
@@ -22,3 +38,42 @@ func (meta *MasterMetaExt) Marshal() ([]byte, error) {
 func (meta *MasterMetaExt) Unmarshal(data []byte) error {
 	return json.Unmarshal(data, meta)
 }
+
+// RestartPolicy controls how a job master is created again after it fails.
+// The backoff starts from InitialBackoffMs and doubles after each failure,
+// up to MaxBackoffMs. The job fails after its job master has been created
+// MaxAttempts times, 0 means no limit.
+type RestartPolicy struct {
+	MaxAttempts      int   `json:"max-attempts"`
+	InitialBackoffMs int64 `json:"initial-backoff-ms"`
+	MaxBackoffMs     int64 `json:"max-backoff-ms"`
+}
+
+// DefaultRestartPolicy is used by the jobs that have no restart policy.
+var DefaultRestartPolicy = RestartPolicy{
+	MaxAttempts:      5,
+	InitialBackoffMs: 1000,
+	MaxBackoffMs:     60000,
+}
+
+// Backoff returns how long to wait before creating the job master again
+// after the given number of failures.
+func (p *RestartPolicy) Backoff(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	backoff := p.InitialBackoffMs
+	for i := 1; i < failures && backoff < p.MaxBackoffMs; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoffMs {
+		backoff = p.MaxBackoffMs
+	}
+	return time.Duration(backoff) * time.Millisecond
+}
+
+// Exhausted returns whether the job master should not be created again
+// after the given number of failures.
+func (p *RestartPolicy) Exhausted(failures int) bool {
+	return p.MaxAttempts > 0 && failures >= p.MaxAttempts
+}
@@ -55,6 +55,19 @@ type MasterImpl interface {
 	// OnWorkerMessage is called when a customized message is received.
 	OnWorkerMessage(worker WorkerHandle, topic p2p.Topic, message interface{}) error
 
+	// OnPause is called when the master is paused, before its workers are
+	// asked to pause. Tick is not called until the master is resumed.
+	OnPause(ctx context.Context) error
+
+	// OnResume is called when a paused master is resumed, before its workers
+	// are asked to resume.
+	OnResume(ctx context.Context) error
+
+	// OnConfigUpdated is called when the config of the job is updated. The
+	// config is rejected if an error is returned, and the error is reported
+	// to the job manager.
+	OnConfigUpdated(ctx context.Context, config []byte) error
+
 	// CloseImpl is called when the master is being closed
 	CloseImpl(ctx context.Context) error
 
@@ -64,8 +77,89 @@ type MasterImpl interface {
 	GetWorkerStatusExtTypeInfo() interface{}
 }
 
+// WorkerMigrator is implemented by the MasterImpl whose workers can be moved
+// to another executor while running, see DefaultBaseMaster.MigrateWorker.
+type WorkerMigrator interface {
+	// OnWorkerMigrated is called after the checkpoint of the old worker has
+	// been handed off to the new worker, and before the old worker is
+	// stopped. The master should replace the old worker with the new one.
+	// OnWorkerDispatched and OnWorkerOnline are not called for the new
+	// worker, nor is OnWorkerOffline called for the old one.
+	OnWorkerMigrated(oldWorker WorkerHandle, newWorker WorkerHandle) error
+}
+
+// CreateWorkerOpt is an option of CreateWorker.
+type CreateWorkerOpt = func(*createWorkerOpts)
+
+type createWorkerOpts struct {
+	resource         *model.Resource
+	location         string
+	locationRequired bool
+	strategy         string
+	labelSelector    map[string]string
+	affinity         pb.WorkerAffinity
+	affinityRequired bool
+	priority         *int32
+}
+
+// WithResource specifies the resource the worker requires in each dimension,
+// the cost passed to CreateWorker is ignored if it's specified.
+func WithResource(resource model.Resource) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.resource = &resource
+	}
+}
+
+// WithPreferredLocation asks to schedule the worker to the executors at the
+// location, which is an executor ID, an address, a host or a label in the form
+// of "key=value". If required is false, the worker can be scheduled to any
+// executor when the preferred ones are full.
+func WithPreferredLocation(location string, required bool) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.location = location
+		opts.locationRequired = required
+	}
+}
+
+// WithSchedulingStrategy asks to schedule the worker with the named strategy,
+// such as "spread", "bin-pack" or "random", instead of the default one of the
+// cluster.
+func WithSchedulingStrategy(strategy string) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.strategy = strategy
+	}
+}
+
+// WithLabelSelector asks to schedule the worker to the executors that have all
+// of the labels.
+func WithLabelSelector(selector map[string]string) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.labelSelector = selector
+	}
+}
+
+// WithAffinity asks to schedule the worker together with or away from the
+// other workers of the master. If required is false, it's only a preference.
+func WithAffinity(affinity pb.WorkerAffinity, required bool) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.affinity = affinity
+		opts.affinityRequired = required
+	}
+}
+
+// WithPriority specifies the priority of the worker, the priority of the job
+// is used by default. If the resource is not enough, the workers of lower
+// priority are preempted for the worker.
+func WithPriority(priority int32) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.priority = &priority
+	}
+}
+
 const (
 	createWorkerTimeout        = 10 * time.Second
+	releaseResourceTimeout     = 3 * time.Second
+	evictWorkerTimeout         = 3 * time.Second
 	maxCreateWorkerConcurrency = 100
 )
 
@@ -77,7 +171,18 @@ type BaseMaster interface {
 	GetWorkers() map[WorkerID]WorkerHandle
 	Close(ctx context.Context) error
 	OnError(err error)
-	CreateWorker(workerType WorkerType, config WorkerConfig, cost model.RescUnit) (WorkerID, error)
+	CreateWorker(workerType WorkerType, config WorkerConfig, cost model.RescUnit, opts ...CreateWorkerOpt) (WorkerID, error)
+	StopWorker(ctx context.Context, workerID WorkerID) error
+	EvictWorker(ctx context.Context, workerID WorkerID, reason error) error
+	MigrateWorker(workerID WorkerID, target model.ExecutorID) error
+	PauseWorker(ctx context.Context, workerID WorkerID) error
+	ResumeWorker(ctx context.Context, workerID WorkerID) error
+	UpdateWorkerConfig(ctx context.Context, workerID WorkerID, config []byte, version int64) error
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	IsPaused() bool
+	RecoverWorker(ctx context.Context, workerID WorkerID) error
+	IsMasterReady() bool
 	GetWorkerStatusExtTypeInfo() interface{}
 }
 
@@ -100,6 +205,31 @@ type DefaultBaseMaster struct {
 
 	currentEpoch atomic.Int64
 
+	// paused is loaded from metadata on Init, and is updated by Pause and Resume.
+	paused atomic.Bool
+
+	// recoveringWorkers are the workers added by RecoverWorker after failover.
+	// ready is set after the workers of previous epochs have been taken over,
+	// the handlers of the recovering workers that do not come online in time
+	// are removed then.
+	recoveringMu      sync.Mutex
+	recoveringWorkers map[WorkerID]struct{}
+	ready             atomic.Bool
+
+	// evictedWorkers are the workers being stopped at the request of the
+	// server master, the values are the reasons passed to OnWorkerOffline.
+	evictedMu      sync.Mutex
+	evictedWorkers map[WorkerID]error
+
+	// workerSpecs are how the workers have been created, a worker is created
+	// again with its spec when it is migrated. migrations are the ongoing
+	// migrations keyed by the new workers, and replacedWorkers are the old
+	// workers being stopped after their migrations.
+	migrationMu     sync.Mutex
+	workerSpecs     map[WorkerID]*workerSpec
+	migrations      map[WorkerID]*workerMigration
+	replacedWorkers map[WorkerID]struct{}
+
 	wg    sync.WaitGroup
 	errCh chan error
 
@@ -162,6 +292,12 @@ func NewBaseMaster(
 
 		uuidGen: uuid.NewGenerator(),
 
+		recoveringWorkers: make(map[WorkerID]struct{}),
+		evictedWorkers:    make(map[WorkerID]error),
+		workerSpecs:       make(map[WorkerID]*workerSpec),
+		migrations:        make(map[WorkerID]*workerMigration),
+		replacedWorkers:   make(map[WorkerID]struct{}),
+
 		nodeID:        nodeID,
 		advertiseAddr: advertiseAddr,
 
@@ -179,7 +315,13 @@ func (m *DefaultBaseMaster) Init(ctx context.Context) error {
 		return errors.Trace(err)
 	}
 	m.currentEpoch.Store(epoch)
-	m.workerManager = newWorkerManager(m.id, !isInit, epoch)
+	m.workerManager = newWorkerManager(m.id, !isInit, epoch, m.messageSender)
+	// There are no workers to take over if the master starts for the first time.
+	m.ready.Store(isInit)
+
+	if err := m.registerEvictionHandlers(ctx); err != nil {
+		return errors.Trace(err)
+	}
 
 	m.startBackgroundTasks()
 
@@ -193,6 +335,14 @@ func (m *DefaultBaseMaster) Init(ctx context.Context) error {
 		}
 	}
 
+	if m.paused.Load() {
+		// The master has been paused before failover, the workers will be
+		// asked to pause again when they come online.
+		if err := m.Impl.OnPause(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	if err := m.markInitializedInMetadata(ctx); err != nil {
 		return errors.Trace(err)
 	}
@@ -214,6 +364,10 @@ func (m *DefaultBaseMaster) Poll(ctx context.Context) error {
 		return errors.Trace(err)
 	}
 
+	if m.paused.Load() {
+		return nil
+	}
+
 	if err := m.Impl.Tick(ctx); err != nil {
 		return errors.Trace(err)
 	}
@@ -277,6 +431,10 @@ func (m *DefaultBaseMaster) runWorkerCheck(ctx context.Context) error {
 		case <-ticker.C:
 		}
 
+		if err := m.checkReady(ctx); err != nil {
+			return errors.Trace(err)
+		}
+
 		offlinedWorkers, onlinedWorkers := m.workerManager.Tick(ctx, m.messageSender)
 		// It is logical to call `OnWorkerOnline` first and then call `OnWorkerOffline`.
 		// In case that these two events for the same worker is detected in the same tick.
@@ -284,10 +442,23 @@ func (m *DefaultBaseMaster) runWorkerCheck(ctx context.Context) error {
 			log.L().Info("worker is online", zap.Any("worker-info", workerInfo))
 
 			handle := m.workerManager.GetWorkerHandle(workerInfo.ID)
+			if m.isMigrationTarget(workerInfo.ID) {
+				if err := m.completeMigration(ctx, handle); err != nil {
+					return errors.Trace(err)
+				}
+				continue
+			}
 			err := m.Impl.OnWorkerOnline(handle)
 			if err != nil {
 				return errors.Trace(err)
 			}
+
+			if m.paused.Load() {
+				if err := m.PauseWorker(ctx, workerInfo.ID); err != nil {
+					log.L().Warn("failed to pause online worker",
+						zap.String("worker-id", workerInfo.ID), zap.Error(err))
+				}
+			}
 		}
 
 		for _, workerInfo := range offlinedWorkers {
@@ -297,7 +468,11 @@ func (m *DefaultBaseMaster) runWorkerCheck(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
-			err = m.Impl.OnWorkerOffline(tombstoneHandle, derror.ErrWorkerOffline.GenWithStackByArgs(workerInfo.ID))
+			reason := m.offlineReason(workerInfo.ID)
+			if m.forgetWorker(workerInfo.ID) {
+				continue
+			}
+			err = m.Impl.OnWorkerOffline(tombstoneHandle, reason)
 			if err != nil {
 				return errors.Trace(err)
 			}
@@ -305,6 +480,51 @@ func (m *DefaultBaseMaster) runWorkerCheck(ctx context.Context) error {
 	}
 }
 
+// offlineReason returns the reason why a worker has gone offline.
+func (m *DefaultBaseMaster) offlineReason(workerID WorkerID) error {
+	m.evictedMu.Lock()
+	defer m.evictedMu.Unlock()
+	if reason, ok := m.evictedWorkers[workerID]; ok {
+		delete(m.evictedWorkers, workerID)
+		return reason
+	}
+	return derror.ErrWorkerOffline.GenWithStackByArgs(workerID)
+}
+
+// checkReady marks the master as ready after the workers of previous epochs
+// have been taken over, and removes the message handlers of the recovering
+// workers that have not sent heartbeats.
+func (m *DefaultBaseMaster) checkReady(ctx context.Context) error {
+	if m.ready.Load() {
+		return nil
+	}
+	initialized, err := m.workerManager.IsInitialized(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !initialized {
+		return nil
+	}
+
+	m.recoveringMu.Lock()
+	recoveringWorkers := m.recoveringWorkers
+	m.recoveringWorkers = make(map[WorkerID]struct{})
+	m.recoveringMu.Unlock()
+
+	for workerID := range recoveringWorkers {
+		if _, ok := m.workerManager.GetWorkerInfo(workerID); ok {
+			continue
+		}
+		log.L().Info("recovering worker is not online, give it up",
+			zap.String("worker-id", workerID))
+		if err := m.unregisterMessageHandler(ctx, workerID); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	m.ready.Store(true)
+	return nil
+}
+
 func (m *DefaultBaseMaster) unregisterMessageHandler(ctx context.Context, workerID WorkerID) error {
 	topic := HeartbeatPingTopic(m.id, workerID)
 	removed, err := m.messageHandlerManager.UnregisterHandler(ctx, topic)
@@ -343,51 +563,47 @@ func (m *DefaultBaseMaster) initMetadata(ctx context.Context) (isInit bool, epoc
 	// TODO refine this logic to make it correct and easier to understand.
 
 	metaClient := NewMasterMetadataClient(m.id, m.metaKVClient)
-	masterMeta, err := metaClient.Load(ctx)
-	if err != nil {
-		if !derror.ErrMasterNotFound.Equal(err) {
-			return false, 0, errors.Trace(err)
-		}
-		// master starts up for the first time, no metadata in metastore
-		masterMeta = &MasterMetaKVData{ID: m.id}
-		err = metaClient.Store(ctx, masterMeta)
-		if err != nil {
-			return false, 0, errors.Trace(err)
-		}
-	}
-
 	epoch, err = metaClient.GenerateEpoch(ctx)
 	if err != nil {
 		return false, 0, errors.Trace(err)
 	}
 
-	isInit = !masterMeta.Initialized
-
-	// We should update the master data to reflect our current information
-	masterMeta.Addr = m.advertiseAddr
-	masterMeta.NodeID = m.nodeID
-	masterMeta.Epoch = epoch
-	masterMeta.MasterMetaExt = m.masterMetaExt
-
-	if err := metaClient.Store(ctx, masterMeta); err != nil {
+	var paused bool
+	// The status written by the JobManager, such as Paused, is kept.
+	err = metaClient.Update(ctx, func(masterMeta *MasterMetaKVData) error {
+		isInit = !masterMeta.Initialized
+		paused = masterMeta.StatusCode == MasterStatusPaused
+
+		// We should update the master data to reflect our current information
+		masterMeta.Addr = m.advertiseAddr
+		masterMeta.NodeID = m.nodeID
+		masterMeta.Epoch = epoch
+		masterMeta.MasterMetaExt = m.masterMetaExt
+		return nil
+	})
+	if err != nil {
 		return false, 0, errors.Trace(err)
 	}
+	m.paused.Store(paused)
 	return
 }
 
 func (m *DefaultBaseMaster) markInitializedInMetadata(ctx context.Context) error {
 	metaClient := NewMasterMetadataClient(m.id, m.metaKVClient)
-	masterMeta, err := metaClient.Load(ctx)
-	if err != nil {
-		return errors.Trace(err)
-	}
-
-	masterMeta.Initialized = true
-	if err := metaClient.Store(ctx, masterMeta); err != nil {
-		return errors.Trace(err)
-	}
+	err := metaClient.Update(ctx, func(masterMeta *MasterMetaKVData) error {
+		masterMeta.Initialized = true
+		return nil
+	})
+	return errors.Trace(err)
+}
 
-	return nil
+func (m *DefaultBaseMaster) updateStatusInMetadata(ctx context.Context, code MasterStatusCode) error {
+	metaClient := NewMasterMetadataClient(m.id, m.metaKVClient)
+	err := metaClient.Update(ctx, func(masterMeta *MasterMetaKVData) error {
+		masterMeta.StatusCode = code
+		return nil
+	})
+	return errors.Trace(err)
 }
 
 func (m *DefaultBaseMaster) registerHandlerForWorker(ctx context.Context, workerID WorkerID) error {
@@ -415,7 +631,9 @@ func (m *DefaultBaseMaster) registerHandlerForWorker(ctx context.Context, worker
 		return errors.Trace(err)
 	}
 	if !ok {
-		log.L().Panic("duplicate handler",
+		// A job master is created again with the same worker ID after it
+		// fails, the handlers registered before can be reused.
+		log.L().Info("handler is already registered",
 			zap.String("topic", topic))
 	}
 
@@ -437,12 +655,99 @@ func (m *DefaultBaseMaster) registerHandlerForWorker(ctx context.Context, worker
 		return errors.Trace(err)
 	}
 	if !ok {
-		log.L().Panic("duplicate handler",
+		log.L().Info("handler is already registered",
+			zap.String("topic", topic))
+	}
+	return nil
+}
+
+// registerEvictionHandlers registers the handlers of the requests from the
+// server master to stop the workers being preempted or migrated.
+func (m *DefaultBaseMaster) registerEvictionHandlers(ctx context.Context) error {
+	topic := PreemptWorkerTopic(m.id)
+	ok, err := m.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&PreemptWorkerMessage{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg := value.(*PreemptWorkerMessage)
+			log.L().Info("worker is preempted", zap.Any("msg", msg))
+			m.handleEvictWorker(msg.WorkerID,
+				derror.ErrWorkerPreempted.GenWithStackByArgs(msg.WorkerID, msg.Reason))
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		log.L().Info("handler is already registered",
+			zap.String("topic", topic))
+	}
+
+	topic = MigrateWorkerTopic(m.id)
+	ok, err = m.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&MigrateWorkerMessage{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg := value.(*MigrateWorkerMessage)
+			log.L().Info("worker is asked to migrate", zap.Any("msg", msg))
+			if msg.Target != "" {
+				// Migrating a worker registers the handlers of the new worker,
+				// which can't be done inside a message handler.
+				go m.handleMigrateWorker(msg.WorkerID, msg.Target)
+				return nil
+			}
+			m.handleEvictWorker(msg.WorkerID,
+				derror.ErrWorkerMigrated.GenWithStackByArgs(msg.WorkerID, msg.Reason))
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		log.L().Info("handler is already registered",
 			zap.String("topic", topic))
 	}
 	return nil
 }
 
+// handleEvictWorker stops a worker at the request of the server master, the
+// stop message is sent without blocking the message handler.
+func (m *DefaultBaseMaster) handleEvictWorker(workerID WorkerID, reason error) {
+	ctx, cancel := context.WithTimeout(context.Background(), evictWorkerTimeout)
+	defer cancel()
+	if err := m.EvictWorker(ctx, workerID, reason); err != nil {
+		log.L().Warn("failed to evict worker",
+			zap.String("worker-id", workerID), zap.Error(err))
+	}
+}
+
+func (m *DefaultBaseMaster) handleMigrateWorker(workerID WorkerID, target model.ExecutorID) {
+	if err := m.MigrateWorker(workerID, target); err != nil {
+		log.L().Warn("failed to migrate worker",
+			zap.String("worker-id", workerID), zap.Error(err))
+	}
+}
+
+// releaseResource releases the resource reserved for the worker after it
+// fails to be dispatched. It's not called if the result of the dispatch is
+// unknown, the server master releases the resource if the worker is not
+// reported by the executor in time.
+func (m *DefaultBaseMaster) releaseResource(workerID WorkerID) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseResourceTimeout)
+	defer cancel()
+	resp, err := m.serverMasterClient.ReleaseResource(ctx,
+		&pb.ReleaseResourceRequest{WorkerIds: []string{workerID}}, releaseResourceTimeout)
+	if err == nil && resp.Err != nil {
+		err = errors.New(resp.Err.GetMessage())
+	}
+	if err != nil {
+		log.L().Warn("failed to release resource of worker",
+			zap.String("worker-id", workerID), zap.Error(err))
+	}
+}
+
 // generateWorkerID assigns a new worker id.
 // When creating a job master, job manager provides a pre allocated ID, in other
 // cases, we need to generate a random WorkerID.
@@ -459,22 +764,96 @@ func (m *DefaultBaseMaster) generateWorkerID(workerType WorkerType, config Worke
 	return m.uuidGen.NewString()
 }
 
-func (m *DefaultBaseMaster) CreateWorker(workerType WorkerType, config WorkerConfig, cost model.RescUnit) (WorkerID, error) {
+// workerPriority returns the default priority of a worker, which is the
+// priority of its job. A job master is not preemptible, since the whole job
+// would be interrupted.
+func (m *DefaultBaseMaster) workerPriority(workerType WorkerType, config WorkerConfig) (int32, bool) {
+	switch workerType {
+	case CvsJobMaster, FakeJobMaster:
+		if masterCfg, ok := config.(*MasterMetaExt); ok {
+			return masterCfg.Priority, true
+		}
+	default:
+	}
+	return m.masterMetaExt.Priority, false
+}
+
+// marshalWorkerConfig serializes the config of a worker. A job master is
+// created with its MasterMetaExt, in which case the raw config of the job is
+// passed to the job master, so that the MasterMetaExt rebuilt by the executor
+// is the same as the one submitted.
+func (m *DefaultBaseMaster) marshalWorkerConfig(workerType WorkerType, config WorkerConfig) ([]byte, error) {
+	switch workerType {
+	case CvsJobMaster, FakeJobMaster:
+		if masterCfg, ok := config.(*MasterMetaExt); ok {
+			return masterCfg.Config, nil
+		}
+	default:
+	}
+	return json.Marshal(config)
+}
+
+func (m *DefaultBaseMaster) CreateWorker(
+	workerType WorkerType, config WorkerConfig, cost model.RescUnit, opts ...CreateWorkerOpt,
+) (WorkerID, error) {
+	return m.createWorker(&workerSpec{tp: workerType, config: config, cost: cost, opts: opts}, "")
+}
+
+// createWorker creates a worker with the spec, migrateFrom is the worker
+// that the new worker replaces if it is created for a migration.
+func (m *DefaultBaseMaster) createWorker(spec *workerSpec, migrateFrom WorkerID) (WorkerID, error) {
+	workerType, config, cost, opts := spec.tp, spec.config, spec.cost, spec.opts
 	log.L().Info("CreateWorker",
 		zap.Int64("worker-type", int64(workerType)),
 		zap.Any("worker-config", config))
 
-	configBytes, err := json.Marshal(config)
+	createOpts := &createWorkerOpts{}
+	for _, opt := range opts {
+		opt(createOpts)
+	}
+	configBytes, err := m.marshalWorkerConfig(workerType, config)
 	if err != nil {
 		return "", errors.Trace(err)
 	}
 
 	// workerID is expected to be globally unique.
 	workerID := m.generateWorkerID(workerType, config)
+	priority, nonPreemptible := m.workerPriority(workerType, config)
+	if createOpts.priority != nil {
+		priority = *createOpts.priority
+	}
+
+	task := &pb.ScheduleTask{
+		Task: &pb.TaskRequest{
+			Id: 0,
+		},
+		Cost:              int64(cost),
+		PreferredLocation: createOpts.location,
+		LocationRequired:  createOpts.locationRequired,
+		Strategy:          createOpts.strategy,
+		WorkerId:          workerID,
+		MasterId:          m.id,
+		LabelSelector:     createOpts.labelSelector,
+		Affinity:          createOpts.affinity,
+		AffinityRequired:  createOpts.affinityRequired,
+		Priority:          priority,
+		NonPreemptible:    nonPreemptible,
+		WorkerType:        int64(workerType),
+	}
+	if createOpts.resource != nil {
+		task.Cost = int64(createOpts.resource.CPU)
+		task.Resource = createOpts.resource.ToPB()
+	}
 
 	if !m.createWorkerQuota.TryConsume() {
 		return "", derror.ErrMasterConcurrencyExceeded.GenWithStackByArgs()
 	}
+	m.migrationMu.Lock()
+	m.workerSpecs[workerID] = spec
+	if migrateFrom != "" {
+		m.migrations[workerID] = &workerMigration{from: migrateFrom}
+	}
+	m.migrationMu.Unlock()
 
 	go func() {
 		defer m.createWorkerQuota.Release()
@@ -486,16 +865,14 @@ func (m *DefaultBaseMaster) CreateWorker(workerType WorkerType, config WorkerCon
 		requestCtx, cancel := context.WithTimeout(context.Background(), createWorkerTimeout)
 		defer cancel()
 		// This following API should be refined.
-		resp, err := m.serverMasterClient.ScheduleTask(requestCtx, &pb.TaskSchedulerRequest{Tasks: []*pb.ScheduleTask{{
-			Task: &pb.TaskRequest{
-				Id: 0,
-			},
-			Cost: int64(cost),
-		}}},
+		resp, err := m.serverMasterClient.ScheduleTask(requestCtx, &pb.TaskSchedulerRequest{Tasks: []*pb.ScheduleTask{task}},
 			// TODO (zixiong) make the timeout configurable
 			time.Second*10)
+		if err == nil && resp.Err != nil {
+			err = scheduleTaskError(resp.Err)
+		}
 		if err != nil {
-			err1 := m.Impl.OnWorkerDispatched(dispatchFailedDummyHandler, errors.Trace(err))
+			err1 := m.onWorkerDispatched(dispatchFailedDummyHandler, errors.Trace(err))
 			if err1 != nil {
 				m.OnError(errors.Trace(err1))
 			}
@@ -509,7 +886,8 @@ func (m *DefaultBaseMaster) CreateWorker(workerType WorkerType, config WorkerCon
 
 		err = m.executorClientManager.AddExecutor(executorID, schedule[0].Addr)
 		if err != nil {
-			err1 := m.Impl.OnWorkerDispatched(dispatchFailedDummyHandler, errors.Trace(err))
+			m.releaseResource(workerID)
+			err1 := m.onWorkerDispatched(dispatchFailedDummyHandler, errors.Trace(err))
 			if err1 != nil {
 				m.OnError(errors.Trace(err1))
 			}
@@ -519,14 +897,19 @@ func (m *DefaultBaseMaster) CreateWorker(workerType WorkerType, config WorkerCon
 		executorResp, err := executorClient.Send(requestCtx, &client.ExecutorRequest{
 			Cmd: client.CmdDispatchTask,
 			Req: &pb.DispatchTaskRequest{
-				TaskTypeId: int64(workerType),
-				TaskConfig: configBytes,
-				MasterId:   m.id,
-				WorkerId:   workerID,
+				TaskTypeId:     int64(workerType),
+				TaskConfig:     configBytes,
+				MasterId:       m.id,
+				WorkerId:       workerID,
+				Resource:       model.NewResourceFromPB(task.GetResource(), task.GetCost()).ToPB(),
+				Priority:       task.GetPriority(),
+				NonPreemptible: task.GetNonPreemptible(),
+				Pinned: task.GetLocationRequired() || task.GetAffinityRequired() ||
+					len(task.GetLabelSelector()) > 0,
 			},
 		})
 		if err != nil {
-			err1 := m.Impl.OnWorkerDispatched(dispatchFailedDummyHandler, errors.Trace(err))
+			err1 := m.onWorkerDispatched(dispatchFailedDummyHandler, errors.Trace(err))
 			if err1 != nil {
 				m.OnError(errors.Trace(err1))
 			}
@@ -536,7 +919,8 @@ func (m *DefaultBaseMaster) CreateWorker(workerType WorkerType, config WorkerCon
 		log.L().Info("Worker dispatched", zap.Any("response", dispatchTaskResp))
 		errCode := dispatchTaskResp.GetErrorCode()
 		if errCode != pb.DispatchTaskErrorCode_OK {
-			err1 := m.Impl.OnWorkerDispatched(dispatchFailedDummyHandler,
+			m.releaseResource(workerID)
+			err1 := m.onWorkerDispatched(dispatchFailedDummyHandler,
 				errors.Errorf("dispatch worker failed with error code: %d", errCode))
 			if err1 != nil {
 				m.OnError(errors.Trace(err1))
@@ -549,7 +933,7 @@ func (m *DefaultBaseMaster) CreateWorker(workerType WorkerType, config WorkerCon
 		}
 		handle := m.workerManager.GetWorkerHandle(workerID)
 
-		if err := m.Impl.OnWorkerDispatched(handle, nil); err != nil {
+		if err := m.onWorkerDispatched(handle, nil); err != nil {
 			m.OnError(errors.Trace(err))
 		}
 	}()
@@ -563,6 +947,351 @@ func (m *DefaultBaseMaster) CreateWorker(workerType WorkerType, config WorkerCon
 	return workerID, nil
 }
 
+// workerSpec is how a worker is created.
+type workerSpec struct {
+	tp     WorkerType
+	config WorkerConfig
+	cost   model.RescUnit
+	opts   []CreateWorkerOpt
+}
+
+// workerMigration is an ongoing migration, from is the worker being replaced.
+type workerMigration struct {
+	from WorkerID
+}
+
+// onWorkerDispatched calls MasterImpl.OnWorkerDispatched, unless the worker
+// is created for a migration, in which case the migration is aborted if the
+// worker fails to be dispatched.
+func (m *DefaultBaseMaster) onWorkerDispatched(handle WorkerHandle, result error) error {
+	if m.isMigrationTarget(handle.ID()) {
+		if result != nil {
+			log.L().Warn("migration is aborted since the new worker fails to be dispatched",
+				zap.String("worker-id", handle.ID()), zap.Error(result))
+			m.forgetWorker(handle.ID())
+		}
+		return nil
+	}
+	if result != nil {
+		m.forgetWorker(handle.ID())
+	}
+	return m.Impl.OnWorkerDispatched(handle, result)
+}
+
+// scheduleTaskError converts the error in TaskSchedulerResponse, so that the
+// master can tell whether the worker is rejected for lack of resource.
+func scheduleTaskError(pbErr *pb.Error) error {
+	if pbErr.Code == pb.ErrorCode_NotEnoughResource {
+		return derror.ErrClusterResourceNotEnough.GenWithStackByArgs()
+	}
+	return errors.Errorf("schedule task failed: %s", pbErr.Message)
+}
+
+// StopWorker asks a worker to exit. The worker is not removed at once, the
+// master is notified by OnWorkerOffline after the worker has gone offline.
+func (m *DefaultBaseMaster) StopWorker(ctx context.Context, workerID WorkerID) error {
+	log.L().Info("StopWorker", zap.String("worker-id", workerID))
+
+	handle := m.workerManager.GetWorkerHandle(workerID)
+	err := handle.SendMessage(ctx, StopWorkerTopic, &StopWorkerMessage{
+		WorkerID: workerID,
+		Epoch:    m.currentEpoch.Load(),
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// EvictWorker asks a worker to exit at the request of the server master, the
+// reason is passed to OnWorkerOffline after the worker has gone offline, so
+// that the master can create the worker again elsewhere.
+func (m *DefaultBaseMaster) EvictWorker(ctx context.Context, workerID WorkerID, reason error) error {
+	m.evictedMu.Lock()
+	m.evictedWorkers[workerID] = reason
+	m.evictedMu.Unlock()
+	return m.StopWorker(ctx, workerID)
+}
+
+// MigrateWorker moves a running worker to the target executor without
+// interrupting it. A new worker is created in the target executor with the
+// spec of the old worker, the checkpoint of the old worker, which is the Ext
+// of its latest status, is handed off to the new worker after it comes
+// online, and then the old worker is stopped. The old worker keeps running if
+// the new worker fails to come online. The MasterImpl must implement
+// WorkerMigrator, and the new worker receives the checkpoint if its
+// WorkerImpl implements CheckpointReceiver.
+func (m *DefaultBaseMaster) MigrateWorker(workerID WorkerID, target model.ExecutorID) error {
+	log.L().Info("MigrateWorker", zap.String("worker-id", workerID),
+		zap.String("target", string(target)))
+
+	if _, ok := m.Impl.(WorkerMigrator); !ok {
+		return derror.ErrWorkerNotMigratable.GenWithStackByArgs(workerID, "master does not support migration")
+	}
+	if m.workerManager.GetWorkerHandle(workerID).Status() == nil {
+		return derror.ErrWorkerNotFound.GenWithStackByArgs(workerID)
+	}
+
+	m.migrationMu.Lock()
+	spec, ok := m.workerSpecs[workerID]
+	if !ok {
+		m.migrationMu.Unlock()
+		return derror.ErrWorkerNotMigratable.GenWithStackByArgs(workerID, "worker is not created in this epoch")
+	}
+	if _, ok := m.migrations[workerID]; ok {
+		m.migrationMu.Unlock()
+		return derror.ErrWorkerNotMigratable.GenWithStackByArgs(workerID, "worker has not taken over yet")
+	}
+	for _, migration := range m.migrations {
+		if migration.from == workerID {
+			m.migrationMu.Unlock()
+			return derror.ErrWorkerNotMigratable.GenWithStackByArgs(workerID, "worker is being migrated")
+		}
+	}
+	m.migrationMu.Unlock()
+	switch spec.tp {
+	case CvsJobMaster, FakeJobMaster:
+		// The ID of a job master is fixed.
+		return derror.ErrWorkerNotMigratable.GenWithStackByArgs(workerID, "job master can't be migrated")
+	default:
+	}
+
+	opts := make([]CreateWorkerOpt, 0, len(spec.opts)+1)
+	opts = append(opts, spec.opts...)
+	opts = append(opts, WithPreferredLocation(string(target), true))
+	newWorkerID, err := m.createWorker(&workerSpec{
+		tp: spec.tp, config: spec.config, cost: spec.cost, opts: opts,
+	}, workerID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	log.L().Info("worker for migration is created", zap.String("worker-id", workerID),
+		zap.String("new-worker-id", newWorkerID))
+	return nil
+}
+
+// isMigrationTarget returns whether the worker is created for a migration
+// and has not taken over the old worker yet.
+func (m *DefaultBaseMaster) isMigrationTarget(workerID WorkerID) bool {
+	m.migrationMu.Lock()
+	defer m.migrationMu.Unlock()
+	_, ok := m.migrations[workerID]
+	return ok
+}
+
+// completeMigration hands off the checkpoint of the old worker to the new
+// worker that has come online, and then stops the old worker. The new worker
+// is stopped instead if the old one has gone offline, since the MasterImpl
+// has handled that in OnWorkerOffline.
+func (m *DefaultBaseMaster) completeMigration(ctx context.Context, newHandle WorkerHandle) error {
+	m.migrationMu.Lock()
+	migration := m.migrations[newHandle.ID()]
+	delete(m.migrations, newHandle.ID())
+	m.migrationMu.Unlock()
+
+	oldHandle := m.workerManager.GetWorkerHandle(migration.from)
+	oldStatus := oldHandle.Status()
+	var err error
+	if oldStatus == nil {
+		err = derror.ErrWorkerOffline.GenWithStackByArgs(migration.from)
+	} else {
+		err = m.handOffCheckpoint(ctx, newHandle, oldStatus)
+	}
+	if err != nil {
+		log.L().Warn("migration is aborted since the checkpoint fails to be handed off",
+			zap.String("worker-id", migration.from),
+			zap.String("new-worker-id", newHandle.ID()), zap.Error(err))
+		m.stopReplacedWorker(ctx, newHandle.ID())
+		return nil
+	}
+
+	if err := m.Impl.(WorkerMigrator).OnWorkerMigrated(oldHandle, newHandle); err != nil {
+		return errors.Trace(err)
+	}
+	log.L().Info("worker is migrated", zap.String("worker-id", migration.from),
+		zap.String("new-worker-id", newHandle.ID()))
+	m.stopReplacedWorker(ctx, migration.from)
+	return nil
+}
+
+// handOffCheckpoint sends the last status of the old worker to the new worker.
+// The ExtBytes of the status is dropped after it's received, so the checkpoint
+// is marshaled from Ext again.
+func (m *DefaultBaseMaster) handOffCheckpoint(ctx context.Context, newHandle WorkerHandle, oldStatus *WorkerStatus) error {
+	var checkpoint []byte
+	if oldStatus.Ext != nil {
+		var err error
+		checkpoint, err = json.Marshal(oldStatus.Ext)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return newHandle.SendMessage(ctx, HandOffCheckpointTopic, &HandOffCheckpointMessage{
+		WorkerID:   newHandle.ID(),
+		Epoch:      m.currentEpoch.Load(),
+		Checkpoint: checkpoint,
+	})
+}
+
+// stopReplacedWorker stops a worker that has been replaced, the MasterImpl is
+// not notified after it goes offline.
+func (m *DefaultBaseMaster) stopReplacedWorker(ctx context.Context, workerID WorkerID) {
+	m.migrationMu.Lock()
+	m.replacedWorkers[workerID] = struct{}{}
+	m.migrationMu.Unlock()
+	if err := m.StopWorker(ctx, workerID); err != nil {
+		log.L().Warn("failed to stop replaced worker",
+			zap.String("worker-id", workerID), zap.Error(err))
+	}
+}
+
+// forgetWorker removes the states of a worker that has gone offline or has
+// failed to be dispatched. It returns true if the MasterImpl should not be
+// notified, which is the case for a replaced worker, or a worker created for
+// a migration that has not taken over the old worker.
+func (m *DefaultBaseMaster) forgetWorker(workerID WorkerID) bool {
+	m.migrationMu.Lock()
+	defer m.migrationMu.Unlock()
+	delete(m.workerSpecs, workerID)
+	if _, ok := m.replacedWorkers[workerID]; ok {
+		delete(m.replacedWorkers, workerID)
+		return true
+	}
+	if _, ok := m.migrations[workerID]; ok {
+		delete(m.migrations, workerID)
+		return true
+	}
+	return false
+}
+
+// PauseWorker asks a worker to pause, the worker calls WorkerImpl.OnPause
+// and stops ticking until it is resumed.
+func (m *DefaultBaseMaster) PauseWorker(ctx context.Context, workerID WorkerID) error {
+	log.L().Info("PauseWorker", zap.String("worker-id", workerID))
+
+	handle := m.workerManager.GetWorkerHandle(workerID)
+	err := handle.SendMessage(ctx, PauseWorkerTopic, &PauseWorkerMessage{
+		WorkerID: workerID,
+		Epoch:    m.currentEpoch.Load(),
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// ResumeWorker asks a paused worker to resume.
+func (m *DefaultBaseMaster) ResumeWorker(ctx context.Context, workerID WorkerID) error {
+	log.L().Info("ResumeWorker", zap.String("worker-id", workerID))
+
+	handle := m.workerManager.GetWorkerHandle(workerID)
+	err := handle.SendMessage(ctx, ResumeWorkerTopic, &ResumeWorkerMessage{
+		WorkerID: workerID,
+		Epoch:    m.currentEpoch.Load(),
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// UpdateWorkerConfig delivers the updated config of a job to its job master,
+// the job master calls MasterImpl.OnConfigUpdated.
+func (m *DefaultBaseMaster) UpdateWorkerConfig(ctx context.Context, workerID WorkerID, config []byte, version int64) error {
+	log.L().Info("UpdateWorkerConfig", zap.String("worker-id", workerID),
+		zap.Int64("config-version", version))
+
+	handle := m.workerManager.GetWorkerHandle(workerID)
+	err := handle.SendMessage(ctx, UpdateConfigTopic, &UpdateConfigMessage{
+		WorkerID:      workerID,
+		Epoch:         m.currentEpoch.Load(),
+		Config:        config,
+		ConfigVersion: version,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// Pause pauses the master and all of its workers. The paused state is stored
+// in metadata, so that the master stays paused after failover.
+func (m *DefaultBaseMaster) Pause(ctx context.Context) error {
+	if m.paused.Load() {
+		return nil
+	}
+	if err := m.updateStatusInMetadata(ctx, MasterStatusPaused); err != nil {
+		return errors.Trace(err)
+	}
+	m.paused.Store(true)
+
+	if err := m.Impl.OnPause(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	for workerID, handle := range m.GetWorkers() {
+		if handle.IsTombStone() {
+			continue
+		}
+		if err := m.PauseWorker(ctx, workerID); err != nil {
+			if !derror.ErrMessageNotDelivered.Equal(err) {
+				return errors.Trace(err)
+			}
+			log.L().Warn("failed to pause worker", zap.String("worker-id", workerID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Resume resumes a paused master and all of its workers.
+func (m *DefaultBaseMaster) Resume(ctx context.Context) error {
+	if !m.paused.Load() {
+		return nil
+	}
+	if err := m.updateStatusInMetadata(ctx, MasterStatusNormal); err != nil {
+		return errors.Trace(err)
+	}
+	m.paused.Store(false)
+
+	if err := m.Impl.OnResume(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	for workerID, handle := range m.GetWorkers() {
+		if handle.IsTombStone() {
+			continue
+		}
+		if err := m.ResumeWorker(ctx, workerID); err != nil {
+			if !derror.ErrMessageNotDelivered.Equal(err) {
+				return errors.Trace(err)
+			}
+			log.L().Warn("failed to resume worker", zap.String("worker-id", workerID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// RecoverWorker registers the message handlers of a worker created in a
+// previous epoch, so that the worker can be taken over if it sends heartbeats
+// before the master is ready. It should be called in OnMasterRecovered.
+func (m *DefaultBaseMaster) RecoverWorker(ctx context.Context, workerID WorkerID) error {
+	log.L().Info("RecoverWorker", zap.String("worker-id", workerID))
+
+	m.recoveringMu.Lock()
+	m.recoveringWorkers[workerID] = struct{}{}
+	m.recoveringMu.Unlock()
+
+	return errors.Trace(m.registerHandlerForWorker(ctx, workerID))
+}
+
+// IsMasterReady returns whether the workers of previous epochs have been
+// taken over, the workers that are not online by then are considered lost.
+func (m *DefaultBaseMaster) IsMasterReady() bool {
+	return m.ready.Load()
+}
+
+func (m *DefaultBaseMaster) IsPaused() bool {
+	return m.paused.Load()
+}
+
 func (m *DefaultBaseMaster) GetWorkerStatusExtTypeInfo() interface{} {
 	// This function provides a trivial default implementation of
 	// GetWorkerStatusExtTypeInfo.
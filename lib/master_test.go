@@ -11,9 +11,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/etcd/clientv3"
 
+	"github.com/hanfei1991/microcosm/client"
+	"github.com/hanfei1991/microcosm/pb"
 	"github.com/hanfei1991/microcosm/pkg/adapter"
+	"github.com/hanfei1991/microcosm/pkg/clock"
 	derror "github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/hanfei1991/microcosm/pkg/metadata"
+	"github.com/hanfei1991/microcosm/pkg/p2p"
 	"github.com/hanfei1991/microcosm/pkg/uuid"
 )
 
@@ -185,3 +189,418 @@ func TestMasterCreateWorker(t *testing.T) {
 		Ext:  &dummyStatus{Val: 4},
 	}, status)
 }
+
+func TestMasterPauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl("", masterName)
+	master.timeoutConfig.masterHeartbeatCheckLoopInterval = time.Millisecond * 10
+	prepareMeta(ctx, t, master.metaKVClient)
+
+	master.On("InitImpl", mock.Anything).Return(nil)
+	err := master.Init(ctx)
+	require.NoError(t, err)
+
+	MockBaseMasterCreateWorker(
+		t,
+		master.DefaultBaseMaster,
+		workerTypePlaceholder,
+		&dummyConfig{param: 1},
+		100,
+		masterName,
+		workerID1,
+		executorNodeID1)
+
+	_, err = master.CreateWorker(workerTypePlaceholder, &dummyConfig{param: 1}, 100)
+	require.NoError(t, err)
+	master.On("OnWorkerDispatched", mock.AnythingOfType("*lib.workerHandleImpl"), nil).Return(nil)
+	<-master.dispatchedWorkers
+
+	master.On("OnWorkerOnline", mock.AnythingOfType("*lib.workerHandleImpl")).Return(nil)
+	MockBaseMasterWorkerHeartbeat(t, master.DefaultBaseMaster, masterName, workerID1, executorNodeID1)
+	require.Eventually(t, func() bool {
+		return master.onlineWorkerCount.Load() == 1
+	}, time.Second*1, time.Millisecond*10)
+
+	master.On("OnPause", mock.Anything).Return(nil)
+	err = master.Pause(ctx)
+	require.NoError(t, err)
+	require.True(t, master.IsPaused())
+	master.AssertCalled(t, "OnPause", mock.Anything)
+
+	msgSender := master.messageSender.(*p2p.MockMessageSender)
+	msg, ok := msgSender.TryPop(executorNodeID1, workerMessageTopic(workerID1, PauseWorkerTopic))
+	require.True(t, ok)
+	require.Equal(t, &PauseWorkerMessage{WorkerID: workerID1, Epoch: master.currentEpoch.Load()}, msg)
+
+	meta, err := NewMasterMetadataClient(masterName, master.metaKVClient).Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, MasterStatusPaused, meta.StatusCode)
+
+	// Tick is not called when the master is paused
+	err = master.Poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), master.TickCount())
+
+	master.On("CloseImpl", mock.Anything).Return(nil)
+	err = master.Close(ctx)
+	require.NoError(t, err)
+
+	// The master stays paused after failover
+	master.Reset()
+	master.On("OnMasterRecovered", mock.Anything).Return(nil)
+	master.On("OnPause", mock.Anything).Return(nil)
+	err = master.Init(ctx)
+	require.NoError(t, err)
+	require.True(t, master.IsPaused())
+	master.AssertCalled(t, "OnPause", mock.Anything)
+
+	master.On("OnResume", mock.Anything).Return(nil)
+	err = master.Resume(ctx)
+	require.NoError(t, err)
+	require.False(t, master.IsPaused())
+	master.AssertCalled(t, "OnResume", mock.Anything)
+
+	meta, err = NewMasterMetadataClient(masterName, master.metaKVClient).Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, MasterStatusNormal, meta.StatusCode)
+
+	master.On("Tick", mock.Anything).Return(nil)
+	err = master.Poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), master.TickCount())
+
+	master.On("CloseImpl", mock.Anything).Return(nil)
+	err = master.Close(ctx)
+	require.NoError(t, err)
+}
+
+func TestMasterEvictWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl("", masterName)
+	master.timeoutConfig.masterHeartbeatCheckLoopInterval = time.Millisecond * 10
+	prepareMeta(ctx, t, master.metaKVClient)
+
+	master.On("InitImpl", mock.Anything).Return(nil)
+	err := master.Init(ctx)
+	require.NoError(t, err)
+	master.messageHandlerManager.AssertHasHandler(t, PreemptWorkerTopic(masterName), &PreemptWorkerMessage{})
+	master.messageHandlerManager.AssertHasHandler(t, MigrateWorkerTopic(masterName), &MigrateWorkerMessage{})
+
+	MockBaseMasterCreateWorker(
+		t,
+		master.DefaultBaseMaster,
+		workerTypePlaceholder,
+		&dummyConfig{param: 1},
+		100,
+		masterName,
+		workerID1,
+		executorNodeID1)
+
+	_, err = master.CreateWorker(workerTypePlaceholder, &dummyConfig{param: 1}, 100)
+	require.NoError(t, err)
+	master.On("OnWorkerDispatched", mock.AnythingOfType("*lib.workerHandleImpl"), nil).Return(nil)
+	<-master.dispatchedWorkers
+
+	master.On("OnWorkerOnline", mock.AnythingOfType("*lib.workerHandleImpl")).Return(nil)
+	MockBaseMasterWorkerHeartbeat(t, master.DefaultBaseMaster, masterName, workerID1, executorNodeID1)
+	require.Eventually(t, func() bool {
+		return master.onlineWorkerCount.Load() == 1
+	}, time.Second*1, time.Millisecond*10)
+
+	// the worker is asked to stop when the server master preempts it
+	err = master.messageHandlerManager.InvokeHandler(t, PreemptWorkerTopic(masterName), masterNodeName,
+		&PreemptWorkerMessage{WorkerID: workerID1, Reason: "preempted by worker worker-2"})
+	require.NoError(t, err)
+	msgSender := master.messageSender.(*p2p.MockMessageSender)
+	msg, ok := msgSender.TryPop(executorNodeID1, workerMessageTopic(workerID1, StopWorkerTopic))
+	require.True(t, ok)
+	require.Equal(t, &StopWorkerMessage{WorkerID: workerID1, Epoch: master.currentEpoch.Load()}, msg)
+
+	// the reason is passed to OnWorkerOffline only once
+	reason := master.offlineReason(workerID1)
+	require.True(t, derror.ErrWorkerPreempted.Equal(reason))
+	require.Contains(t, reason.Error(), "preempted by worker worker-2")
+	require.True(t, derror.ErrWorkerOffline.Equal(master.offlineReason(workerID1)))
+
+	// the worker is asked to stop when the executor is drained
+	err = master.messageHandlerManager.InvokeHandler(t, MigrateWorkerTopic(masterName), masterNodeName,
+		&MigrateWorkerMessage{WorkerID: workerID1, Reason: "executor node-exec-1 is drained"})
+	require.NoError(t, err)
+	_, ok = msgSender.TryPop(executorNodeID1, workerMessageTopic(workerID1, StopWorkerTopic))
+	require.True(t, ok)
+	require.True(t, derror.ErrWorkerMigrated.Equal(master.offlineReason(workerID1)))
+
+	// the caller is told if the stop message is not delivered
+	msgSender.SetBlocked(true)
+	err = master.StopWorker(ctx, workerID1)
+	require.True(t, derror.ErrMessageNotDelivered.Equal(err))
+	msgSender.SetBlocked(false)
+
+	master.On("CloseImpl", mock.Anything).Return(nil)
+	err = master.Close(ctx)
+	require.NoError(t, err)
+}
+
+func TestMasterMigrateWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl("", masterName)
+	master.timeoutConfig.masterHeartbeatCheckLoopInterval = time.Millisecond * 10
+	prepareMeta(ctx, t, master.metaKVClient)
+
+	master.On("InitImpl", mock.Anything).Return(nil)
+	err := master.Init(ctx)
+	require.NoError(t, err)
+
+	MockBaseMasterCreateWorker(
+		t,
+		master.DefaultBaseMaster,
+		workerTypePlaceholder,
+		&dummyConfig{param: 1},
+		100,
+		masterName,
+		workerID1,
+		executorNodeID1)
+
+	_, err = master.CreateWorker(workerTypePlaceholder, &dummyConfig{param: 1}, 100)
+	require.NoError(t, err)
+	master.On("OnWorkerDispatched", mock.AnythingOfType("*lib.workerHandleImpl"), nil).Return(nil)
+	<-master.dispatchedWorkers
+
+	master.On("OnWorkerOnline", mock.AnythingOfType("*lib.workerHandleImpl")).Return(nil)
+	MockBaseMasterWorkerHeartbeat(t, master.DefaultBaseMaster, masterName, workerID1, executorNodeID1)
+	require.Eventually(t, func() bool {
+		return master.onlineWorkerCount.Load() == 1
+	}, time.Second*1, time.Millisecond*10)
+	err = master.messageHandlerManager.InvokeHandler(t, StatusUpdateTopic(masterName, workerID1), executorNodeID1,
+		&StatusUpdateMessage{
+			WorkerID: workerID1,
+			Status:   WorkerStatus{Code: WorkerStatusNormal, ExtBytes: []byte(`{"Val":42}`)},
+		})
+	require.NoError(t, err)
+
+	// the new worker is required to run in the target executor
+	master.uuidGen.(*uuid.MockGenerator).Push(workerID2)
+	master.serverMasterClient.On("ScheduleTask", mock.Anything,
+		&pb.TaskSchedulerRequest{Tasks: []*pb.ScheduleTask{{
+			Task:              &pb.TaskRequest{Id: 0},
+			Cost:              100,
+			PreferredLocation: executorNodeID2,
+			LocationRequired:  true,
+			WorkerId:          workerID2,
+			MasterId:          masterName,
+			WorkerType:        int64(workerTypePlaceholder),
+		}}}, mock.Anything).Return(
+		&pb.TaskSchedulerResponse{Schedule: map[int64]*pb.ScheduleResult{0: {ExecutorId: executorNodeID2}}}, nil)
+	executorClient := &client.MockExecutorClient{}
+	err = master.executorClientManager.AddExecutorClient(executorNodeID2, executorClient)
+	require.NoError(t, err)
+	executorClient.On("Send", mock.Anything, mock.Anything).Return(
+		&client.ExecutorResponse{Resp: &pb.DispatchTaskResponse{ErrorCode: 1}}, nil)
+
+	err = master.messageHandlerManager.InvokeHandler(t, MigrateWorkerTopic(masterName), masterNodeName,
+		&MigrateWorkerMessage{WorkerID: workerID1, Reason: "rebalanced", Target: executorNodeID2})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return master.workerManager.GetWorkerHandle(workerID2).Status() != nil
+	}, time.Second*1, time.Millisecond*10)
+	// the worker being migrated can't be migrated again
+	err = master.MigrateWorker(workerID1, executorNodeID3)
+	require.True(t, derror.ErrWorkerNotMigratable.Equal(err))
+
+	// the checkpoint is handed off after the new worker is online, and then
+	// the old worker is stopped
+	master.On("OnWorkerMigrated", mock.AnythingOfType("*lib.workerHandleImpl"),
+		mock.AnythingOfType("*lib.workerHandleImpl")).Return(nil)
+	MockBaseMasterWorkerHeartbeat(t, master.DefaultBaseMaster, masterName, workerID2, executorNodeID2)
+	msgSender := master.messageSender.(*p2p.MockMessageSender)
+	var msg interface{}
+	require.Eventually(t, func() bool {
+		var ok bool
+		msg, ok = msgSender.TryPop(executorNodeID2, workerMessageTopic(workerID2, HandOffCheckpointTopic))
+		return ok
+	}, time.Second*1, time.Millisecond*10)
+	require.Equal(t, &HandOffCheckpointMessage{
+		WorkerID:   workerID2,
+		Epoch:      master.currentEpoch.Load(),
+		Checkpoint: []byte(`{"Val":42}`),
+	}, msg)
+	require.Eventually(t, func() bool {
+		_, ok := msgSender.TryPop(executorNodeID1, workerMessageTopic(workerID1, StopWorkerTopic))
+		return ok
+	}, time.Second*1, time.Millisecond*10)
+	master.AssertNumberOfCalls(t, "OnWorkerMigrated", 1)
+	master.AssertNumberOfCalls(t, "OnWorkerDispatched", 1)
+	master.AssertNumberOfCalls(t, "OnWorkerOnline", 1)
+	// the master is not notified after the old worker exits
+	require.True(t, master.forgetWorker(workerID1))
+	require.False(t, master.forgetWorker(workerID2))
+
+	err = master.MigrateWorker(workerID3, executorNodeID3)
+	require.True(t, derror.ErrWorkerNotFound.Equal(err))
+
+	master.On("CloseImpl", mock.Anything).Return(nil)
+	err = master.Close(ctx)
+	require.NoError(t, err)
+}
+
+func TestJobMasterReportWorkerStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl("", masterName)
+	master.timeoutConfig.masterHeartbeatCheckLoopInterval = time.Millisecond * 10
+	prepareMeta(ctx, t, master.metaKVClient)
+
+	master.On("InitImpl", mock.Anything).Return(nil)
+	err := master.Init(ctx)
+	require.NoError(t, err)
+
+	MockBaseMasterCreateWorker(
+		t,
+		master.DefaultBaseMaster,
+		workerTypePlaceholder,
+		&dummyConfig{param: 1},
+		100,
+		masterName,
+		workerID1,
+		executorNodeID1)
+
+	_, err = master.CreateWorker(workerTypePlaceholder, &dummyConfig{param: 1}, 100)
+	require.NoError(t, err)
+	master.On("OnWorkerDispatched", mock.AnythingOfType("*lib.workerHandleImpl"), nil).Return(nil)
+	<-master.dispatchedWorkers
+
+	err = master.messageHandlerManager.InvokeHandler(t, StatusUpdateTopic(masterName, workerID1), masterName, &StatusUpdateMessage{
+		WorkerID: workerID1,
+		Status: WorkerStatus{
+			Code:     WorkerStatusNormal,
+			ExtBytes: []byte(`{"Val":4}`),
+		},
+	})
+	require.NoError(t, err)
+
+	workerImpl := newMockWorkerImpl(masterName, "job-manager")
+	workerImpl.On("Status").Return(WorkerStatus{
+		Code: WorkerStatusNormal,
+		Ext:  int64(10),
+	})
+	jobMasterImpl := &jobMasterWorkerImpl{WorkerImpl: workerImpl, master: master.DefaultBaseMaster}
+
+	// workers are not reported before the master role is initialized
+	status := jobMasterImpl.Status()
+	require.Equal(t, WorkerStatusNormal, status.Code)
+	require.Equal(t, &JobMasterStatusExt{
+		ExtBytes: []byte("10"),
+		Workers:  map[WorkerID]WorkerStatus{},
+	}, status.Ext)
+
+	jobMasterImpl.masterInitialized.Store(true)
+	status = jobMasterImpl.Status()
+	require.Equal(t, &JobMasterStatusExt{
+		ExtBytes: []byte("10"),
+		Workers: map[WorkerID]WorkerStatus{
+			workerID1: {
+				Code:     WorkerStatusNormal,
+				ExtBytes: []byte(`{"Val":4}`),
+				Ext:      &dummyStatus{Val: 4},
+			},
+		},
+	}, status.Ext)
+}
+
+func TestMasterRecoverWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl("", masterName)
+	manager := newWorkerManager(masterName, true, 1, master.messageSender).(*workerManagerImpl)
+	manager.clock = clock.NewMock()
+	manager.clock.(*clock.Mock).Set(time.Now())
+	master.workerManager = manager
+
+	err := master.RecoverWorker(ctx, workerID1)
+	require.NoError(t, err)
+	err = master.RecoverWorker(ctx, workerID2)
+	require.NoError(t, err)
+	master.messageHandlerManager.AssertHasHandler(t,
+		HeartbeatPingTopic(masterName, workerID1), &HeartbeatPingMessage{})
+	master.messageHandlerManager.AssertHasHandler(t,
+		HeartbeatPingTopic(masterName, workerID2), &HeartbeatPingMessage{})
+
+	err = master.checkReady(ctx)
+	require.NoError(t, err)
+	require.False(t, master.IsMasterReady())
+
+	// worker1 is taken over, while worker2 does not send heartbeats in time.
+	err = master.messageHandlerManager.InvokeHandler(t,
+		HeartbeatPingTopic(masterName, workerID1),
+		executorNodeID1,
+		&HeartbeatPingMessage{
+			SendTime:     clock.MonoNow(),
+			FromWorkerID: workerID1,
+			Epoch:        1,
+		})
+	require.NoError(t, err)
+	manager.clock.(*clock.Mock).Add(defaultTimeoutConfig.workerTimeoutDuration * 2)
+
+	err = master.checkReady(ctx)
+	require.NoError(t, err)
+	require.True(t, master.IsMasterReady())
+	master.messageHandlerManager.AssertHasHandler(t,
+		HeartbeatPingTopic(masterName, workerID1), &HeartbeatPingMessage{})
+	master.messageHandlerManager.AssertNoHandler(t, HeartbeatPingTopic(masterName, workerID2))
+	master.messageHandlerManager.AssertNoHandler(t, StatusUpdateTopic(masterName, workerID2))
+}
+
+func TestJobMasterConfigUpdated(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl("", masterName)
+	workerImpl := newMockWorkerImpl(masterName, "job-manager")
+	workerImpl.On("Status").Return(WorkerStatus{Code: WorkerStatusNormal})
+	jobMasterImpl := &jobMasterWorkerImpl{
+		WorkerImpl: workerImpl,
+		master:     master.DefaultBaseMaster,
+		masterImpl: master,
+	}
+
+	config := []byte(`{"dstHost":"0.0.0.0:1234"}`)
+	master.On("OnConfigUpdated", mock.Anything, config).Return(nil).Once()
+	jobMasterImpl.onConfigUpdated(ctx, &UpdateConfigMessage{
+		WorkerID:      masterName,
+		Config:        config,
+		ConfigVersion: 1,
+	})
+	status := jobMasterImpl.Status()
+	require.Equal(t, int64(0), status.Ext.(*JobMasterStatusExt).RejectedConfigVersion)
+
+	// the rejected config is reported in the status
+	master.On("OnConfigUpdated", mock.Anything, config).Return(derror.ErrBuildJobFailed.GenWithStack("rejected")).Once()
+	jobMasterImpl.onConfigUpdated(ctx, &UpdateConfigMessage{
+		WorkerID:      masterName,
+		Config:        config,
+		ConfigVersion: 2,
+	})
+	status = jobMasterImpl.Status()
+	require.Equal(t, int64(2), status.Ext.(*JobMasterStatusExt).RejectedConfigVersion)
+	require.Contains(t, status.Ext.(*JobMasterStatusExt).ConfigError, "rejected")
+}
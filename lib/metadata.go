@@ -27,10 +27,17 @@ func NewMasterMetadataClient(masterID MasterID, metaKVClient metadata.MetaKV) *M
 }
 
 func (c *MasterMetadataClient) Load(ctx context.Context) (*MasterMetaKVData, error) {
+	masterMeta, _, err := c.load(ctx)
+	return masterMeta, err
+}
+
+// load returns the master metadata and the mod revision of its key, the mod
+// revision is 0 if the key doesn't exist.
+func (c *MasterMetadataClient) load(ctx context.Context) (*MasterMetaKVData, int64, error) {
 	key := adapter.MasterMetaKey.Encode(c.masterID)
 	rawResp, err := c.metaKVClient.Get(ctx, key)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return nil, 0, errors.Trace(err)
 	}
 	resp := rawResp.(*clientv3.GetResponse)
 	if len(resp.Kvs) == 0 {
@@ -38,15 +45,49 @@ func (c *MasterMetadataClient) Load(ctx context.Context) (*MasterMetaKVData, err
 		masterMeta := &MasterMetaKVData{
 			ID: c.masterID,
 		}
-		return masterMeta, nil
+		return masterMeta, 0, nil
 	}
 	masterMetaBytes := resp.Kvs[0].Value
 	var masterMeta MasterMetaKVData
 	if err := json.Unmarshal(masterMetaBytes, &masterMeta); err != nil {
 		// TODO wrap the error
-		return nil, errors.Trace(err)
+		return nil, 0, errors.Trace(err)
+	}
+	return &masterMeta, resp.Kvs[0].ModRevision, nil
+}
+
+// Update loads the master metadata, applies fn to it and stores it by a
+// compare-and-swap txn on the mod revision of the key. The master and the
+// JobManager both write the metadata, fn is applied again on the latest one
+// if the key has been changed concurrently, so that no write is lost.
+func (c *MasterMetadataClient) Update(ctx context.Context, fn func(*MasterMetaKVData) error) error {
+	key := adapter.MasterMetaKey.Encode(c.masterID)
+	for {
+		masterMeta, modRevision, err := c.load(ctx)
+		if err != nil {
+			return err
+		}
+		if err := fn(masterMeta); err != nil {
+			return err
+		}
+		dataBytes, err := json.Marshal(masterMeta)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		txn := c.metaKVClient.Txn(ctx).(clientv3.Txn)
+		resp, err := txn.If(clientv3.Compare(clientv3.ModRevision(key), "=", modRevision)).
+			Then(clientv3.OpPut(key, string(dataBytes))).
+			Commit()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if resp.Succeeded {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return errors.Trace(err)
+		}
 	}
-	return &masterMeta, nil
 }
 
 func (c *MasterMetadataClient) Store(ctx context.Context, data *MasterMetaKVData) error {
@@ -77,7 +118,7 @@ func (c *MasterMetadataClient) LoadAllMasters(ctx context.Context) ([]*MasterMet
 		if err := json.Unmarshal(kv.Value, masterMeta); err != nil {
 			return nil, errors.Trace(err)
 		}
-		if masterMeta.MasterMetaExt.Tp != JobManager {
+		if masterMeta.MasterMetaExt == nil || masterMeta.MasterMetaExt.Tp != JobManager {
 			meta = append(meta, masterMeta)
 		}
 	}
@@ -46,3 +46,36 @@ func TestMasterMetadata(t *testing.T) {
 		require.Equal(t, FakeJobMaster, master.MasterMetaExt.Tp)
 	}
 }
+
+func TestMasterMetadataUpdateConcurrently(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	metaKVClient := metadata.NewMetaMock()
+	cli := NewMasterMetadataClient("master-1", metaKVClient)
+	err := cli.Store(ctx, &MasterMetaKVData{ID: "master-1", Addr: "127.0.0.1:10000"})
+	require.Nil(t, err)
+
+	// The JobManager pauses the job while the master is updating its address,
+	// the update is applied again on the paused metadata.
+	attempts := 0
+	err = cli.Update(ctx, func(meta *MasterMetaKVData) error {
+		attempts++
+		if attempts == 1 {
+			err := NewMasterMetadataClient("master-1", metaKVClient).Update(ctx, func(meta *MasterMetaKVData) error {
+				meta.StatusCode = MasterStatusPaused
+				return nil
+			})
+			require.Nil(t, err)
+		}
+		meta.Addr = "127.0.0.1:10001"
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, attempts)
+
+	meta, err := cli.Load(ctx)
+	require.Nil(t, err)
+	require.Equal(t, MasterStatusPaused, meta.StatusCode)
+	require.Equal(t, "127.0.0.1:10001", meta.Addr)
+}
@@ -130,6 +130,14 @@ func (m *MockMasterImpl) OnWorkerOffline(worker WorkerHandle, reason error) erro
 	return args.Error(0)
 }
 
+func (m *MockMasterImpl) OnWorkerMigrated(oldWorker WorkerHandle, newWorker WorkerHandle) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	args := m.Called(oldWorker, newWorker)
+	return args.Error(0)
+}
+
 func (m *MockMasterImpl) OnWorkerMessage(worker WorkerHandle, topic p2p.Topic, message interface{}) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -138,6 +146,30 @@ func (m *MockMasterImpl) OnWorkerMessage(worker WorkerHandle, topic p2p.Topic, m
 	return args.Error(0)
 }
 
+func (m *MockMasterImpl) OnPause(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockMasterImpl) OnResume(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockMasterImpl) OnConfigUpdated(ctx context.Context, config []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	args := m.Called(ctx, config)
+	return args.Error(0)
+}
+
 func (m *MockMasterImpl) CloseImpl(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -51,7 +51,10 @@ func MockBaseMasterCreateWorker(
 		Task: &pb.TaskRequest{
 			Id: 0,
 		},
-		Cost: int64(cost),
+		Cost:       int64(cost),
+		WorkerId:   workerID,
+		MasterId:   masterID,
+		WorkerType: int64(workerType),
 	}}}
 	master.serverMasterClient.(*client.MockServerMasterClient).On(
 		"ScheduleTask",
@@ -81,6 +84,7 @@ func MockBaseMasterCreateWorker(
 				TaskConfig: configBytes,
 				MasterId:   masterID,
 				WorkerId:   workerID,
+				Resource:   &pb.Resource{Cpu: int64(cost)},
 			},
 		}).Return(&client.ExecutorResponse{Resp: &pb.DispatchTaskResponse{
 		ErrorCode: 1,
@@ -108,3 +112,76 @@ func MockBaseMasterWorkerHeartbeat(
 
 	require.NoError(t, err)
 }
+
+// MockBaseMasterDispatchWorker makes the worker of the given ID, which is
+// created by CreateWorker later, scheduled to and dispatched in the executor.
+// Unlike MockBaseMasterCreateWorker, the other fields of the requests are not
+// checked.
+func MockBaseMasterDispatchWorker(
+	t *testing.T,
+	master *DefaultBaseMaster,
+	workerID WorkerID,
+	executorID model.ExecutorID,
+) {
+	master.serverMasterClient.(*client.MockServerMasterClient).On(
+		"ScheduleTask",
+		mock.Anything,
+		mock.MatchedBy(func(req *pb.TaskSchedulerRequest) bool {
+			return len(req.Tasks) == 1 && req.Tasks[0].WorkerId == workerID
+		}),
+		mock.Anything).Return(
+		&pb.TaskSchedulerResponse{
+			Schedule: map[int64]*pb.ScheduleResult{
+				0: {
+					ExecutorId: string(executorID),
+				},
+			},
+		}, nil)
+
+	clientManager := master.executorClientManager.(*client.Manager)
+	mockExecutorClient, ok := clientManager.ExecutorClient(executorID).(*client.MockExecutorClient)
+	if !ok {
+		mockExecutorClient = &client.MockExecutorClient{}
+		err := clientManager.AddExecutorClient(executorID, mockExecutorClient)
+		require.NoError(t, err)
+	}
+	mockExecutorClient.On("Send",
+		mock.Anything,
+		mock.MatchedBy(func(req *client.ExecutorRequest) bool {
+			dispatchReq, ok := req.Req.(*pb.DispatchTaskRequest)
+			return ok && dispatchReq.WorkerId == workerID
+		})).Return(&client.ExecutorResponse{Resp: &pb.DispatchTaskResponse{
+		ErrorCode: 1,
+	}}, nil)
+}
+
+func MockBaseMasterWorkerUpdateStatus(
+	t *testing.T,
+	master *DefaultBaseMaster,
+	masterID MasterID,
+	workerID WorkerID,
+	executorID p2p.NodeID,
+	status *WorkerStatus,
+) {
+	err := master.messageHandlerManager.(*p2p.MockMessageHandlerManager).InvokeHandler(
+		t,
+		StatusUpdateTopic(masterID, workerID),
+		executorID,
+		&StatusUpdateMessage{
+			WorkerID: workerID,
+			Status:   *status,
+		})
+
+	require.NoError(t, err)
+}
+
+// MockBaseMasterPopWorkerMessage pops the earliest message of the topic that
+// the master has sent to the worker.
+func MockBaseMasterPopWorkerMessage(
+	master *DefaultBaseMaster,
+	workerID WorkerID,
+	executorID p2p.NodeID,
+	topic p2p.Topic,
+) (interface{}, bool) {
+	return master.messageSender.(*p2p.MockMessageSender).TryPop(executorID, workerMessageTopic(workerID, topic))
+}
@@ -71,6 +71,22 @@ func (w *mockWorkerImpl) OnMasterFailover(reason MasterFailoverReason) error {
 	return args.Error(0)
 }
 
+func (w *mockWorkerImpl) OnPause(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	args := w.Called(ctx)
+	return args.Error(0)
+}
+
+func (w *mockWorkerImpl) OnResume(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	args := w.Called(ctx)
+	return args.Error(0)
+}
+
 func (w *mockWorkerImpl) CloseImpl(ctx context.Context) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/hanfei1991/microcosm/lib"
@@ -16,6 +17,8 @@ type WorkerConfig = lib.WorkerConfig
 type Registry interface {
 	MustRegisterWorkerType(tp lib.WorkerType, factory WorkerFactory)
 	RegisterWorkerType(tp lib.WorkerType, factory WorkerFactory) (ok bool)
+	// WorkerTypes returns the registered worker types in ascending order.
+	WorkerTypes() []lib.WorkerType
 	CreateWorker(
 		ctx *dcontext.Context,
 		tp lib.WorkerType,
@@ -54,6 +57,20 @@ func (r *registryImpl) RegisterWorkerType(tp lib.WorkerType, factory WorkerFacto
 	return true
 }
 
+func (r *registryImpl) WorkerTypes() []lib.WorkerType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ret := make([]lib.WorkerType, 0, len(r.factoryMap))
+	for tp := range r.factoryMap {
+		ret = append(ret, tp)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+	return ret
+}
+
 func (r *registryImpl) CreateWorker(
 	ctx *dcontext.Context,
 	tp lib.WorkerType,
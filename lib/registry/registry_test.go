@@ -58,3 +58,12 @@ func TestLoadFake(t *testing.T) {
 		RegisterFake(registry)
 	})
 }
+
+func TestRegistryWorkerTypes(t *testing.T) {
+	registry := NewRegistry()
+	require.Empty(t, registry.WorkerTypes())
+
+	registry.MustRegisterWorkerType(fakeWorkerType+1, fakeWorkerFactory)
+	registry.MustRegisterWorkerType(fakeWorkerType, fakeWorkerFactory)
+	require.Equal(t, []lib.WorkerType{fakeWorkerType, fakeWorkerType + 1}, registry.WorkerTypes())
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -43,6 +44,13 @@ type WorkerImpl interface {
 	// OnMasterFailover is called when the master is failed over.
 	OnMasterFailover(reason MasterFailoverReason) error
 
+	// OnPause is called when the master asks the worker to pause.
+	// Tick is not called until the worker is resumed.
+	OnPause(ctx context.Context) error
+
+	// OnResume is called when the master asks a paused worker to resume.
+	OnResume(ctx context.Context) error
+
 	// CloseImpl tells the WorkerImpl to quit running StatusWorker and release resources.
 	CloseImpl(ctx context.Context) error
 }
@@ -75,6 +83,25 @@ type DefaultBaseWorker struct {
 	cancelMu      sync.Mutex
 	cancelBgTasks context.CancelFunc
 
+	// stopped is set when the master has asked the worker to exit.
+	stopped atomic.Bool
+
+	// pauseRequested is set by the message handlers when the master asks the
+	// worker to pause or resume, and paused records whether the WorkerImpl
+	// has been paused. paused is only accessed in Poll.
+	pauseRequested atomic.Bool
+	paused         bool
+
+	// configUpdate is the latest config update sent by the master that has
+	// not been applied, it is applied in Poll.
+	configMu     sync.Mutex
+	configUpdate *UpdateConfigMessage
+
+	// checkpoint is handed off by the master if the worker replaces a
+	// migrated worker, it is applied in Poll.
+	checkpointMu sync.Mutex
+	checkpoint   *HandOffCheckpointMessage
+
 	clock clock.Clock
 }
 
@@ -148,12 +175,110 @@ func (w *DefaultBaseWorker) Poll(ctx context.Context) error {
 	default:
 	}
 
+	if w.stopped.Load() {
+		return derror.ErrWorkerStopped.GenWithStackByArgs(w.id)
+	}
+
+	w.applyConfigUpdate(ctx)
+	if err := w.applyCheckpoint(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.syncPauseState(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	if w.paused {
+		return nil
+	}
+
 	if err := w.Impl.Tick(ctx); err != nil {
 		return errors.Trace(err)
 	}
 	return nil
 }
 
+// restorePaused marks the worker as paused without calling WorkerImpl.OnPause.
+func (w *DefaultBaseWorker) restorePaused() {
+	w.pauseRequested.Store(true)
+	w.paused = true
+}
+
+// syncPauseState calls OnPause or OnResume of the WorkerImpl if the master
+// has asked the worker to pause or resume since the last Poll.
+func (w *DefaultBaseWorker) syncPauseState(ctx context.Context) error {
+	pauseRequested := w.pauseRequested.Load()
+	if pauseRequested == w.paused {
+		return nil
+	}
+
+	if pauseRequested {
+		log.L().Info("worker is paused", zap.String("worker-id", w.id))
+		if err := w.Impl.OnPause(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	} else {
+		log.L().Info("worker is resumed", zap.String("worker-id", w.id))
+		if err := w.Impl.OnResume(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	w.paused = pauseRequested
+	return nil
+}
+
+// configUpdater is implemented by the WorkerImpl of a job master, which
+// handles the config updates of the job.
+type configUpdater interface {
+	onConfigUpdated(ctx context.Context, msg *UpdateConfigMessage)
+}
+
+// applyConfigUpdate applies the config update sent by the master since the
+// last Poll, if the WorkerImpl can handle it.
+func (w *DefaultBaseWorker) applyConfigUpdate(ctx context.Context) {
+	w.configMu.Lock()
+	msg := w.configUpdate
+	w.configUpdate = nil
+	w.configMu.Unlock()
+	if msg == nil {
+		return
+	}
+	updater, ok := w.Impl.(configUpdater)
+	if !ok {
+		log.L().Warn("worker does not support config update, ignore it",
+			zap.String("worker-id", w.id))
+		return
+	}
+	updater.onConfigUpdated(ctx, msg)
+}
+
+// CheckpointReceiver is implemented by the WorkerImpl that can take over the
+// progress of another worker of the same type, so that the worker can be
+// migrated while running, see DefaultBaseMaster.MigrateWorker.
+type CheckpointReceiver interface {
+	// OnCheckpoint is called before Tick with the serialized Ext of the
+	// latest status of the worker being replaced.
+	OnCheckpoint(ctx context.Context, checkpoint []byte) error
+}
+
+// applyCheckpoint passes the checkpoint handed off by the master since the
+// last Poll to the WorkerImpl.
+func (w *DefaultBaseWorker) applyCheckpoint(ctx context.Context) error {
+	w.checkpointMu.Lock()
+	msg := w.checkpoint
+	w.checkpoint = nil
+	w.checkpointMu.Unlock()
+	if msg == nil {
+		return nil
+	}
+	receiver, ok := w.Impl.(CheckpointReceiver)
+	if !ok {
+		log.L().Warn("worker does not support checkpoint hand-off, ignore it",
+			zap.String("worker-id", w.id))
+		return nil
+	}
+	log.L().Info("checkpoint is handed off", zap.String("worker-id", w.id))
+	return receiver.OnCheckpoint(ctx, msg.Checkpoint)
+}
+
 func (w *DefaultBaseWorker) Close(ctx context.Context) error {
 	w.cancelMu.Lock()
 	w.cancelBgTasks()
@@ -285,6 +410,134 @@ func (w *DefaultBaseWorker) initMessageHandlers(ctx context.Context) error {
 		log.L().Panic("duplicate handler",
 			zap.String("topic", topic))
 	}
+
+	topic = workerMessageTopic(w.id, StopWorkerTopic)
+	ok, err = w.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&StopWorkerMessage{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg := value.(*StopWorkerMessage)
+			if msg.Epoch < w.masterClient.Epoch() {
+				log.L().Info("stale stop worker message dropped",
+					zap.Any("msg", msg),
+					zap.Int64("master-epoch", w.masterClient.Epoch()))
+				return nil
+			}
+			log.L().Info("worker is asked to stop by master",
+				zap.Any("msg", msg))
+			w.stopped.Store(true)
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		log.L().Panic("duplicate handler",
+			zap.String("topic", topic))
+	}
+
+	topic = workerMessageTopic(w.id, PauseWorkerTopic)
+	ok, err = w.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&PauseWorkerMessage{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg := value.(*PauseWorkerMessage)
+			if msg.Epoch < w.masterClient.Epoch() {
+				log.L().Info("stale pause worker message dropped",
+					zap.Any("msg", msg),
+					zap.Int64("master-epoch", w.masterClient.Epoch()))
+				return nil
+			}
+			w.pauseRequested.Store(true)
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		log.L().Panic("duplicate handler",
+			zap.String("topic", topic))
+	}
+
+	topic = workerMessageTopic(w.id, ResumeWorkerTopic)
+	ok, err = w.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&ResumeWorkerMessage{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg := value.(*ResumeWorkerMessage)
+			if msg.Epoch < w.masterClient.Epoch() {
+				log.L().Info("stale resume worker message dropped",
+					zap.Any("msg", msg),
+					zap.Int64("master-epoch", w.masterClient.Epoch()))
+				return nil
+			}
+			w.pauseRequested.Store(false)
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		log.L().Panic("duplicate handler",
+			zap.String("topic", topic))
+	}
+
+	topic = workerMessageTopic(w.id, UpdateConfigTopic)
+	ok, err = w.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&UpdateConfigMessage{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg := value.(*UpdateConfigMessage)
+			if msg.Epoch < w.masterClient.Epoch() {
+				log.L().Info("stale update config message dropped",
+					zap.Any("msg", msg),
+					zap.Int64("master-epoch", w.masterClient.Epoch()))
+				return nil
+			}
+			w.configMu.Lock()
+			defer w.configMu.Unlock()
+			if w.configUpdate == nil || w.configUpdate.ConfigVersion < msg.ConfigVersion {
+				w.configUpdate = msg
+			}
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		log.L().Panic("duplicate handler",
+			zap.String("topic", topic))
+	}
+
+	topic = workerMessageTopic(w.id, HandOffCheckpointTopic)
+	ok, err = w.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&HandOffCheckpointMessage{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg := value.(*HandOffCheckpointMessage)
+			if msg.Epoch < w.masterClient.Epoch() {
+				log.L().Info("stale checkpoint message dropped",
+					zap.Any("msg", msg),
+					zap.Int64("master-epoch", w.masterClient.Epoch()))
+				return nil
+			}
+			w.checkpointMu.Lock()
+			defer w.checkpointMu.Unlock()
+			w.checkpoint = msg
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		log.L().Panic("duplicate handler",
+			zap.String("topic", topic))
+	}
 	return nil
 }
 
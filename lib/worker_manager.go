@@ -2,7 +2,6 @@ package lib
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"time"
 
@@ -48,7 +47,7 @@ type workerManagerImpl struct {
 	messageSender p2p.MessageSender
 }
 
-func newWorkerManager(id MasterID, needWait bool, curEpoch Epoch) workerManager {
+func newWorkerManager(id MasterID, needWait bool, curEpoch Epoch, messageSender p2p.MessageSender) workerManager {
 	return &workerManagerImpl{
 		initialized: !needWait,
 		workerInfos: make(map[WorkerID]*WorkerInfo),
@@ -59,6 +58,8 @@ func newWorkerManager(id MasterID, needWait bool, curEpoch Epoch) workerManager
 		timeoutConfig: defaultTimeoutConfig,
 
 		clock: clock.New(),
+
+		messageSender: messageSender,
 	}
 }
 
@@ -325,11 +326,14 @@ func (w *workerHandleImpl) SendMessage(ctx context.Context, topic p2p.Topic, mes
 	executorNodeID := info.NodeID
 	// TODO the worker should have a way to register a handle for this topic.
 	// TODO maybe we need a TopicEncoder
-	prefixedTopic := fmt.Sprintf("worker-message/%s/%s", w.id, topic)
-	_, err := w.manager.MessageSender().SendToNode(ctx, executorNodeID, prefixedTopic, message)
+	msgTopic := workerMessageTopic(w.id, topic)
+	ok, err := w.manager.MessageSender().SendToNode(ctx, executorNodeID, msgTopic, message)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if !ok {
+		return derror.ErrMessageNotDelivered.GenWithStackByArgs(msgTopic, executorNodeID)
+	}
 	return nil
 }
 
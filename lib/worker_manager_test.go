@@ -18,7 +18,7 @@ func TestHeartBeatPingPongAfterCreateWorker(t *testing.T) {
 
 	msgSender := p2p.NewMockMessageSender()
 
-	manager := newWorkerManager(masterName, false, 1).(*workerManagerImpl)
+	manager := newWorkerManager(masterName, false, 1, nil).(*workerManagerImpl)
 	manager.clock = clock.NewMock()
 	manager.clock.(*clock.Mock).Set(time.Now())
 
@@ -57,7 +57,7 @@ func TestHeartBeatPingPongAfterFailover(t *testing.T) {
 
 	msgSender := p2p.NewMockMessageSender()
 
-	manager := newWorkerManager(masterName, true, 1).(*workerManagerImpl)
+	manager := newWorkerManager(masterName, true, 1, nil).(*workerManagerImpl)
 	manager.clock = clock.NewMock()
 	manager.clock.(*clock.Mock).Set(time.Now())
 
@@ -93,7 +93,7 @@ func TestMultiplePendingHeartbeats(t *testing.T) {
 
 	msgSender := p2p.NewMockMessageSender()
 
-	manager := newWorkerManager(masterName, true, 1).(*workerManagerImpl)
+	manager := newWorkerManager(masterName, true, 1, nil).(*workerManagerImpl)
 	manager.clock = clock.NewMock()
 	manager.clock.(*clock.Mock).Set(time.Now())
 
@@ -187,7 +187,7 @@ func TestWorkerManagerInitialization(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
 	defer cancel()
 
-	manager := newWorkerManager(masterName, true, 1).(*workerManagerImpl)
+	manager := newWorkerManager(masterName, true, 1, nil).(*workerManagerImpl)
 	manager.clock = clock.NewMock()
 	manager.clock.(*clock.Mock).Set(time.Now())
 
@@ -220,7 +220,7 @@ func TestWorkerManagerInitialization(t *testing.T) {
 func TestUpdateStatus(t *testing.T) {
 	t.Parallel()
 
-	manager := newWorkerManager(masterName, true, 1)
+	manager := newWorkerManager(masterName, true, 1, nil)
 	err := manager.AddWorker(workerID1, executorNodeID1, WorkerStatusInit)
 	require.NoError(t, err)
 
@@ -255,7 +255,7 @@ func TestWorkerTimedOut(t *testing.T) {
 
 	msgSender := p2p.NewMockMessageSender()
 
-	manager := newWorkerManager(masterName, true, 1).(*workerManagerImpl)
+	manager := newWorkerManager(masterName, true, 1, nil).(*workerManagerImpl)
 	manager.clock = clock.NewMock()
 	manager.clock.(*clock.Mock).Set(time.Now())
 
@@ -290,7 +290,7 @@ func TestWorkerTimedOutWithPendingHeartbeat(t *testing.T) {
 
 	msgSender := p2p.NewMockMessageSender()
 
-	manager := newWorkerManager(masterName, true, 1).(*workerManagerImpl)
+	manager := newWorkerManager(masterName, true, 1, nil).(*workerManagerImpl)
 	manager.clock = clock.NewMock()
 	manager.clock.(*clock.Mock).Set(time.Now())
 
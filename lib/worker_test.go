@@ -9,6 +9,7 @@ import (
 	runtime "github.com/hanfei1991/microcosm/executor/worker"
 	"github.com/hanfei1991/microcosm/pkg/adapter"
 	"github.com/hanfei1991/microcosm/pkg/clock"
+	derror "github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/hanfei1991/microcosm/pkg/metadata"
 
 	"github.com/stretchr/testify/mock"
@@ -283,3 +284,103 @@ func TestWorkerSuicide(t *testing.T) {
 
 	require.Regexp(t, ".*Suicide.*", exitErr.Error())
 }
+
+func TestWorkerStoppedByMaster(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	worker := newMockWorkerImpl(workerID1, masterName)
+	worker.clock = clock.NewMock()
+	worker.clock.(*clock.Mock).Set(time.Now())
+	putMasterMeta(ctx, t, worker.metaKVClient, &MasterMetaKVData{
+		ID:          masterName,
+		NodeID:      masterNodeName,
+		Epoch:       2,
+		Initialized: true,
+	})
+
+	worker.On("InitImpl", mock.Anything).Return(nil)
+	worker.On("Status").Return(WorkerStatus{
+		Code: WorkerStatusNormal,
+	}, nil)
+	worker.On("Tick", mock.Anything).Return(nil)
+	err := worker.Init(ctx)
+	require.NoError(t, err)
+
+	// stop message from a stale master is ignored
+	topic := workerMessageTopic(workerID1, StopWorkerTopic)
+	err = worker.messageHandlerManager.InvokeHandler(t, topic, masterNodeName, &StopWorkerMessage{
+		WorkerID: workerID1,
+		Epoch:    1,
+	})
+	require.NoError(t, err)
+	err = worker.Poll(ctx)
+	require.NoError(t, err)
+
+	err = worker.messageHandlerManager.InvokeHandler(t, topic, masterNodeName, &StopWorkerMessage{
+		WorkerID: workerID1,
+		Epoch:    2,
+	})
+	require.NoError(t, err)
+	err = worker.Poll(ctx)
+	require.Error(t, err)
+	require.True(t, derror.ErrWorkerStopped.Equal(err))
+
+	worker.On("CloseImpl").Return(nil)
+	err = worker.Close(ctx)
+	require.NoError(t, err)
+}
+
+func TestWorkerPauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	worker := newMockWorkerImpl(workerID1, masterName)
+	worker.clock = clock.NewMock()
+	worker.clock.(*clock.Mock).Set(time.Now())
+	putMasterMeta(ctx, t, worker.metaKVClient, &MasterMetaKVData{
+		ID:          masterName,
+		NodeID:      masterNodeName,
+		Epoch:       1,
+		Initialized: true,
+	})
+
+	worker.On("InitImpl", mock.Anything).Return(nil)
+	worker.On("Status").Return(WorkerStatus{
+		Code: WorkerStatusNormal,
+	}, nil)
+	err := worker.Init(ctx)
+	require.NoError(t, err)
+
+	err = worker.messageHandlerManager.InvokeHandler(t,
+		workerMessageTopic(workerID1, PauseWorkerTopic), masterNodeName,
+		&PauseWorkerMessage{WorkerID: workerID1, Epoch: 1})
+	require.NoError(t, err)
+
+	// Tick is not called when the worker is paused
+	worker.On("OnPause", mock.Anything).Return(nil)
+	err = worker.Poll(ctx)
+	require.NoError(t, err)
+	worker.AssertCalled(t, "OnPause", mock.Anything)
+	worker.AssertNotCalled(t, "Tick", mock.Anything)
+
+	err = worker.messageHandlerManager.InvokeHandler(t,
+		workerMessageTopic(workerID1, ResumeWorkerTopic), masterNodeName,
+		&ResumeWorkerMessage{WorkerID: workerID1, Epoch: 1})
+	require.NoError(t, err)
+
+	worker.On("OnResume", mock.Anything).Return(nil)
+	worker.On("Tick", mock.Anything).Return(nil)
+	err = worker.Poll(ctx)
+	require.NoError(t, err)
+	worker.AssertCalled(t, "OnResume", mock.Anything)
+	worker.AssertCalled(t, "Tick", mock.Anything)
+
+	worker.On("CloseImpl").Return(nil)
+	err = worker.Close(ctx)
+	require.NoError(t, err)
+}
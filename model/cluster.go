@@ -30,12 +30,16 @@ type NodeInfo struct {
 	ID   DeployNodeID `json:"id"`
 	Addr string       `json:"addr"`
 
-	// The capability of executor, including
-	// 1. cpu (goroutines)
-	// 2. memory
-	// 3. disk cap
-	// TODO: So we should enrich the cap dimensions in the future.
-	Capability int `json:"cap"`
+	// The capability of executor, including cpu, memory and disk.
+	Capability Resource `json:"capability"`
+	// Labels are free-form attributes of the executor, such as zone or rack.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Version, ProtocolVersion and WorkerTypes are advertised by the
+	// executor when it registers.
+	Version         string  `json:"version,omitempty"`
+	ProtocolVersion int32   `json:"protocol-version,omitempty"`
+	WorkerTypes     []int64 `json:"worker-types,omitempty"`
 }
 
 func (e *NodeInfo) EtcdKey() string {
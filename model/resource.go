@@ -0,0 +1,79 @@
+package model
+
+import "github.com/hanfei1991/microcosm/pb"
+
+// Resource is an amount of resource in multiple dimensions, a task fits an
+// executor only if every dimension fits.
+type Resource struct {
+	// CPU is in abstract resource units, the same unit as the legacy scalar
+	// cost of a task, it is not tied to the cores of the host.
+	CPU RescUnit `json:"cpu"`
+	// Memory and Disk are in bytes.
+	Memory int64 `json:"memory"`
+	Disk   int64 `json:"disk"`
+}
+
+// NewResourceFromPB converts pb.Resource to Resource. The legacy scalar
+// resource, such as ScheduleTask.cost, is taken as the cpu if res is nil.
+func NewResourceFromPB(res *pb.Resource, legacy int64) Resource {
+	if res == nil {
+		return Resource{CPU: RescUnit(legacy)}
+	}
+	return Resource{
+		CPU:    RescUnit(res.Cpu),
+		Memory: res.Memory,
+		Disk:   res.Disk,
+	}
+}
+
+// ToPB converts Resource to pb.Resource.
+func (r Resource) ToPB() *pb.Resource {
+	return &pb.Resource{
+		Cpu:    int64(r.CPU),
+		Memory: r.Memory,
+		Disk:   r.Disk,
+	}
+}
+
+// Add returns the sum of r and other in each dimension.
+func (r Resource) Add(other Resource) Resource {
+	return Resource{
+		CPU:    r.CPU + other.CPU,
+		Memory: r.Memory + other.Memory,
+		Disk:   r.Disk + other.Disk,
+	}
+}
+
+// Sub returns the difference of r and other in each dimension.
+func (r Resource) Sub(other Resource) Resource {
+	return Resource{
+		CPU:    r.CPU - other.CPU,
+		Memory: r.Memory - other.Memory,
+		Disk:   r.Disk - other.Disk,
+	}
+}
+
+// Max returns the larger one of r and other in each dimension.
+func (r Resource) Max(other Resource) Resource {
+	ret := r
+	if other.CPU > ret.CPU {
+		ret.CPU = other.CPU
+	}
+	if other.Memory > ret.Memory {
+		ret.Memory = other.Memory
+	}
+	if other.Disk > ret.Disk {
+		ret.Disk = other.Disk
+	}
+	return ret
+}
+
+// Fits returns whether r is not larger than capacity in every dimension.
+func (r Resource) Fits(capacity Resource) bool {
+	return r.CPU <= capacity.CPU && r.Memory <= capacity.Memory && r.Disk <= capacity.Disk
+}
+
+// IsEmpty returns whether r is zero in every dimension.
+func (r Resource) IsEmpty() bool {
+	return r == Resource{}
+}
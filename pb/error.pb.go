@@ -45,7 +45,11 @@ const (
 	ErrorCode_InvalidMetaStoreType ErrorCode = 8
 	// MasterNotReady means the master is staring up, and not ready to serve
 	ErrorCode_MasterNotReady ErrorCode = 9
-	ErrorCode_UnknownError   ErrorCode = 10001
+	// the job name of a submitted job is used by another job
+	ErrorCode_JobNameExists ErrorCode = 10
+	// the version of the executor is not compatible with the server master
+	ErrorCode_IncompatibleVersion ErrorCode = 11
+	ErrorCode_UnknownError        ErrorCode = 10001
 )
 
 var ErrorCode_name = map[int32]string{
@@ -59,6 +63,8 @@ var ErrorCode_name = map[int32]string{
 	7:     "BuildGrpcConnFailed",
 	8:     "InvalidMetaStoreType",
 	9:     "MasterNotReady",
+	10:    "JobNameExists",
+	11:    "IncompatibleVersion",
 	10001: "UnknownError",
 }
 
@@ -73,6 +79,8 @@ var ErrorCode_value = map[string]int32{
 	"BuildGrpcConnFailed":  7,
 	"InvalidMetaStoreType": 8,
 	"MasterNotReady":       9,
+	"JobNameExists":        10,
+	"IncompatibleVersion":  11,
 	"UnknownError":         10001,
 }
 
@@ -207,30 +215,32 @@ func init() {
 func init() { proto.RegisterFile("error.proto", fileDescriptor_0579b252106fcf4a) }
 
 var fileDescriptor_0579b252106fcf4a = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xbd, 0x8e, 0xda, 0x40,
-	0x14, 0x85, 0x3d, 0xfc, 0xfb, 0x92, 0x90, 0x61, 0x48, 0x88, 0x2b, 0x8b, 0x50, 0xa1, 0x28, 0xa2,
-	0x48, 0xea, 0x34, 0x20, 0x12, 0x25, 0x0a, 0x2e, 0x0c, 0xa9, 0x23, 0xff, 0x5c, 0x11, 0x6b, 0xcd,
-	0x5c, 0xef, 0x78, 0xbc, 0xbb, 0xbc, 0xc5, 0xee, 0x5b, 0x6d, 0x49, 0xb9, 0xe5, 0x0a, 0xde, 0x61,
-	0xeb, 0x95, 0x8d, 0xed, 0x6e, 0xee, 0x39, 0xe7, 0x7e, 0x73, 0x46, 0x03, 0x7d, 0x54, 0x8a, 0xd4,
-	0x3c, 0x51, 0xa4, 0x49, 0x34, 0x12, 0x7f, 0xfa, 0x1d, 0x4c, 0x87, 0xf4, 0x1f, 0xf4, 0x42, 0x54,
-	0xc2, 0x82, 0xae, 0xc2, 0xeb, 0x0c, 0x53, 0x6d, 0xb1, 0x09, 0x9b, 0x99, 0x6e, 0x35, 0x8a, 0x31,
-	0x74, 0xe2, 0x22, 0x63, 0x35, 0x0a, 0xa3, 0x9c, 0xa6, 0x0a, 0xda, 0xab, 0x9c, 0x28, 0x3e, 0x41,
-	0x2b, 0xa0, 0x10, 0x8b, 0xbd, 0xc1, 0xd7, 0xb7, 0xf3, 0xc4, 0x9f, 0x17, 0xc6, 0x92, 0x42, 0x74,
-	0x0b, 0x2b, 0xa7, 0xef, 0x31, 0x4d, 0xbd, 0x1d, 0x96, 0x90, 0x6a, 0x14, 0x5f, 0x00, 0x24, 0xe9,
-	0x7f, 0xe5, 0x0d, 0xcd, 0x09, 0x9b, 0xf5, 0x2f, 0x88, 0xba, 0x9a, 0x6b, 0xca, 0xea, 0xf8, 0xf9,
-	0x85, 0x81, 0x59, 0xb3, 0x45, 0x0f, 0x5a, 0x0e, 0x49, 0xe4, 0x86, 0x18, 0xc1, 0xbb, 0xb5, 0x97,
-	0x6a, 0x54, 0xf5, 0x16, 0x67, 0xb9, 0xf8, 0x57, 0x5e, 0x49, 0xba, 0x95, 0xab, 0x3b, 0x0c, 0x32,
-	0x4d, 0x8a, 0x37, 0xc4, 0x07, 0x18, 0x3a, 0xa4, 0x57, 0x92, 0xb2, 0xdd, 0x7f, 0x17, 0x53, 0xca,
-	0x54, 0x80, 0xbc, 0x29, 0xc6, 0x20, 0x36, 0x99, 0xff, 0x9b, 0xfc, 0x4d, 0xe6, 0xef, 0x23, 0xfd,
-	0xc3, 0x8b, 0x62, 0x0c, 0x79, 0x2b, 0x8f, 0x6f, 0x69, 0xef, 0xa7, 0x9a, 0x24, 0xd6, 0x94, 0x76,
-	0x2e, 0x5f, 0xe2, 0x8b, 0x2c, 0x8a, 0xc3, 0x32, 0xdd, 0x11, 0x1f, 0x61, 0x54, 0x08, 0x3f, 0x55,
-	0x12, 0x2c, 0x49, 0xca, 0xd2, 0xe8, 0x0a, 0x0b, 0xde, 0xff, 0x92, 0x37, 0x5e, 0x1c, 0x85, 0x6b,
-	0xd4, 0xde, 0x46, 0x93, 0xc2, 0xed, 0x21, 0x41, 0xde, 0x13, 0x02, 0x06, 0x75, 0x73, 0x17, 0xbd,
-	0xf0, 0xc0, 0x4d, 0x31, 0x84, 0x37, 0x55, 0xf1, 0xfc, 0xad, 0xfc, 0xc1, 0x59, 0x58, 0x8f, 0x27,
-	0x9b, 0x1d, 0x4f, 0x36, 0x7b, 0x3e, 0xd9, 0xec, 0xfe, 0x6c, 0x1b, 0xc7, 0xb3, 0x6d, 0x3c, 0x9d,
-	0x6d, 0xc3, 0xef, 0x14, 0x1f, 0xfa, 0xed, 0x35, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x44, 0x30, 0x2f,
-	0xdf, 0x01, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xbd, 0x6e, 0xd4, 0x40,
+	0x10, 0xc7, 0xcf, 0xce, 0xe5, 0x12, 0xcf, 0x91, 0xb0, 0x37, 0x81, 0xe0, 0xca, 0x0a, 0xa9, 0x22,
+	0x84, 0xae, 0x80, 0x9a, 0x26, 0x91, 0x41, 0x89, 0x88, 0x0b, 0x5f, 0xa0, 0x45, 0xbb, 0xf6, 0x28,
+	0x58, 0xd8, 0x3b, 0x66, 0x77, 0x0d, 0xc9, 0x5b, 0xc0, 0x2b, 0xf0, 0x34, 0x94, 0x29, 0x29, 0xd1,
+	0xdd, 0x8b, 0x44, 0xf6, 0xd9, 0xee, 0xfc, 0xff, 0x98, 0x9f, 0x67, 0x77, 0x61, 0x4e, 0xc6, 0xb0,
+	0x59, 0xd6, 0x86, 0x1d, 0xa3, 0x5f, 0xab, 0xd3, 0x77, 0x10, 0x24, 0xec, 0x3e, 0x92, 0xcc, 0xc9,
+	0x60, 0x08, 0x7b, 0x86, 0xbe, 0x37, 0x64, 0x5d, 0xe8, 0x9d, 0x78, 0x67, 0x41, 0x3a, 0x48, 0x3c,
+	0x86, 0x59, 0xd9, 0x75, 0x42, 0xbf, 0x0b, 0x7a, 0x75, 0x6a, 0x60, 0x37, 0x6e, 0x89, 0xf8, 0x12,
+	0xa6, 0x19, 0xe7, 0xd4, 0xcd, 0x1d, 0xbe, 0x39, 0x58, 0xd6, 0x6a, 0xd9, 0x05, 0x17, 0x9c, 0x53,
+	0xda, 0x45, 0x2d, 0xbd, 0x22, 0x6b, 0xe5, 0x2d, 0xf5, 0x90, 0x41, 0xe2, 0x6b, 0x00, 0xcd, 0xee,
+	0x4b, 0xff, 0x87, 0x9d, 0x13, 0xef, 0x6c, 0xbe, 0x45, 0x8c, 0xab, 0xa5, 0x81, 0x1e, 0x3e, 0x5f,
+	0xfd, 0xf1, 0x21, 0x18, 0xd9, 0xb8, 0x0f, 0xd3, 0x84, 0x35, 0x89, 0x09, 0x1e, 0xc1, 0xd3, 0x6b,
+	0x69, 0x1d, 0x99, 0x71, 0x4a, 0x78, 0xad, 0xf9, 0x49, 0x7f, 0xd3, 0xfc, 0x53, 0xc7, 0x77, 0x94,
+	0x35, 0x8e, 0x8d, 0xf0, 0xf1, 0x39, 0x2c, 0x12, 0x76, 0xb1, 0xe6, 0xe6, 0xf6, 0x6b, 0x4a, 0x96,
+	0x1b, 0x93, 0x91, 0xd8, 0xc1, 0x63, 0xc0, 0x55, 0xa3, 0xae, 0x58, 0xad, 0x1a, 0x55, 0x15, 0xee,
+	0xbd, 0x2c, 0x4a, 0xca, 0xc5, 0xb4, 0xad, 0xdf, 0x70, 0xa5, 0xac, 0x63, 0x4d, 0x23, 0x65, 0xb7,
+	0xb5, 0xb7, 0xf5, 0xf3, 0xa6, 0x28, 0xf3, 0xbe, 0x3d, 0xc3, 0x17, 0x70, 0xd4, 0x19, 0x1f, 0x4c,
+	0x9d, 0x5d, 0xb0, 0xd6, 0x7d, 0xb0, 0x87, 0x21, 0x3c, 0xbb, 0xd4, 0x3f, 0x64, 0x59, 0xe4, 0xd7,
+	0xe4, 0xe4, 0xca, 0xb1, 0xa1, 0x9b, 0xfb, 0x9a, 0xc4, 0x3e, 0x22, 0x1c, 0x8e, 0x9b, 0xa7, 0x24,
+	0xf3, 0x7b, 0x11, 0xe0, 0x02, 0x0e, 0xae, 0x58, 0x25, 0xb2, 0xa2, 0xf8, 0xae, 0xb0, 0xce, 0x0a,
+	0x68, 0xc9, 0x97, 0x3a, 0xe3, 0xaa, 0x96, 0xae, 0x50, 0x25, 0x7d, 0x26, 0x63, 0x0b, 0xd6, 0x62,
+	0x8e, 0x0b, 0x78, 0x32, 0x1c, 0xb2, 0xbd, 0x17, 0xf1, 0x3b, 0x39, 0x0f, 0xff, 0xae, 0x23, 0xef,
+	0x61, 0x1d, 0x79, 0xff, 0xd7, 0x91, 0xf7, 0x6b, 0x13, 0x4d, 0x1e, 0x36, 0xd1, 0xe4, 0xdf, 0x26,
+	0x9a, 0xa8, 0x59, 0xf7, 0xf8, 0x6f, 0x1f, 0x07, 0x00, 0x15, 0xc3, 0xa2, 0xde, 0x0b, 0x02, 0x00,
+	0x00,
 }
 
 func (m *NotLeader) Marshal() (dAtA []byte, err error) {
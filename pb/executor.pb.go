@@ -66,6 +66,13 @@ type DispatchTaskRequest struct {
 	TaskConfig []byte `protobuf:"bytes,2,opt,name=task_config,json=taskConfig,proto3" json:"task_config,omitempty"`
 	MasterId   string `protobuf:"bytes,3,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
 	WorkerId   string `protobuf:"bytes,4,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// The fields below are how the worker is scheduled, the executor reports
+	// them in heartbeats.
+	Resource       *Resource `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Priority       int32     `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	NonPreemptible bool      `protobuf:"varint,7,opt,name=non_preemptible,json=nonPreemptible,proto3" json:"non_preemptible,omitempty"`
+	// pinned is set if the worker is required to run in specific executors.
+	Pinned bool `protobuf:"varint,8,opt,name=pinned,proto3" json:"pinned,omitempty"`
 }
 
 func (m *DispatchTaskRequest) Reset()         { *m = DispatchTaskRequest{} }
@@ -129,6 +136,98 @@ func (m *DispatchTaskRequest) GetWorkerId() string {
 	return ""
 }
 
+func (m *DispatchTaskRequest) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *DispatchTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *DispatchTaskRequest) GetNonPreemptible() bool {
+	if m != nil {
+		return m.NonPreemptible
+	}
+	return false
+}
+
+func (m *DispatchTaskRequest) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+// Resource is an amount of resource in multiple dimensions.
+type Resource struct {
+	// cpu is in abstract resource units, the same unit as the legacy
+	// ScheduleTask.cost.
+	Cpu int64 `protobuf:"varint,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// memory and disk are in bytes.
+	Memory int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Disk   int64 `protobuf:"varint,3,opt,name=disk,proto3" json:"disk,omitempty"`
+}
+
+func (m *Resource) Reset()         { *m = Resource{} }
+func (m *Resource) String() string { return proto.CompactTextString(m) }
+func (*Resource) ProtoMessage()    {}
+func (*Resource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{1}
+}
+func (m *Resource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Resource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Resource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Resource.Merge(m, src)
+}
+func (m *Resource) XXX_Size() int {
+	return m.Size()
+}
+func (m *Resource) XXX_DiscardUnknown() {
+	xxx_messageInfo_Resource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Resource proto.InternalMessageInfo
+
+func (m *Resource) GetCpu() int64 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *Resource) GetMemory() int64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *Resource) GetDisk() int64 {
+	if m != nil {
+		return m.Disk
+	}
+	return 0
+}
+
 type DispatchTaskResponse struct {
 	ErrorCode    DispatchTaskErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=pb.DispatchTaskErrorCode" json:"error_code,omitempty"`
 	ErrorMessage string                `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
@@ -139,7 +238,7 @@ func (m *DispatchTaskResponse) Reset()         { *m = DispatchTaskResponse{} }
 func (m *DispatchTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*DispatchTaskResponse) ProtoMessage()    {}
 func (*DispatchTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{1}
+	return fileDescriptor_12d1cdcda51e000f, []int{2}
 }
 func (m *DispatchTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,7 +296,7 @@ func (m *CancelBatchTasksRequest) Reset()         { *m = CancelBatchTasksRequest
 func (m *CancelBatchTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelBatchTasksRequest) ProtoMessage()    {}
 func (*CancelBatchTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{2}
+	return fileDescriptor_12d1cdcda51e000f, []int{3}
 }
 func (m *CancelBatchTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +340,7 @@ func (m *PauseBatchTasksRequest) Reset()         { *m = PauseBatchTasksRequest{}
 func (m *PauseBatchTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*PauseBatchTasksRequest) ProtoMessage()    {}
 func (*PauseBatchTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{3}
+	return fileDescriptor_12d1cdcda51e000f, []int{4}
 }
 func (m *PauseBatchTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +384,7 @@ func (m *SubmitBatchTasksRequest) Reset()         { *m = SubmitBatchTasksRequest
 func (m *SubmitBatchTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitBatchTasksRequest) ProtoMessage()    {}
 func (*SubmitBatchTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{4}
+	return fileDescriptor_12d1cdcda51e000f, []int{5}
 }
 func (m *SubmitBatchTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +432,7 @@ func (m *TaskRequest) Reset()         { *m = TaskRequest{} }
 func (m *TaskRequest) String() string { return proto.CompactTextString(m) }
 func (*TaskRequest) ProtoMessage()    {}
 func (*TaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{5}
+	return fileDescriptor_12d1cdcda51e000f, []int{6}
 }
 func (m *TaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +504,7 @@ func (m *SubmitBatchTasksResponse) Reset()         { *m = SubmitBatchTasksRespon
 func (m *SubmitBatchTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitBatchTasksResponse) ProtoMessage()    {}
 func (*SubmitBatchTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{6}
+	return fileDescriptor_12d1cdcda51e000f, []int{7}
 }
 func (m *SubmitBatchTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +548,7 @@ func (m *CancelBatchTasksResponse) Reset()         { *m = CancelBatchTasksRespon
 func (m *CancelBatchTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelBatchTasksResponse) ProtoMessage()    {}
 func (*CancelBatchTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{7}
+	return fileDescriptor_12d1cdcda51e000f, []int{8}
 }
 func (m *CancelBatchTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +592,7 @@ func (m *PauseBatchTasksResponse) Reset()         { *m = PauseBatchTasksResponse
 func (m *PauseBatchTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*PauseBatchTasksResponse) ProtoMessage()    {}
 func (*PauseBatchTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12d1cdcda51e000f, []int{8}
+	return fileDescriptor_12d1cdcda51e000f, []int{9}
 }
 func (m *PauseBatchTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,6 +631,7 @@ func (m *PauseBatchTasksResponse) GetErr() *Error {
 func init() {
 	proto.RegisterEnum("pb.DispatchTaskErrorCode", DispatchTaskErrorCode_name, DispatchTaskErrorCode_value)
 	proto.RegisterType((*DispatchTaskRequest)(nil), "pb.DispatchTaskRequest")
+	proto.RegisterType((*Resource)(nil), "pb.Resource")
 	proto.RegisterType((*DispatchTaskResponse)(nil), "pb.DispatchTaskResponse")
 	proto.RegisterType((*CancelBatchTasksRequest)(nil), "pb.CancelBatchTasksRequest")
 	proto.RegisterType((*PauseBatchTasksRequest)(nil), "pb.PauseBatchTasksRequest")
@@ -545,44 +645,50 @@ func init() {
 func init() { proto.RegisterFile("executor.proto", fileDescriptor_12d1cdcda51e000f) }
 
 var fileDescriptor_12d1cdcda51e000f = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x18, 0xf4, 0x9f, 0xa6, 0x6d, 0x3e, 0xe7, 0x97, 0x5a, 0xdb, 0x1f, 0x8d, 0x49, 0x90, 0xb1, 0x8c,
-	0x90, 0x22, 0x0e, 0x39, 0x04, 0x09, 0x10, 0x5c, 0x50, 0x43, 0x91, 0x22, 0x0a, 0x41, 0x26, 0x48,
-	0xdc, 0x22, 0xc7, 0x5e, 0x5a, 0x2b, 0x89, 0x77, 0xf1, 0xae, 0x55, 0xfa, 0x16, 0x88, 0x17, 0xe2,
-	0xca, 0xb1, 0x47, 0x8e, 0x28, 0xb9, 0xf0, 0x18, 0x68, 0x77, 0xe3, 0x2a, 0x75, 0x12, 0xa9, 0xe2,
-	0x96, 0x9d, 0xf9, 0x66, 0x32, 0x99, 0x6f, 0x37, 0x50, 0xc7, 0x5f, 0x71, 0x94, 0x73, 0x92, 0x75,
-	0x68, 0x46, 0x38, 0x41, 0x06, 0x1d, 0x37, 0x2d, 0x9c, 0x65, 0x05, 0xe0, 0x7f, 0xd7, 0xe1, 0xf0,
-	0x55, 0xc2, 0x68, 0xc8, 0xa3, 0xf3, 0x61, 0xc8, 0x26, 0x01, 0xfe, 0x92, 0x63, 0xc6, 0x91, 0x07,
-	0x35, 0x1e, 0xb2, 0xc9, 0x88, 0x5f, 0x52, 0x3c, 0x4a, 0x62, 0x47, 0xf7, 0xf4, 0xb6, 0x19, 0x80,
-	0xc0, 0x86, 0x97, 0x14, 0xf7, 0x63, 0x74, 0x1f, 0x2c, 0x39, 0x11, 0x91, 0xf4, 0x73, 0x72, 0xe6,
-	0x18, 0x9e, 0xde, 0xae, 0xa9, 0x81, 0x9e, 0x44, 0x50, 0x0b, 0xaa, 0xb3, 0x90, 0x71, 0x9c, 0x09,
-	0xbd, 0xe9, 0xe9, 0xed, 0x6a, 0xb0, 0xaf, 0x80, 0x7e, 0x2c, 0xc8, 0x0b, 0x92, 0x4d, 0x14, 0xb9,
-	0xa3, 0x48, 0x05, 0xf4, 0x63, 0x11, 0xea, 0xff, 0x9b, 0xa1, 0x18, 0x25, 0x29, 0xc3, 0xe8, 0x19,
-	0x80, 0x0c, 0x3f, 0x8a, 0x48, 0x8c, 0x65, 0xa6, 0x7a, 0xf7, 0x6e, 0x87, 0x8e, 0x3b, 0xab, 0xd3,
-	0x27, 0x62, 0xa2, 0x47, 0x62, 0x1c, 0x54, 0x71, 0xf1, 0x11, 0x3d, 0x80, 0xff, 0x94, 0x72, 0x86,
-	0x19, 0x0b, 0xcf, 0xb0, 0xcc, 0x5b, 0x0d, 0x6a, 0x12, 0x7c, 0xab, 0xb0, 0x9b, 0xa1, 0xcc, 0x52,
-	0xa8, 0x17, 0xd0, 0xe8, 0x85, 0x69, 0x84, 0xa7, 0xc7, 0xc5, 0x17, 0xb1, 0x72, 0x59, 0x49, 0x3c,
-	0x9a, 0x26, 0x8c, 0x3b, 0xba, 0x67, 0x16, 0x65, 0xf5, 0xe3, 0xd3, 0x84, 0x71, 0xff, 0x39, 0x1c,
-	0xbd, 0x0f, 0x73, 0x86, 0xff, 0x45, 0xfb, 0x12, 0x1a, 0x1f, 0xf2, 0xf1, 0x2c, 0xe1, 0xeb, 0xe2,
-	0x87, 0x50, 0x11, 0x83, 0xcc, 0x31, 0x3c, 0xb3, 0x6d, 0x75, 0x0f, 0x44, 0x15, 0x2b, 0x5b, 0x0c,
-	0x14, 0xeb, 0x73, 0xb0, 0x56, 0x77, 0x5b, 0x07, 0xe3, 0x7a, 0xa3, 0x46, 0x12, 0xa3, 0x23, 0xd8,
-	0x4d, 0x52, 0x9a, 0x73, 0x65, 0x63, 0x06, 0xcb, 0x13, 0x72, 0x60, 0x8f, 0xe4, 0x5c, 0x12, 0xa6,
-	0x24, 0x8a, 0xa3, 0x70, 0x20, 0x54, 0xae, 0xad, 0x16, 0x18, 0x84, 0xa2, 0x43, 0xa8, 0x10, 0x3a,
-	0xe2, 0xd4, 0xa9, 0x78, 0x7a, 0xbb, 0x12, 0xec, 0x10, 0x3a, 0xa4, 0xfe, 0x53, 0x70, 0xd6, 0x73,
-	0x2f, 0x17, 0xd9, 0x02, 0x13, 0x67, 0x99, 0xcc, 0x60, 0x75, 0xab, 0x22, 0xb6, 0xdc, 0x5a, 0x20,
-	0x50, 0x21, 0x5c, 0x6f, 0xfa, 0x36, 0xc2, 0x27, 0xd0, 0x58, 0x6b, 0xf9, 0x16, 0xba, 0x47, 0x9f,
-	0xe0, 0xce, 0xc6, 0x0b, 0x84, 0x2c, 0xd8, 0xfb, 0x98, 0x4e, 0x52, 0x72, 0x91, 0xda, 0x1a, 0xda,
-	0x05, 0x63, 0xf0, 0xc6, 0xd6, 0x51, 0x1d, 0xe0, 0x1d, 0x09, 0x30, 0x23, 0x79, 0x16, 0x61, 0xdb,
-	0x10, 0xe7, 0x7e, 0x9a, 0xf0, 0xd7, 0x61, 0x32, 0xc5, 0xb1, 0x6d, 0x22, 0x80, 0xca, 0x80, 0x9f,
-	0xe3, 0xcc, 0xfe, 0xb3, 0xd7, 0xfd, 0x61, 0xc0, 0xfe, 0xc9, 0xf2, 0x09, 0xa2, 0x01, 0xd8, 0xe5,
-	0x42, 0x50, 0x4b, 0x44, 0xd9, 0xb2, 0xde, 0xe6, 0xbd, 0xcd, 0xa4, 0xfa, 0x49, 0xbe, 0x26, 0x0c,
-	0xcb, 0x45, 0x29, 0xc3, 0x2d, 0x17, 0x55, 0x19, 0x6e, 0xeb, 0xd6, 0xd7, 0xd0, 0x29, 0x1c, 0x94,
-	0x0a, 0x44, 0x4d, 0x21, 0xd9, 0x7c, 0x77, 0x9b, 0xad, 0x8d, 0xdc, 0xb5, 0x5b, 0x0f, 0x6a, 0xab,
-	0xb5, 0xa2, 0x46, 0xf9, 0xa5, 0x16, 0x3e, 0xce, 0x3a, 0x51, 0x98, 0x1c, 0x3b, 0x3f, 0xe7, 0xae,
-	0x7e, 0x35, 0x77, 0xf5, 0xdf, 0x73, 0x57, 0xff, 0xb6, 0x70, 0xb5, 0xab, 0x85, 0xab, 0xfd, 0x5a,
-	0xb8, 0xda, 0x78, 0x57, 0xfe, 0x83, 0x3d, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x32, 0x51, 0xe8,
-	0xc0, 0xe4, 0x04, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8d, 0xed, 0xe6, 0xdf, 0x24, 0xbf, 0x34, 0xda, 0xfe, 0x68, 0x4c, 0x52, 0x05, 0xcb, 0x08,
+	0x61, 0x71, 0xe8, 0x21, 0x48, 0x80, 0xe0, 0x82, 0x1a, 0x8a, 0x88, 0x28, 0xb4, 0x5a, 0x8a, 0xc4,
+	0x2d, 0x72, 0xe2, 0xa5, 0x5d, 0x25, 0xf1, 0x2e, 0xbb, 0x6b, 0x95, 0x7c, 0x0b, 0xc4, 0x8d, 0x4f,
+	0xc3, 0x95, 0x63, 0x8f, 0x1c, 0x51, 0x7b, 0xe1, 0x63, 0xa0, 0x5d, 0xc7, 0x21, 0x4d, 0x52, 0xa9,
+	0xe2, 0xb6, 0xf3, 0xde, 0xcc, 0xf3, 0xec, 0x9b, 0xf1, 0x42, 0x8d, 0x7c, 0x26, 0xc3, 0x44, 0x31,
+	0xb1, 0xcb, 0x05, 0x53, 0x0c, 0xd9, 0x7c, 0xd0, 0xac, 0x10, 0x21, 0x32, 0xc0, 0xff, 0x66, 0xc3,
+	0xd6, 0x0b, 0x2a, 0x79, 0xa8, 0x86, 0xa7, 0xc7, 0xa1, 0x1c, 0x61, 0xf2, 0x29, 0x21, 0x52, 0x21,
+	0x0f, 0xaa, 0x2a, 0x94, 0xa3, 0xbe, 0x9a, 0x72, 0xd2, 0xa7, 0x91, 0x6b, 0x79, 0x56, 0xe0, 0x60,
+	0xd0, 0xd8, 0xf1, 0x94, 0x93, 0x5e, 0x84, 0xee, 0x40, 0xc5, 0x64, 0x0c, 0x59, 0xfc, 0x91, 0x9e,
+	0xb8, 0xb6, 0x67, 0x05, 0xd5, 0x34, 0xa1, 0x6b, 0x10, 0xd4, 0x82, 0xf2, 0x24, 0x94, 0x8a, 0x08,
+	0x5d, 0xef, 0x78, 0x56, 0x50, 0xc6, 0xa5, 0x14, 0xe8, 0x45, 0x9a, 0x3c, 0x63, 0x62, 0x94, 0x92,
+	0x1b, 0x29, 0x99, 0x02, 0xbd, 0x08, 0x05, 0x50, 0x12, 0x44, 0xb2, 0x44, 0x0c, 0x89, 0x9b, 0xf7,
+	0xac, 0xa0, 0xd2, 0xa9, 0xee, 0xf2, 0xc1, 0x2e, 0x9e, 0x61, 0x78, 0xce, 0xa2, 0x26, 0x94, 0xb8,
+	0xa0, 0x4c, 0x50, 0x35, 0x75, 0x0b, 0x9e, 0x15, 0xe4, 0xf1, 0x3c, 0x46, 0xf7, 0x61, 0x33, 0x66,
+	0x71, 0x9f, 0x0b, 0x42, 0x26, 0x5c, 0xd1, 0xc1, 0x98, 0xb8, 0x45, 0xcf, 0x0a, 0x4a, 0xb8, 0x16,
+	0xb3, 0xf8, 0xe8, 0x2f, 0x8a, 0xb6, 0xa1, 0xc0, 0x69, 0x1c, 0x93, 0xc8, 0x2d, 0x19, 0x7e, 0x16,
+	0xf9, 0xaf, 0xa0, 0x94, 0x7d, 0x12, 0xd5, 0xc1, 0x19, 0xf2, 0x64, 0x66, 0x83, 0x3e, 0xea, 0xaa,
+	0x09, 0x99, 0x30, 0x31, 0x35, 0x57, 0x77, 0xf0, 0x2c, 0x42, 0x08, 0x36, 0x22, 0x2a, 0x47, 0xe6,
+	0xc6, 0x0e, 0x36, 0x67, 0xff, 0xab, 0x05, 0xff, 0x5f, 0x75, 0x59, 0x72, 0x16, 0x4b, 0x82, 0x9e,
+	0x00, 0x98, 0x69, 0xf4, 0x87, 0x2c, 0x22, 0x46, 0xbd, 0xd6, 0xb9, 0xad, 0xef, 0xba, 0x98, 0xbd,
+	0xaf, 0x33, 0xba, 0x2c, 0x22, 0xb8, 0x4c, 0xb2, 0x23, 0xba, 0x0b, 0xff, 0xa5, 0x95, 0x13, 0x22,
+	0x65, 0x78, 0x42, 0x4c, 0x17, 0x65, 0x5c, 0x35, 0xe0, 0x9b, 0x14, 0xbb, 0xea, 0xb2, 0x73, 0xd5,
+	0x65, 0xff, 0x19, 0x34, 0xba, 0x61, 0x3c, 0x24, 0xe3, 0xbd, 0xec, 0x43, 0x72, 0x79, 0xfa, 0x34,
+	0xea, 0x8f, 0xa9, 0x54, 0xae, 0xe5, 0x39, 0xd9, 0xf4, 0x7b, 0xd1, 0x01, 0x95, 0xca, 0x7f, 0x0a,
+	0xdb, 0x47, 0x61, 0x22, 0xc9, 0xbf, 0xd4, 0x3e, 0x87, 0xc6, 0xbb, 0x64, 0x30, 0xa1, 0x6a, 0xb5,
+	0xf8, 0x1e, 0xe4, 0x75, 0xa2, 0x74, 0x6d, 0xcf, 0x09, 0x2a, 0x9d, 0x4d, 0x6d, 0xc5, 0xc2, 0x5a,
+	0xe2, 0x94, 0xf5, 0x15, 0x54, 0x16, 0x50, 0x54, 0x03, 0x7b, 0xbe, 0xa2, 0x36, 0x8d, 0xf4, 0x68,
+	0x68, 0xcc, 0x13, 0x95, 0xca, 0x38, 0x78, 0x16, 0x21, 0x17, 0x8a, 0x2c, 0x51, 0x86, 0x70, 0x0c,
+	0x91, 0x85, 0x5a, 0x81, 0x71, 0xb3, 0x87, 0x55, 0x6c, 0x33, 0x8e, 0xb6, 0x20, 0xcf, 0x78, 0x5f,
+	0x71, 0xb3, 0x7e, 0x79, 0xbc, 0xc1, 0xf8, 0x31, 0xf7, 0x1f, 0x83, 0xbb, 0xda, 0xf7, 0x6c, 0x90,
+	0x2d, 0x70, 0x88, 0x10, 0xa6, 0x87, 0x4a, 0xa7, 0xac, 0xdb, 0x36, 0x53, 0xc3, 0x1a, 0xd5, 0x85,
+	0xab, 0x4e, 0xdf, 0xa4, 0xf0, 0x11, 0x34, 0x56, 0x5c, 0xbe, 0x41, 0xdd, 0x83, 0x0f, 0x70, 0x6b,
+	0xed, 0x02, 0xa1, 0x0a, 0x14, 0xdf, 0xc7, 0xa3, 0x98, 0x9d, 0xc5, 0xf5, 0x1c, 0x2a, 0x80, 0x7d,
+	0xf8, 0xba, 0x6e, 0xa1, 0x1a, 0xc0, 0x5b, 0x96, 0x6d, 0x7a, 0xdd, 0xd6, 0x71, 0x2f, 0xa6, 0xea,
+	0x65, 0x48, 0xc7, 0x24, 0xaa, 0x3b, 0x08, 0x20, 0x7f, 0xa8, 0x4e, 0x89, 0xa8, 0xff, 0x2e, 0x76,
+	0xbe, 0xdb, 0x50, 0xda, 0x9f, 0xbd, 0x29, 0xe8, 0x10, 0xea, 0xcb, 0x86, 0xa0, 0x96, 0x6e, 0xe5,
+	0x9a, 0xf1, 0x36, 0x77, 0xd6, 0x93, 0xe9, 0x95, 0xfc, 0x9c, 0x16, 0x5c, 0x36, 0x2a, 0x15, 0xbc,
+	0x66, 0x51, 0x9b, 0x3b, 0xeb, 0xc9, 0xb9, 0xe0, 0x01, 0x6c, 0x2e, 0x19, 0x88, 0x9a, 0xba, 0x64,
+	0xfd, 0xee, 0x36, 0x5b, 0x6b, 0xb9, 0xb9, 0x5a, 0x17, 0xaa, 0x8b, 0xb6, 0xa2, 0xc6, 0xf2, 0x9f,
+	0x9a, 0xe9, 0xb8, 0xab, 0x44, 0x26, 0xb2, 0xe7, 0xfe, 0xb8, 0x68, 0x5b, 0xe7, 0x17, 0x6d, 0xeb,
+	0xd7, 0x45, 0xdb, 0xfa, 0x72, 0xd9, 0xce, 0x9d, 0x5f, 0xb6, 0x73, 0x3f, 0x2f, 0xdb, 0xb9, 0x41,
+	0xc1, 0x3c, 0xc9, 0x0f, 0xff, 0x0c, 0x00, 0x97, 0x90, 0x34, 0x78, 0xb5, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -795,6 +901,43 @@ func (m *DispatchTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NonPreemptible {
+		i--
+		if m.NonPreemptible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Priority != 0 {
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.WorkerId) > 0 {
 		i -= len(m.WorkerId)
 		copy(dAtA[i:], m.WorkerId)
@@ -824,6 +967,44 @@ func (m *DispatchTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disk != 0 {
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Disk))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Memory != 0 {
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Memory))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cpu != 0 {
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Cpu))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DispatchTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -887,21 +1068,21 @@ func (m *CancelBatchTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.TaskIdList) > 0 {
-		dAtA2 := make([]byte, len(m.TaskIdList)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.TaskIdList)*10)
+		var j2 int
 		for _, num1 := range m.TaskIdList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintExecutor(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintExecutor(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -929,21 +1110,21 @@ func (m *PauseBatchTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.TaskIdList) > 0 {
-		dAtA4 := make([]byte, len(m.TaskIdList)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.TaskIdList)*10)
+		var j4 int
 		for _, num1 := range m.TaskIdList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintExecutor(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintExecutor(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1020,40 +1201,40 @@ func (m *TaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Outputs) > 0 {
-		dAtA6 := make([]byte, len(m.Outputs)*10)
-		var j5 int
+		dAtA7 := make([]byte, len(m.Outputs)*10)
+		var j6 int
 		for _, num1 := range m.Outputs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j6++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintExecutor(dAtA, i, uint64(j5))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintExecutor(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Inputs) > 0 {
-		dAtA8 := make([]byte, len(m.Inputs)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.Inputs)*10)
+		var j8 int
 		for _, num1 := range m.Inputs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintExecutor(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintExecutor(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1202,6 +1383,37 @@ func (m *DispatchTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovExecutor(uint64(l))
 	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovExecutor(uint64(m.Priority))
+	}
+	if m.NonPreemptible {
+		n += 2
+	}
+	if m.Pinned {
+		n += 2
+	}
+	return n
+}
+
+func (m *Resource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cpu != 0 {
+		n += 1 + sovExecutor(uint64(m.Cpu))
+	}
+	if m.Memory != 0 {
+		n += 1 + sovExecutor(uint64(m.Memory))
+	}
+	if m.Disk != 0 {
+		n += 1 + sovExecutor(uint64(m.Disk))
+	}
 	return n
 }
 
@@ -1496,6 +1708,208 @@ func (m *DispatchTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.WorkerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonPreemptible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonPreemptible = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Resource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			m.Cpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cpu |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Memory |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			}
+			m.Disk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Disk |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutor(dAtA[iNdEx:])
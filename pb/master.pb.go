@@ -58,12 +58,136 @@ func (JobType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f9c348dec43a6705, []int{0}
 }
 
+// ConcurrencyPolicy decides what to do when a schedule is due while the jobs
+// it has submitted before are still running.
+type ConcurrencyPolicy int32
+
+const (
+	// Allow submits the job anyway.
+	ConcurrencyPolicy_Allow ConcurrencyPolicy = 0
+	// Forbid skips the submission.
+	ConcurrencyPolicy_Forbid ConcurrencyPolicy = 1
+	// Replace cancels the running jobs and then submits the job.
+	ConcurrencyPolicy_Replace ConcurrencyPolicy = 2
+)
+
+var ConcurrencyPolicy_name = map[int32]string{
+	0: "Allow",
+	1: "Forbid",
+	2: "Replace",
+}
+
+var ConcurrencyPolicy_value = map[string]int32{
+	"Allow":   0,
+	"Forbid":  1,
+	"Replace": 2,
+}
+
+func (x ConcurrencyPolicy) String() string {
+	return proto.EnumName(ConcurrencyPolicy_name, int32(x))
+}
+
+func (ConcurrencyPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{1}
+}
+
+// WorkerAffinity is the relation between the host of a worker and the hosts
+// of the other workers of the same master. The executors in the same host are
+// taken as co-located.
+type WorkerAffinity int32
+
+const (
+	WorkerAffinity_NoAffinity WorkerAffinity = 0
+	// Affinity schedules the worker together with the other workers.
+	WorkerAffinity_Affinity WorkerAffinity = 1
+	// AntiAffinity schedules the worker away from the other workers, so that
+	// a host crash doesn't take all of the workers down.
+	WorkerAffinity_AntiAffinity WorkerAffinity = 2
+)
+
+var WorkerAffinity_name = map[int32]string{
+	0: "NoAffinity",
+	1: "Affinity",
+	2: "AntiAffinity",
+}
+
+var WorkerAffinity_value = map[string]int32{
+	"NoAffinity":   0,
+	"Affinity":     1,
+	"AntiAffinity": 2,
+}
+
+func (x WorkerAffinity) String() string {
+	return proto.EnumName(WorkerAffinity_name, int32(x))
+}
+
+func (WorkerAffinity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{2}
+}
+
+// State is the state of the job in the job manager.
+type JobInfo_State int32
+
+const (
+	JobInfo_Pending   JobInfo_State = 0
+	JobInfo_WaitAck   JobInfo_State = 1
+	JobInfo_Online    JobInfo_State = 2
+	JobInfo_Canceling JobInfo_State = 3
+	JobInfo_Failed    JobInfo_State = 4
+	JobInfo_Finished  JobInfo_State = 5
+	JobInfo_Canceled  JobInfo_State = 6
+	// Queued means the job is waiting in the admission queue for cluster
+	// resource.
+	JobInfo_Queued JobInfo_State = 7
+	// Blocked means the job is waiting for its upstream jobs to finish.
+	JobInfo_Blocked JobInfo_State = 8
+)
+
+var JobInfo_State_name = map[int32]string{
+	0: "Pending",
+	1: "WaitAck",
+	2: "Online",
+	3: "Canceling",
+	4: "Failed",
+	5: "Finished",
+	6: "Canceled",
+	7: "Queued",
+	8: "Blocked",
+}
+
+var JobInfo_State_value = map[string]int32{
+	"Pending":   0,
+	"WaitAck":   1,
+	"Online":    2,
+	"Canceling": 3,
+	"Failed":    4,
+	"Finished":  5,
+	"Canceled":  6,
+	"Queued":    7,
+	"Blocked":   8,
+}
+
+func (x JobInfo_State) String() string {
+	return proto.EnumName(JobInfo_State_name, int32(x))
+}
+
+func (JobInfo_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{13, 0}
+}
+
 type HeartbeatRequest struct {
 	ExecutorId    string `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
 	ResourceUsage int32  `protobuf:"varint,2,opt,name=resource_usage,json=resourceUsage,proto3" json:"resource_usage,omitempty"`
 	Status        int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
 	Timestamp     uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Ttl           uint64 `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// worker_ids are the workers running in the executor, the resource
+	// reserved for the workers that have exited is released.
+	WorkerIds []string `protobuf:"bytes,6,rep,name=worker_ids,json=workerIds,proto3" json:"worker_ids,omitempty"`
+	// workers are the workers running in the executor along with the
+	// resource reserved for them, from which the server master rebuilds the
+	// reservations after failover.
+	Workers []*RunningWorker `protobuf:"bytes,7,rep,name=workers,proto3" json:"workers,omitempty"`
 }
 
 func (m *HeartbeatRequest) Reset()         { *m = HeartbeatRequest{} }
@@ -134,6 +258,112 @@ func (m *HeartbeatRequest) GetTtl() uint64 {
 	return 0
 }
 
+func (m *HeartbeatRequest) GetWorkerIds() []string {
+	if m != nil {
+		return m.WorkerIds
+	}
+	return nil
+}
+
+func (m *HeartbeatRequest) GetWorkers() []*RunningWorker {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+type RunningWorker struct {
+	WorkerId       string    `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	MasterId       string    `protobuf:"bytes,2,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	Resource       *Resource `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Priority       int32     `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	NonPreemptible bool      `protobuf:"varint,5,opt,name=non_preemptible,json=nonPreemptible,proto3" json:"non_preemptible,omitempty"`
+	WorkerType     int64     `protobuf:"varint,6,opt,name=worker_type,json=workerType,proto3" json:"worker_type,omitempty"`
+	Pinned         bool      `protobuf:"varint,7,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (m *RunningWorker) Reset()         { *m = RunningWorker{} }
+func (m *RunningWorker) String() string { return proto.CompactTextString(m) }
+func (*RunningWorker) ProtoMessage()    {}
+func (*RunningWorker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{1}
+}
+func (m *RunningWorker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunningWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunningWorker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RunningWorker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunningWorker.Merge(m, src)
+}
+func (m *RunningWorker) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunningWorker) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunningWorker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunningWorker proto.InternalMessageInfo
+
+func (m *RunningWorker) GetWorkerId() string {
+	if m != nil {
+		return m.WorkerId
+	}
+	return ""
+}
+
+func (m *RunningWorker) GetMasterId() string {
+	if m != nil {
+		return m.MasterId
+	}
+	return ""
+}
+
+func (m *RunningWorker) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *RunningWorker) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *RunningWorker) GetNonPreemptible() bool {
+	if m != nil {
+		return m.NonPreemptible
+	}
+	return false
+}
+
+func (m *RunningWorker) GetWorkerType() int64 {
+	if m != nil {
+		return m.WorkerType
+	}
+	return 0
+}
+
+func (m *RunningWorker) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
 type HeartbeatResponse struct {
 	Err    *Error   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
 	Leader string   `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`
@@ -144,7 +374,7 @@ func (m *HeartbeatResponse) Reset()         { *m = HeartbeatResponse{} }
 func (m *HeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*HeartbeatResponse) ProtoMessage()    {}
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{1}
+	return fileDescriptor_f9c348dec43a6705, []int{2}
 }
 func (m *HeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,13 +429,24 @@ type SubmitJobRequest struct {
 	Config []byte  `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	// User name, token, etc...
 	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// TODO: Resource Limit
+	// job_name is an optional unique name of the job, it is used as the job
+	// ID if provided, so that retried submissions of a job are rejected.
+	JobName string `protobuf:"bytes,4,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// priority decides the order in which the jobs waiting for cluster
+	// resource are admitted, a job with higher priority is admitted first.
+	Priority int32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// depends_on are the IDs of the upstream jobs, the job is blocked until
+	// all of them are finished, and it is failed or canceled if any of them
+	// is failed or canceled.
+	DependsOn []string `protobuf:"bytes,6,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
 }
 
 func (m *SubmitJobRequest) Reset()         { *m = SubmitJobRequest{} }
 func (m *SubmitJobRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitJobRequest) ProtoMessage()    {}
 func (*SubmitJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{2}
+	return fileDescriptor_f9c348dec43a6705, []int{3}
 }
 func (m *SubmitJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,6 +496,27 @@ func (m *SubmitJobRequest) GetUser() string {
 	return ""
 }
 
+func (m *SubmitJobRequest) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *SubmitJobRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *SubmitJobRequest) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
 type CancelJobRequest struct {
 	JobId    int32  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Deprecated: Do not use.
 	JobIdStr string `protobuf:"bytes,2,opt,name=job_id_str,json=jobIdStr,proto3" json:"job_id_str,omitempty"`
@@ -264,7 +526,7 @@ func (m *CancelJobRequest) Reset()         { *m = CancelJobRequest{} }
 func (m *CancelJobRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelJobRequest) ProtoMessage()    {}
 func (*CancelJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{3}
+	return fileDescriptor_f9c348dec43a6705, []int{4}
 }
 func (m *CancelJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +579,7 @@ func (m *PauseJobRequest) Reset()         { *m = PauseJobRequest{} }
 func (m *PauseJobRequest) String() string { return proto.CompactTextString(m) }
 func (*PauseJobRequest) ProtoMessage()    {}
 func (*PauseJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{4}
+	return fileDescriptor_f9c348dec43a6705, []int{5}
 }
 func (m *PauseJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,6 +623,50 @@ func (m *PauseJobRequest) GetJobIdStr() string {
 	return ""
 }
 
+type ResumeJobRequest struct {
+	JobIdStr string `protobuf:"bytes,1,opt,name=job_id_str,json=jobIdStr,proto3" json:"job_id_str,omitempty"`
+}
+
+func (m *ResumeJobRequest) Reset()         { *m = ResumeJobRequest{} }
+func (m *ResumeJobRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeJobRequest) ProtoMessage()    {}
+func (*ResumeJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{6}
+}
+func (m *ResumeJobRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeJobRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeJobRequest.Merge(m, src)
+}
+func (m *ResumeJobRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeJobRequest proto.InternalMessageInfo
+
+func (m *ResumeJobRequest) GetJobIdStr() string {
+	if m != nil {
+		return m.JobIdStr
+	}
+	return ""
+}
+
 type SubmitJobResponse struct {
 	Err      *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
 	JobId    int32  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Deprecated: Do not use.
@@ -371,7 +677,7 @@ func (m *SubmitJobResponse) Reset()         { *m = SubmitJobResponse{} }
 func (m *SubmitJobResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitJobResponse) ProtoMessage()    {}
 func (*SubmitJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{5}
+	return fileDescriptor_f9c348dec43a6705, []int{7}
 }
 func (m *SubmitJobResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +736,7 @@ func (m *PauseJobResponse) Reset()         { *m = PauseJobResponse{} }
 func (m *PauseJobResponse) String() string { return proto.CompactTextString(m) }
 func (*PauseJobResponse) ProtoMessage()    {}
 func (*PauseJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{6}
+	return fileDescriptor_f9c348dec43a6705, []int{8}
 }
 func (m *PauseJobResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,6 +772,50 @@ func (m *PauseJobResponse) GetErr() *Error {
 	return nil
 }
 
+type ResumeJobResponse struct {
+	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (m *ResumeJobResponse) Reset()         { *m = ResumeJobResponse{} }
+func (m *ResumeJobResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeJobResponse) ProtoMessage()    {}
+func (*ResumeJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{9}
+}
+func (m *ResumeJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeJobResponse.Merge(m, src)
+}
+func (m *ResumeJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeJobResponse proto.InternalMessageInfo
+
+func (m *ResumeJobResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
 type CancelJobResponse struct {
 	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
 }
@@ -474,7 +824,7 @@ func (m *CancelJobResponse) Reset()         { *m = CancelJobResponse{} }
 func (m *CancelJobResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelJobResponse) ProtoMessage()    {}
 func (*CancelJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{7}
+	return fileDescriptor_f9c348dec43a6705, []int{10}
 }
 func (m *CancelJobResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,26 +860,22 @@ func (m *CancelJobResponse) GetErr() *Error {
 	return nil
 }
 
-type RegisterExecutorRequest struct {
-	// dm need 'worker-name' to locate the worker.
-	// TODO: Do we really need a "worker name"? Can we use address to identify an executor?
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Capability int64  `protobuf:"varint,3,opt,name=capability,proto3" json:"capability,omitempty"`
+type QueryJobRequest struct {
+	JobIdStr string `protobuf:"bytes,1,opt,name=job_id_str,json=jobIdStr,proto3" json:"job_id_str,omitempty"`
 }
 
-func (m *RegisterExecutorRequest) Reset()         { *m = RegisterExecutorRequest{} }
-func (m *RegisterExecutorRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterExecutorRequest) ProtoMessage()    {}
-func (*RegisterExecutorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{8}
+func (m *QueryJobRequest) Reset()         { *m = QueryJobRequest{} }
+func (m *QueryJobRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJobRequest) ProtoMessage()    {}
+func (*QueryJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{11}
 }
-func (m *RegisterExecutorRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterExecutorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterExecutorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryJobRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -539,56 +885,45 @@ func (m *RegisterExecutorRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *RegisterExecutorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterExecutorRequest.Merge(m, src)
+func (m *QueryJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJobRequest.Merge(m, src)
 }
-func (m *RegisterExecutorRequest) XXX_Size() int {
+func (m *QueryJobRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterExecutorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterExecutorRequest.DiscardUnknown(m)
+func (m *QueryJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterExecutorRequest proto.InternalMessageInfo
-
-func (m *RegisterExecutorRequest) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+var xxx_messageInfo_QueryJobRequest proto.InternalMessageInfo
 
-func (m *RegisterExecutorRequest) GetVersion() string {
+func (m *QueryJobRequest) GetJobIdStr() string {
 	if m != nil {
-		return m.Version
+		return m.JobIdStr
 	}
 	return ""
 }
 
-func (m *RegisterExecutorRequest) GetCapability() int64 {
-	if m != nil {
-		return m.Capability
-	}
-	return 0
-}
-
-type RegisterExecutorResponse struct {
-	Err        *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
-	ExecutorId string `protobuf:"bytes,2,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+type WorkerStatusInfo struct {
+	WorkerId     string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Code         int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// ext is the json encoded business-specific status.
+	Ext []byte `protobuf:"bytes,4,opt,name=ext,proto3" json:"ext,omitempty"`
 }
 
-func (m *RegisterExecutorResponse) Reset()         { *m = RegisterExecutorResponse{} }
-func (m *RegisterExecutorResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterExecutorResponse) ProtoMessage()    {}
-func (*RegisterExecutorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{9}
+func (m *WorkerStatusInfo) Reset()         { *m = WorkerStatusInfo{} }
+func (m *WorkerStatusInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkerStatusInfo) ProtoMessage()    {}
+func (*WorkerStatusInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{12}
 }
-func (m *RegisterExecutorResponse) XXX_Unmarshal(b []byte) error {
+func (m *WorkerStatusInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegisterExecutorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WorkerStatusInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegisterExecutorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WorkerStatusInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -598,50 +933,89 @@ func (m *RegisterExecutorResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *RegisterExecutorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterExecutorResponse.Merge(m, src)
+func (m *WorkerStatusInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerStatusInfo.Merge(m, src)
 }
-func (m *RegisterExecutorResponse) XXX_Size() int {
+func (m *WorkerStatusInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegisterExecutorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterExecutorResponse.DiscardUnknown(m)
+func (m *WorkerStatusInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerStatusInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterExecutorResponse proto.InternalMessageInfo
+var xxx_messageInfo_WorkerStatusInfo proto.InternalMessageInfo
 
-func (m *RegisterExecutorResponse) GetErr() *Error {
+func (m *WorkerStatusInfo) GetWorkerId() string {
 	if m != nil {
-		return m.Err
+		return m.WorkerId
 	}
-	return nil
+	return ""
 }
 
-func (m *RegisterExecutorResponse) GetExecutorId() string {
+func (m *WorkerStatusInfo) GetCode() int32 {
 	if m != nil {
-		return m.ExecutorId
+		return m.Code
 	}
-	return ""
+	return 0
 }
 
-type ScheduleTask struct {
-	Task              *TaskRequest `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
-	Cost              int64        `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	PreferredLocation string       `protobuf:"bytes,3,opt,name=preferred_location,json=preferredLocation,proto3" json:"preferred_location,omitempty"`
+func (m *WorkerStatusInfo) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
 }
 
-func (m *ScheduleTask) Reset()         { *m = ScheduleTask{} }
-func (m *ScheduleTask) String() string { return proto.CompactTextString(m) }
-func (*ScheduleTask) ProtoMessage()    {}
-func (*ScheduleTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{10}
+func (m *WorkerStatusInfo) GetExt() []byte {
+	if m != nil {
+		return m.Ext
+	}
+	return nil
 }
-func (m *ScheduleTask) XXX_Unmarshal(b []byte) error {
+
+type JobInfo struct {
+	JobIdStr string        `protobuf:"bytes,1,opt,name=job_id_str,json=jobIdStr,proto3" json:"job_id_str,omitempty"`
+	State    JobInfo_State `protobuf:"varint,2,opt,name=state,proto3,enum=pb.JobInfo_State" json:"state,omitempty"`
+	// tp is the worker type of the job master.
+	Tp     int64  `protobuf:"varint,3,opt,name=tp,proto3" json:"tp,omitempty"`
+	Config []byte `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// status is the status of the job master, it is empty if the job master
+	// is not online.
+	Status  *WorkerStatusInfo   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Workers []*WorkerStatusInfo `protobuf:"bytes,6,rep,name=workers,proto3" json:"workers,omitempty"`
+	// failures are the failures of the job master, the job master is created
+	// again after a backoff until the restart policy is exhausted.
+	Failures []*JobFailure `protobuf:"bytes,7,rep,name=failures,proto3" json:"failures,omitempty"`
+	// start_time and end_time are formatted in RFC3339, end_time is empty if
+	// the job is not terminated.
+	StartTime string `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   string `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// error is why the job is failed.
+	Error    string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Priority int32  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// queue_position is the 1-based position of the job in the admission
+	// queue, it is 0 if the job is not queued.
+	QueuePosition int64    `protobuf:"varint,12,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	DependsOn     []string `protobuf:"bytes,13,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// config_version is increased each time the config is updated, and
+	// config_error is why the running job master has rejected the config of
+	// this version.
+	ConfigVersion int64  `protobuf:"varint,14,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	ConfigError   string `protobuf:"bytes,15,opt,name=config_error,json=configError,proto3" json:"config_error,omitempty"`
+}
+
+func (m *JobInfo) Reset()         { *m = JobInfo{} }
+func (m *JobInfo) String() string { return proto.CompactTextString(m) }
+func (*JobInfo) ProtoMessage()    {}
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{13}
+}
+func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScheduleTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScheduleTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -651,58 +1025,141 @@ func (m *ScheduleTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ScheduleTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScheduleTask.Merge(m, src)
+func (m *JobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobInfo.Merge(m, src)
 }
-func (m *ScheduleTask) XXX_Size() int {
+func (m *JobInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScheduleTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScheduleTask.DiscardUnknown(m)
+func (m *JobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScheduleTask proto.InternalMessageInfo
+var xxx_messageInfo_JobInfo proto.InternalMessageInfo
 
-func (m *ScheduleTask) GetTask() *TaskRequest {
+func (m *JobInfo) GetJobIdStr() string {
 	if m != nil {
-		return m.Task
+		return m.JobIdStr
+	}
+	return ""
+}
+
+func (m *JobInfo) GetState() JobInfo_State {
+	if m != nil {
+		return m.State
+	}
+	return JobInfo_Pending
+}
+
+func (m *JobInfo) GetTp() int64 {
+	if m != nil {
+		return m.Tp
+	}
+	return 0
+}
+
+func (m *JobInfo) GetConfig() []byte {
+	if m != nil {
+		return m.Config
 	}
 	return nil
 }
 
-func (m *ScheduleTask) GetCost() int64 {
+func (m *JobInfo) GetStatus() *WorkerStatusInfo {
 	if m != nil {
-		return m.Cost
+		return m.Status
+	}
+	return nil
+}
+
+func (m *JobInfo) GetWorkers() []*WorkerStatusInfo {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+func (m *JobInfo) GetFailures() []*JobFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *JobInfo) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *JobInfo) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *JobInfo) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *JobInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
 	}
 	return 0
 }
 
-func (m *ScheduleTask) GetPreferredLocation() string {
+func (m *JobInfo) GetQueuePosition() int64 {
 	if m != nil {
-		return m.PreferredLocation
+		return m.QueuePosition
+	}
+	return 0
+}
+
+func (m *JobInfo) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *JobInfo) GetConfigVersion() int64 {
+	if m != nil {
+		return m.ConfigVersion
+	}
+	return 0
+}
+
+func (m *JobInfo) GetConfigError() string {
+	if m != nil {
+		return m.ConfigError
 	}
 	return ""
 }
 
-// TaskSchedulerRequest is sent from job master to server master, server master
-// applies resource from resource manager, allocates executor to tasks.
-// The request contains an array of ScheduleTask.
-type TaskSchedulerRequest struct {
-	Tasks []*ScheduleTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+type JobFailure struct {
+	// time is formatted in RFC3339.
+	Time   string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *TaskSchedulerRequest) Reset()         { *m = TaskSchedulerRequest{} }
-func (m *TaskSchedulerRequest) String() string { return proto.CompactTextString(m) }
-func (*TaskSchedulerRequest) ProtoMessage()    {}
-func (*TaskSchedulerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{11}
+func (m *JobFailure) Reset()         { *m = JobFailure{} }
+func (m *JobFailure) String() string { return proto.CompactTextString(m) }
+func (*JobFailure) ProtoMessage()    {}
+func (*JobFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{14}
 }
-func (m *TaskSchedulerRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TaskSchedulerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TaskSchedulerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobFailure.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -712,44 +1169,49 @@ func (m *TaskSchedulerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TaskSchedulerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskSchedulerRequest.Merge(m, src)
+func (m *JobFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobFailure.Merge(m, src)
 }
-func (m *TaskSchedulerRequest) XXX_Size() int {
+func (m *JobFailure) XXX_Size() int {
 	return m.Size()
 }
-func (m *TaskSchedulerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskSchedulerRequest.DiscardUnknown(m)
+func (m *JobFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobFailure.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TaskSchedulerRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobFailure proto.InternalMessageInfo
 
-func (m *TaskSchedulerRequest) GetTasks() []*ScheduleTask {
+func (m *JobFailure) GetTime() string {
 	if m != nil {
-		return m.Tasks
+		return m.Time
 	}
-	return nil
+	return ""
 }
 
-// ScheduleResult represents the where the task(sub job) will be running.
-// Currently it contains an executor id.
-type ScheduleResult struct {
-	ExecutorId string `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
-	Addr       string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+func (m *JobFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *ScheduleResult) Reset()         { *m = ScheduleResult{} }
-func (m *ScheduleResult) String() string { return proto.CompactTextString(m) }
-func (*ScheduleResult) ProtoMessage()    {}
-func (*ScheduleResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{12}
+type QueryJobResponse struct {
+	Err *Error   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	Job *JobInfo `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
 }
-func (m *ScheduleResult) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryJobResponse) Reset()         { *m = QueryJobResponse{} }
+func (m *QueryJobResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJobResponse) ProtoMessage()    {}
+func (*QueryJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{15}
+}
+func (m *QueryJobResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScheduleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScheduleResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryJobResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -759,49 +1221,49 @@ func (m *ScheduleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ScheduleResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScheduleResult.Merge(m, src)
+func (m *QueryJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJobResponse.Merge(m, src)
 }
-func (m *ScheduleResult) XXX_Size() int {
+func (m *QueryJobResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScheduleResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScheduleResult.DiscardUnknown(m)
+func (m *QueryJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJobResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScheduleResult proto.InternalMessageInfo
+var xxx_messageInfo_QueryJobResponse proto.InternalMessageInfo
 
-func (m *ScheduleResult) GetExecutorId() string {
+func (m *QueryJobResponse) GetErr() *Error {
 	if m != nil {
-		return m.ExecutorId
+		return m.Err
 	}
-	return ""
+	return nil
 }
 
-func (m *ScheduleResult) GetAddr() string {
+func (m *QueryJobResponse) GetJob() *JobInfo {
 	if m != nil {
-		return m.Addr
+		return m.Job
 	}
-	return ""
+	return nil
 }
 
-type TaskSchedulerResponse struct {
-	Schedule map[int64]*ScheduleResult `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Err      *Error                    `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+type ListJobsRequest struct {
+	// states filters the jobs by state, all jobs are returned if it is empty.
+	States []JobInfo_State `protobuf:"varint,1,rep,packed,name=states,proto3,enum=pb.JobInfo_State" json:"states,omitempty"`
 }
 
-func (m *TaskSchedulerResponse) Reset()         { *m = TaskSchedulerResponse{} }
-func (m *TaskSchedulerResponse) String() string { return proto.CompactTextString(m) }
-func (*TaskSchedulerResponse) ProtoMessage()    {}
-func (*TaskSchedulerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{13}
+func (m *ListJobsRequest) Reset()         { *m = ListJobsRequest{} }
+func (m *ListJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJobsRequest) ProtoMessage()    {}
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{16}
 }
-func (m *TaskSchedulerResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListJobsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TaskSchedulerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TaskSchedulerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListJobsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -811,49 +1273,42 @@ func (m *TaskSchedulerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *TaskSchedulerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskSchedulerResponse.Merge(m, src)
+func (m *ListJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsRequest.Merge(m, src)
 }
-func (m *TaskSchedulerResponse) XXX_Size() int {
+func (m *ListJobsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TaskSchedulerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskSchedulerResponse.DiscardUnknown(m)
+func (m *ListJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TaskSchedulerResponse proto.InternalMessageInfo
-
-func (m *TaskSchedulerResponse) GetSchedule() map[int64]*ScheduleResult {
-	if m != nil {
-		return m.Schedule
-	}
-	return nil
-}
+var xxx_messageInfo_ListJobsRequest proto.InternalMessageInfo
 
-func (m *TaskSchedulerResponse) GetErr() *Error {
+func (m *ListJobsRequest) GetStates() []JobInfo_State {
 	if m != nil {
-		return m.Err
+		return m.States
 	}
 	return nil
 }
 
-type ExecWorkload struct {
-	Tp    JobType `protobuf:"varint,1,opt,name=tp,proto3,enum=pb.JobType" json:"tp,omitempty"`
-	Usage int32   `protobuf:"varint,2,opt,name=usage,proto3" json:"usage,omitempty"`
+type ListJobsResponse struct {
+	Err  *Error     `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	Jobs []*JobInfo `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
 }
 
-func (m *ExecWorkload) Reset()         { *m = ExecWorkload{} }
-func (m *ExecWorkload) String() string { return proto.CompactTextString(m) }
-func (*ExecWorkload) ProtoMessage()    {}
-func (*ExecWorkload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{14}
+func (m *ListJobsResponse) Reset()         { *m = ListJobsResponse{} }
+func (m *ListJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJobsResponse) ProtoMessage()    {}
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{17}
 }
-func (m *ExecWorkload) XXX_Unmarshal(b []byte) error {
+func (m *ListJobsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecWorkload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecWorkload.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListJobsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -863,49 +1318,49 @@ func (m *ExecWorkload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ExecWorkload) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecWorkload.Merge(m, src)
+func (m *ListJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsResponse.Merge(m, src)
 }
-func (m *ExecWorkload) XXX_Size() int {
+func (m *ListJobsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExecWorkload) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecWorkload.DiscardUnknown(m)
+func (m *ListJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecWorkload proto.InternalMessageInfo
+var xxx_messageInfo_ListJobsResponse proto.InternalMessageInfo
 
-func (m *ExecWorkload) GetTp() JobType {
+func (m *ListJobsResponse) GetErr() *Error {
 	if m != nil {
-		return m.Tp
+		return m.Err
 	}
-	return JobType_CVSDemo
+	return nil
 }
 
-func (m *ExecWorkload) GetUsage() int32 {
+func (m *ListJobsResponse) GetJobs() []*JobInfo {
 	if m != nil {
-		return m.Usage
+		return m.Jobs
 	}
-	return 0
+	return nil
 }
 
-type ExecWorkloadRequest struct {
-	ExecutorId string          `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
-	Workloads  []*ExecWorkload `protobuf:"bytes,2,rep,name=workloads,proto3" json:"workloads,omitempty"`
+type UpdateJobConfigRequest struct {
+	JobIdStr string `protobuf:"bytes,1,opt,name=job_id_str,json=jobIdStr,proto3" json:"job_id_str,omitempty"`
+	Config   []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (m *ExecWorkloadRequest) Reset()         { *m = ExecWorkloadRequest{} }
-func (m *ExecWorkloadRequest) String() string { return proto.CompactTextString(m) }
-func (*ExecWorkloadRequest) ProtoMessage()    {}
-func (*ExecWorkloadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{15}
+func (m *UpdateJobConfigRequest) Reset()         { *m = UpdateJobConfigRequest{} }
+func (m *UpdateJobConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateJobConfigRequest) ProtoMessage()    {}
+func (*UpdateJobConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{18}
 }
-func (m *ExecWorkloadRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateJobConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecWorkloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateJobConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecWorkloadRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateJobConfigRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -915,48 +1370,50 @@ func (m *ExecWorkloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ExecWorkloadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecWorkloadRequest.Merge(m, src)
+func (m *UpdateJobConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateJobConfigRequest.Merge(m, src)
 }
-func (m *ExecWorkloadRequest) XXX_Size() int {
+func (m *UpdateJobConfigRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExecWorkloadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecWorkloadRequest.DiscardUnknown(m)
+func (m *UpdateJobConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateJobConfigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecWorkloadRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateJobConfigRequest proto.InternalMessageInfo
 
-func (m *ExecWorkloadRequest) GetExecutorId() string {
+func (m *UpdateJobConfigRequest) GetJobIdStr() string {
 	if m != nil {
-		return m.ExecutorId
+		return m.JobIdStr
 	}
 	return ""
 }
 
-func (m *ExecWorkloadRequest) GetWorkloads() []*ExecWorkload {
+func (m *UpdateJobConfigRequest) GetConfig() []byte {
 	if m != nil {
-		return m.Workloads
+		return m.Config
 	}
 	return nil
 }
 
-type ExecWorkloadResponse struct {
+type UpdateJobConfigResponse struct {
 	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	// config_version is the version of the updated config.
+	ConfigVersion int64 `protobuf:"varint,2,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 }
 
-func (m *ExecWorkloadResponse) Reset()         { *m = ExecWorkloadResponse{} }
-func (m *ExecWorkloadResponse) String() string { return proto.CompactTextString(m) }
-func (*ExecWorkloadResponse) ProtoMessage()    {}
-func (*ExecWorkloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{16}
+func (m *UpdateJobConfigResponse) Reset()         { *m = UpdateJobConfigResponse{} }
+func (m *UpdateJobConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateJobConfigResponse) ProtoMessage()    {}
+func (*UpdateJobConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{19}
 }
-func (m *ExecWorkloadResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateJobConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExecWorkloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateJobConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExecWorkloadResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateJobConfigResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -966,1524 +1423,10461 @@ func (m *ExecWorkloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ExecWorkloadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecWorkloadResponse.Merge(m, src)
+func (m *UpdateJobConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateJobConfigResponse.Merge(m, src)
 }
-func (m *ExecWorkloadResponse) XXX_Size() int {
+func (m *UpdateJobConfigResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExecWorkloadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecWorkloadResponse.DiscardUnknown(m)
+func (m *UpdateJobConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateJobConfigResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExecWorkloadResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateJobConfigResponse proto.InternalMessageInfo
 
-func (m *ExecWorkloadResponse) GetErr() *Error {
+func (m *UpdateJobConfigResponse) GetErr() *Error {
 	if m != nil {
 		return m.Err
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("pb.JobType", JobType_name, JobType_value)
-	proto.RegisterType((*HeartbeatRequest)(nil), "pb.HeartbeatRequest")
-	proto.RegisterType((*HeartbeatResponse)(nil), "pb.HeartbeatResponse")
-	proto.RegisterType((*SubmitJobRequest)(nil), "pb.SubmitJobRequest")
-	proto.RegisterType((*CancelJobRequest)(nil), "pb.CancelJobRequest")
-	proto.RegisterType((*PauseJobRequest)(nil), "pb.PauseJobRequest")
-	proto.RegisterType((*SubmitJobResponse)(nil), "pb.SubmitJobResponse")
-	proto.RegisterType((*PauseJobResponse)(nil), "pb.PauseJobResponse")
-	proto.RegisterType((*CancelJobResponse)(nil), "pb.CancelJobResponse")
-	proto.RegisterType((*RegisterExecutorRequest)(nil), "pb.RegisterExecutorRequest")
-	proto.RegisterType((*RegisterExecutorResponse)(nil), "pb.RegisterExecutorResponse")
-	proto.RegisterType((*ScheduleTask)(nil), "pb.ScheduleTask")
-	proto.RegisterType((*TaskSchedulerRequest)(nil), "pb.TaskSchedulerRequest")
-	proto.RegisterType((*ScheduleResult)(nil), "pb.ScheduleResult")
-	proto.RegisterType((*TaskSchedulerResponse)(nil), "pb.TaskSchedulerResponse")
-	proto.RegisterMapType((map[int64]*ScheduleResult)(nil), "pb.TaskSchedulerResponse.ScheduleEntry")
-	proto.RegisterType((*ExecWorkload)(nil), "pb.ExecWorkload")
-	proto.RegisterType((*ExecWorkloadRequest)(nil), "pb.ExecWorkloadRequest")
-	proto.RegisterType((*ExecWorkloadResponse)(nil), "pb.ExecWorkloadResponse")
+func (m *UpdateJobConfigResponse) GetConfigVersion() int64 {
+	if m != nil {
+		return m.ConfigVersion
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("master.proto", fileDescriptor_f9c348dec43a6705) }
-
-var fileDescriptor_f9c348dec43a6705 = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0xed, 0x24, 0x6d, 0x4e, 0xba, 0xa9, 0x33, 0x9b, 0xdd, 0xf5, 0xa6, 0x25, 0x54, 0x46,
-	0x40, 0x84, 0x44, 0x41, 0x5d, 0x24, 0xd0, 0x5e, 0x20, 0xb1, 0x69, 0x10, 0x2d, 0x54, 0x0b, 0x93,
-	0xe5, 0x47, 0x42, 0xa2, 0x1a, 0x27, 0xa7, 0x25, 0x9b, 0x1f, 0x9b, 0x99, 0x71, 0x21, 0x6f, 0xc1,
-	0x33, 0xf0, 0x34, 0x88, 0xab, 0xbd, 0xe4, 0x12, 0xb5, 0x8f, 0xc1, 0x0d, 0x9a, 0xb1, 0xc7, 0x71,
-	0x9c, 0x76, 0x95, 0x0b, 0xee, 0xe6, 0x7c, 0xc7, 0xe7, 0x3b, 0x7f, 0x73, 0xce, 0x18, 0x76, 0x66,
-	0x4c, 0x48, 0xe4, 0x87, 0x11, 0x0f, 0x65, 0x48, 0xec, 0x28, 0x68, 0xd7, 0x91, 0xf3, 0x30, 0x05,
-	0xda, 0x0d, 0xfc, 0x0d, 0x87, 0xb1, 0xcc, 0xe4, 0xdd, 0x19, 0x4a, 0x26, 0x64, 0xc8, 0x31, 0x01,
-	0xfc, 0x3f, 0x2c, 0x70, 0xbf, 0x40, 0xc6, 0x65, 0x80, 0x4c, 0x52, 0xfc, 0x25, 0x46, 0x21, 0xc9,
-	0x9b, 0x50, 0x37, 0x76, 0xe7, 0xe3, 0x91, 0x67, 0x1d, 0x58, 0xdd, 0x1a, 0x05, 0x03, 0x9d, 0x8c,
-	0xc8, 0xdb, 0xd0, 0xe0, 0x28, 0xc2, 0x98, 0x0f, 0xf1, 0x3c, 0x16, 0xec, 0x12, 0x3d, 0xfb, 0xc0,
-	0xea, 0x56, 0xe8, 0x3d, 0x83, 0x7e, 0xab, 0x40, 0xf2, 0x10, 0xaa, 0x42, 0x32, 0x19, 0x0b, 0xcf,
-	0xd1, 0xea, 0x54, 0x22, 0xfb, 0x50, 0x93, 0xe3, 0x19, 0x0a, 0xc9, 0x66, 0x91, 0x57, 0x3e, 0xb0,
-	0xba, 0x65, 0xba, 0x04, 0x88, 0x0b, 0x8e, 0x94, 0x53, 0xaf, 0xa2, 0x71, 0x75, 0xf4, 0x7f, 0x82,
-	0x66, 0x2e, 0x46, 0x11, 0x85, 0x73, 0x81, 0x64, 0x0f, 0x1c, 0xe4, 0x5c, 0x07, 0x57, 0x3f, 0xaa,
-	0x1d, 0x46, 0xc1, 0x61, 0x5f, 0x25, 0x4e, 0x15, 0xaa, 0x3c, 0x4f, 0x91, 0x8d, 0x90, 0xeb, 0xc0,
-	0x6a, 0x34, 0x95, 0x48, 0x0b, 0x2a, 0x6c, 0x34, 0xe2, 0x2a, 0x20, 0xa7, 0x5b, 0xa3, 0x89, 0xe0,
-	0xff, 0x08, 0xee, 0x20, 0x0e, 0x66, 0x63, 0x79, 0x1a, 0x06, 0xa6, 0x06, 0x7b, 0x60, 0xcb, 0x48,
-	0xb3, 0x37, 0x8e, 0xea, 0x8a, 0xfd, 0x34, 0x0c, 0x5e, 0x2c, 0x22, 0xa4, 0xb6, 0x8c, 0x14, 0xfd,
-	0x30, 0x9c, 0x5f, 0x8c, 0x2f, 0x35, 0xfd, 0x0e, 0x4d, 0x25, 0x42, 0xa0, 0x1c, 0x0b, 0xe4, 0x3a,
-	0xdd, 0x1a, 0xd5, 0x67, 0xff, 0x4b, 0x70, 0x7b, 0x6c, 0x3e, 0xc4, 0x69, 0x8e, 0xfc, 0x31, 0x54,
-	0x5f, 0x86, 0x81, 0xa9, 0x6d, 0xe5, 0x99, 0xed, 0x59, 0xb4, 0xf2, 0x32, 0x0c, 0x4e, 0x46, 0x64,
-	0x1f, 0x20, 0x51, 0x9d, 0x0b, 0x69, 0xa2, 0xdf, 0xd6, 0xaa, 0x81, 0xe4, 0xfe, 0x29, 0xec, 0x7e,
-	0xcd, 0x62, 0x81, 0xff, 0x07, 0xd7, 0x18, 0x9a, 0xb9, 0xac, 0x37, 0xa9, 0xea, 0xd2, 0x95, 0xfd,
-	0x7a, 0x57, 0x4e, 0xc1, 0xd5, 0x07, 0xe0, 0x2e, 0xc3, 0xde, 0xc0, 0x93, 0xff, 0x21, 0x34, 0x73,
-	0x45, 0xdb, 0xc4, 0x62, 0x06, 0x8f, 0x28, 0x5e, 0x8e, 0xd5, 0x30, 0xf4, 0xd3, 0x8b, 0x6a, 0x2a,
-	0xe4, 0xc1, 0x96, 0xea, 0x33, 0x0a, 0x91, 0x5e, 0x65, 0x23, 0x2a, 0xcd, 0x15, 0x72, 0x31, 0x0e,
-	0xe7, 0x69, 0x75, 0x8c, 0x48, 0x3a, 0x00, 0x43, 0x16, 0xb1, 0x60, 0x3c, 0x1d, 0xcb, 0x85, 0xce,
-	0xc7, 0xa1, 0x39, 0xc4, 0xff, 0x01, 0xbc, 0x75, 0x77, 0x9b, 0xd4, 0xb0, 0x30, 0x5b, 0x76, 0x71,
-	0xb6, 0xfc, 0x2b, 0xd8, 0x19, 0x0c, 0x7f, 0xc6, 0x51, 0x3c, 0xc5, 0x17, 0x4c, 0x4c, 0xc8, 0x5b,
-	0x50, 0x96, 0x4c, 0x4c, 0x52, 0xba, 0x5d, 0x45, 0xa7, 0xf0, 0x34, 0x39, 0xaa, 0x95, 0xea, 0xe2,
-	0x0d, 0x43, 0x21, 0x35, 0x9d, 0x43, 0xf5, 0x99, 0xbc, 0x0f, 0x24, 0xe2, 0x78, 0x81, 0x9c, 0xe3,
-	0xe8, 0x7c, 0x1a, 0x0e, 0x99, 0x54, 0x79, 0x26, 0xad, 0x69, 0x66, 0x9a, 0xaf, 0x52, 0x85, 0xff,
-	0x29, 0xb4, 0x14, 0xaf, 0xf1, 0x9d, 0x55, 0xef, 0x1d, 0xa8, 0x28, 0x17, 0xaa, 0x76, 0x4e, 0xb7,
-	0x7e, 0xe4, 0xaa, 0x00, 0xf2, 0x01, 0xd2, 0x44, 0xed, 0xf7, 0xa1, 0x61, 0x60, 0x8a, 0x22, 0x9e,
-	0x6e, 0xb0, 0x46, 0x08, 0x94, 0x55, 0x27, 0xd2, 0x22, 0xe8, 0xb3, 0xff, 0x97, 0x05, 0x0f, 0x0a,
-	0x71, 0xa4, 0x65, 0xed, 0xc1, 0xb6, 0x48, 0xc1, 0x34, 0x96, 0x77, 0x4d, 0x31, 0xd6, 0x3e, 0xce,
-	0x22, 0xec, 0xcf, 0x25, 0x5f, 0xd0, 0xcc, 0xd0, 0xf4, 0xc6, 0xbe, 0xad, 0x37, 0xed, 0xe7, 0x70,
-	0x6f, 0xc5, 0x4e, 0xad, 0xa2, 0x09, 0x2e, 0x74, 0xe4, 0x0e, 0x55, 0x47, 0xd2, 0x85, 0xca, 0x15,
-	0x9b, 0xc6, 0x98, 0x32, 0x90, 0x7c, 0x35, 0x92, 0xb4, 0x69, 0xf2, 0xc1, 0x53, 0xfb, 0x13, 0xcb,
-	0xff, 0x0c, 0x76, 0xd4, 0xed, 0xf8, 0x3e, 0xe4, 0x93, 0x69, 0xc8, 0x46, 0xaf, 0x5f, 0x2a, 0x2d,
-	0xa8, 0xe4, 0x77, 0x69, 0x22, 0xf8, 0x17, 0x70, 0x3f, 0x4f, 0xb1, 0xf1, 0x8a, 0x3e, 0x84, 0xda,
-	0xaf, 0xa9, 0x8d, 0xf0, 0xec, 0x65, 0xeb, 0x56, 0xc8, 0x96, 0x9f, 0xf8, 0x4f, 0xa0, 0xb5, 0xea,
-	0x67, 0x83, 0xcb, 0xfc, 0xde, 0x47, 0xb0, 0x95, 0x66, 0x40, 0xea, 0xb0, 0xd5, 0xfb, 0x6e, 0x70,
-	0x8c, 0xb3, 0xd0, 0x2d, 0x91, 0x2a, 0xd8, 0xc7, 0x67, 0xae, 0x45, 0xb6, 0xc0, 0xe9, 0x1d, 0xf7,
-	0x5c, 0x5b, 0x69, 0x3f, 0x67, 0x13, 0x35, 0xff, 0xae, 0x73, 0xf4, 0x6f, 0x19, 0xaa, 0x67, 0xfa,
-	0xd9, 0x22, 0xcf, 0xc1, 0x2d, 0x8e, 0x11, 0xd9, 0x53, 0x4e, 0xee, 0x98, 0xe5, 0xf6, 0xfe, 0xed,
-	0xca, 0x24, 0x58, 0xbf, 0x44, 0x9e, 0x42, 0x2d, 0x5b, 0x6a, 0xa4, 0xa5, 0xbb, 0x53, 0xd8, 0xec,
-	0xed, 0x07, 0x05, 0x34, 0xb3, 0xfd, 0x18, 0xb6, 0xcd, 0x96, 0x22, 0xf7, 0xd5, 0x47, 0x85, 0x55,
-	0xdb, 0x6e, 0xad, 0x82, 0x79, 0xa7, 0xd9, 0xb6, 0x4a, 0x9c, 0x16, 0x37, 0x7e, 0xe2, 0x74, 0x6d,
-	0xa5, 0x25, 0xb6, 0xd9, 0xdb, 0x96, 0xd8, 0x16, 0x9f, 0xe3, 0xc4, 0x76, 0xed, 0x01, 0xf4, 0x4b,
-	0xa4, 0x5f, 0x58, 0x15, 0xde, 0x2d, 0xf3, 0x90, 0x50, 0x3c, 0xbe, 0x73, 0x52, 0xfc, 0x12, 0xa1,
-	0xd0, 0x34, 0x15, 0x3d, 0x43, 0xc9, 0x06, 0xea, 0xf7, 0x80, 0xac, 0x14, 0x3a, 0x83, 0x0d, 0xdf,
-	0x1b, 0x77, 0x68, 0x33, 0xce, 0x13, 0x68, 0x7c, 0x13, 0x23, 0x5f, 0x2c, 0x09, 0x75, 0x08, 0xab,
-	0x98, 0x61, 0x6b, 0xdf, 0xa6, 0xca, 0xa8, 0xce, 0xe0, 0x21, 0xc5, 0x28, 0xe4, 0xd2, 0xb4, 0x3b,
-	0x1b, 0xa7, 0x47, 0x6b, 0x17, 0x3a, 0x25, 0xf4, 0xd6, 0x15, 0x86, 0xee, 0x99, 0xf7, 0xe7, 0x75,
-	0xc7, 0x7a, 0x75, 0xdd, 0xb1, 0xfe, 0xb9, 0xee, 0x58, 0xbf, 0xdf, 0x74, 0x4a, 0xaf, 0x6e, 0x3a,
-	0xa5, 0xbf, 0x6f, 0x3a, 0xa5, 0xa0, 0xaa, 0x7f, 0x89, 0x9e, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0xf3, 0x30, 0x3d, 0x6a, 0x54, 0x09, 0x00, 0x00,
+type CreateScheduleRequest struct {
+	// name is the unique name of the schedule, the jobs submitted by the
+	// schedule are named after it.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cron is a cron expression with five fields: minute, hour, day of month,
+	// month and day of week, or a descriptor such as "@daily". It is
+	// evaluated in UTC.
+	Cron     string            `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	Tp       JobType           `protobuf:"varint,3,opt,name=tp,proto3,enum=pb.JobType" json:"tp,omitempty"`
+	Config   []byte            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Priority int32             `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Policy   ConcurrencyPolicy `protobuf:"varint,6,opt,name=policy,proto3,enum=pb.ConcurrencyPolicy" json:"policy,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MasterClient is the client API for Master service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MasterClient interface {
-	RegisterExecutor(ctx context.Context, in *RegisterExecutorRequest, opts ...grpc.CallOption) (*RegisterExecutorResponse, error)
-	SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*SubmitJobResponse, error)
-	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error)
-	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
-	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
-	ScheduleTask(ctx context.Context, in *TaskSchedulerRequest, opts ...grpc.CallOption) (*TaskSchedulerResponse, error)
-	// RegisterMetaStore is called from backend metastore and
-	// registers to server master metastore manager
-	RegisterMetaStore(ctx context.Context, in *RegisterMetaStoreRequest, opts ...grpc.CallOption) (*RegisterMetaStoreResponse, error)
-	// QueryMetaStore queries metastore manager and returns
-	// the information of a matching metastore
-	QueryMetaStore(ctx context.Context, in *QueryMetaStoreRequest, opts ...grpc.CallOption) (*QueryMetaStoreResponse, error)
-	// ReportExecutorWorkload is called from executor to server master to report
-	// resource usage in executor.
-	ReportExecutorWorkload(ctx context.Context, in *ExecWorkloadRequest, opts ...grpc.CallOption) (*ExecWorkloadResponse, error)
+func (m *CreateScheduleRequest) Reset()         { *m = CreateScheduleRequest{} }
+func (m *CreateScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduleRequest) ProtoMessage()    {}
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{20}
 }
-
-type masterClient struct {
-	cc *grpc.ClientConn
+func (m *CreateScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewMasterClient(cc *grpc.ClientConn) MasterClient {
-	return &masterClient{cc}
+func (m *CreateScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleRequest.Merge(m, src)
+}
+func (m *CreateScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleRequest.DiscardUnknown(m)
 }
 
-func (c *masterClient) RegisterExecutor(ctx context.Context, in *RegisterExecutorRequest, opts ...grpc.CallOption) (*RegisterExecutorResponse, error) {
-	out := new(RegisterExecutorResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/RegisterExecutor", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateScheduleRequest proto.InternalMessageInfo
+
+func (m *CreateScheduleRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*SubmitJobResponse, error) {
-	out := new(SubmitJobResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/SubmitJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateScheduleRequest) GetCron() string {
+	if m != nil {
+		return m.Cron
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error) {
-	out := new(PauseJobResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/PauseJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateScheduleRequest) GetTp() JobType {
+	if m != nil {
+		return m.Tp
 	}
-	return out, nil
+	return JobType_CVSDemo
 }
 
-func (c *masterClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
-	out := new(CancelJobResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/CancelJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateScheduleRequest) GetConfig() []byte {
+	if m != nil {
+		return m.Config
 	}
-	return out, nil
+	return nil
 }
 
-func (c *masterClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
-	out := new(HeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/Heartbeat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateScheduleRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
 	}
-	return out, nil
+	return 0
 }
 
-func (c *masterClient) ScheduleTask(ctx context.Context, in *TaskSchedulerRequest, opts ...grpc.CallOption) (*TaskSchedulerResponse, error) {
-	out := new(TaskSchedulerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/ScheduleTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateScheduleRequest) GetPolicy() ConcurrencyPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return out, nil
+	return ConcurrencyPolicy_Allow
 }
 
-func (c *masterClient) RegisterMetaStore(ctx context.Context, in *RegisterMetaStoreRequest, opts ...grpc.CallOption) (*RegisterMetaStoreResponse, error) {
-	out := new(RegisterMetaStoreResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/RegisterMetaStore", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CreateScheduleResponse struct {
+	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	// next_time is when the first job is submitted, formatted in RFC3339.
+	NextTime string `protobuf:"bytes,2,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"`
 }
 
-func (c *masterClient) QueryMetaStore(ctx context.Context, in *QueryMetaStoreRequest, opts ...grpc.CallOption) (*QueryMetaStoreResponse, error) {
-	out := new(QueryMetaStoreResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/QueryMetaStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateScheduleResponse) Reset()         { *m = CreateScheduleResponse{} }
+func (m *CreateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduleResponse) ProtoMessage()    {}
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{21}
+}
+func (m *CreateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CreateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleResponse.Merge(m, src)
+}
+func (m *CreateScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleResponse.DiscardUnknown(m)
 }
 
-func (c *masterClient) ReportExecutorWorkload(ctx context.Context, in *ExecWorkloadRequest, opts ...grpc.CallOption) (*ExecWorkloadResponse, error) {
-	out := new(ExecWorkloadResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/ReportExecutorWorkload", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateScheduleResponse proto.InternalMessageInfo
+
+func (m *CreateScheduleResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return out, nil
+	return nil
 }
 
-// MasterServer is the server API for Master service.
-type MasterServer interface {
-	RegisterExecutor(context.Context, *RegisterExecutorRequest) (*RegisterExecutorResponse, error)
-	SubmitJob(context.Context, *SubmitJobRequest) (*SubmitJobResponse, error)
-	PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error)
-	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
-	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
-	ScheduleTask(context.Context, *TaskSchedulerRequest) (*TaskSchedulerResponse, error)
-	// RegisterMetaStore is called from backend metastore and
-	// registers to server master metastore manager
-	RegisterMetaStore(context.Context, *RegisterMetaStoreRequest) (*RegisterMetaStoreResponse, error)
-	// QueryMetaStore queries metastore manager and returns
-	// the information of a matching metastore
-	QueryMetaStore(context.Context, *QueryMetaStoreRequest) (*QueryMetaStoreResponse, error)
-	// ReportExecutorWorkload is called from executor to server master to report
-	// resource usage in executor.
-	ReportExecutorWorkload(context.Context, *ExecWorkloadRequest) (*ExecWorkloadResponse, error)
+func (m *CreateScheduleResponse) GetNextTime() string {
+	if m != nil {
+		return m.NextTime
+	}
+	return ""
 }
 
-// UnimplementedMasterServer can be embedded to have forward compatible implementations.
-type UnimplementedMasterServer struct {
+type DeleteScheduleRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (*UnimplementedMasterServer) RegisterExecutor(ctx context.Context, req *RegisterExecutorRequest) (*RegisterExecutorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterExecutor not implemented")
-}
-func (*UnimplementedMasterServer) SubmitJob(ctx context.Context, req *SubmitJobRequest) (*SubmitJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
-}
-func (*UnimplementedMasterServer) PauseJob(ctx context.Context, req *PauseJobRequest) (*PauseJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+func (m *DeleteScheduleRequest) Reset()         { *m = DeleteScheduleRequest{} }
+func (m *DeleteScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteScheduleRequest) ProtoMessage()    {}
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{22}
 }
-func (*UnimplementedMasterServer) CancelJob(ctx context.Context, req *CancelJobRequest) (*CancelJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+func (m *DeleteScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMasterServer) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*HeartbeatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+func (m *DeleteScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMasterServer) ScheduleTask(ctx context.Context, req *TaskSchedulerRequest) (*TaskSchedulerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTask not implemented")
+func (m *DeleteScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduleRequest.Merge(m, src)
 }
-func (*UnimplementedMasterServer) RegisterMetaStore(ctx context.Context, req *RegisterMetaStoreRequest) (*RegisterMetaStoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterMetaStore not implemented")
+func (m *DeleteScheduleRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMasterServer) QueryMetaStore(ctx context.Context, req *QueryMetaStoreRequest) (*QueryMetaStoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryMetaStore not implemented")
+func (m *DeleteScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduleRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMasterServer) ReportExecutorWorkload(ctx context.Context, req *ExecWorkloadRequest) (*ExecWorkloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportExecutorWorkload not implemented")
+
+var xxx_messageInfo_DeleteScheduleRequest proto.InternalMessageInfo
+
+func (m *DeleteScheduleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func RegisterMasterServer(s *grpc.Server, srv MasterServer) {
-	s.RegisterService(&_Master_serviceDesc, srv)
+type DeleteScheduleResponse struct {
+	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
 }
 
-func _Master_RegisterExecutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterExecutorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).RegisterExecutor(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/RegisterExecutor",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).RegisterExecutor(ctx, req.(*RegisterExecutorRequest))
+func (m *DeleteScheduleResponse) Reset()         { *m = DeleteScheduleResponse{} }
+func (m *DeleteScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteScheduleResponse) ProtoMessage()    {}
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{23}
+}
+func (m *DeleteScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeleteScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduleResponse.Merge(m, src)
+}
+func (m *DeleteScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduleResponse.DiscardUnknown(m)
 }
 
-func _Master_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).SubmitJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/SubmitJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).SubmitJob(ctx, req.(*SubmitJobRequest))
+var xxx_messageInfo_DeleteScheduleResponse proto.InternalMessageInfo
+
+func (m *DeleteScheduleResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Master_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PauseJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).PauseJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/PauseJob",
+type ListSchedulesRequest struct {
+}
+
+func (m *ListSchedulesRequest) Reset()         { *m = ListSchedulesRequest{} }
+func (m *ListSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulesRequest) ProtoMessage()    {}
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{24}
+}
+func (m *ListSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).PauseJob(ctx, req.(*PauseJobRequest))
+}
+func (m *ListSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesRequest.Merge(m, src)
+}
+func (m *ListSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchedulesRequest proto.InternalMessageInfo
+
+type ListSchedulesResponse struct {
+	Err       *Error          `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	Schedules []*ScheduleInfo `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (m *ListSchedulesResponse) Reset()         { *m = ListSchedulesResponse{} }
+func (m *ListSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulesResponse) ProtoMessage()    {}
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{25}
+}
+func (m *ListSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesResponse.Merge(m, src)
+}
+func (m *ListSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesResponse.DiscardUnknown(m)
 }
 
-func _Master_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ListSchedulesResponse proto.InternalMessageInfo
+
+func (m *ListSchedulesResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).CancelJob(ctx, in)
+	return nil
+}
+
+func (m *ListSchedulesResponse) GetSchedules() []*ScheduleInfo {
+	if m != nil {
+		return m.Schedules
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/CancelJob",
+	return nil
+}
+
+type ScheduleInfo struct {
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cron     string            `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	Tp       JobType           `protobuf:"varint,3,opt,name=tp,proto3,enum=pb.JobType" json:"tp,omitempty"`
+	Config   []byte            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Priority int32             `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Policy   ConcurrencyPolicy `protobuf:"varint,6,opt,name=policy,proto3,enum=pb.ConcurrencyPolicy" json:"policy,omitempty"`
+	// next_time is formatted in RFC3339.
+	NextTime string `protobuf:"bytes,7,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"`
+	// history are the latest jobs submitted by the schedule, oldest first.
+	History []*ScheduledJob `protobuf:"bytes,8,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (m *ScheduleInfo) Reset()         { *m = ScheduleInfo{} }
+func (m *ScheduleInfo) String() string { return proto.CompactTextString(m) }
+func (*ScheduleInfo) ProtoMessage()    {}
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{26}
+}
+func (m *ScheduleInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).CancelJob(ctx, req.(*CancelJobRequest))
+}
+func (m *ScheduleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleInfo.Merge(m, src)
+}
+func (m *ScheduleInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleInfo proto.InternalMessageInfo
+
+func (m *ScheduleInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Master_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeartbeatRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ScheduleInfo) GetCron() string {
+	if m != nil {
+		return m.Cron
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).Heartbeat(ctx, in)
+	return ""
+}
+
+func (m *ScheduleInfo) GetTp() JobType {
+	if m != nil {
+		return m.Tp
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/Heartbeat",
+	return JobType_CVSDemo
+}
+
+func (m *ScheduleInfo) GetConfig() []byte {
+	if m != nil {
+		return m.Config
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	return nil
+}
+
+func (m *ScheduleInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Master_ScheduleTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TaskSchedulerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ScheduleInfo) GetPolicy() ConcurrencyPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).ScheduleTask(ctx, in)
+	return ConcurrencyPolicy_Allow
+}
+
+func (m *ScheduleInfo) GetNextTime() string {
+	if m != nil {
+		return m.NextTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/ScheduleTask",
+	return ""
+}
+
+func (m *ScheduleInfo) GetHistory() []*ScheduledJob {
+	if m != nil {
+		return m.History
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).ScheduleTask(ctx, req.(*TaskSchedulerRequest))
+	return nil
+}
+
+type ScheduledJob struct {
+	JobIdStr string `protobuf:"bytes,1,opt,name=job_id_str,json=jobIdStr,proto3" json:"job_id_str,omitempty"`
+	// schedule_time is the scheduled time at which the job is submitted,
+	// formatted in RFC3339.
+	ScheduleTime string        `protobuf:"bytes,2,opt,name=schedule_time,json=scheduleTime,proto3" json:"schedule_time,omitempty"`
+	State        JobInfo_State `protobuf:"varint,3,opt,name=state,proto3,enum=pb.JobInfo_State" json:"state,omitempty"`
+}
+
+func (m *ScheduledJob) Reset()         { *m = ScheduledJob{} }
+func (m *ScheduledJob) String() string { return proto.CompactTextString(m) }
+func (*ScheduledJob) ProtoMessage()    {}
+func (*ScheduledJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{27}
+}
+func (m *ScheduledJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScheduledJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledJob.Merge(m, src)
+}
+func (m *ScheduledJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledJob.DiscardUnknown(m)
 }
 
-func _Master_RegisterMetaStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterMetaStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduledJob proto.InternalMessageInfo
+
+func (m *ScheduledJob) GetJobIdStr() string {
+	if m != nil {
+		return m.JobIdStr
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).RegisterMetaStore(ctx, in)
+	return ""
+}
+
+func (m *ScheduledJob) GetScheduleTime() string {
+	if m != nil {
+		return m.ScheduleTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/RegisterMetaStore",
+	return ""
+}
+
+func (m *ScheduledJob) GetState() JobInfo_State {
+	if m != nil {
+		return m.State
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).RegisterMetaStore(ctx, req.(*RegisterMetaStoreRequest))
+	return JobInfo_Pending
+}
+
+type RegisterExecutorRequest struct {
+	// dm need 'worker-name' to locate the worker.
+	// TODO: Do we really need a "worker name"? Can we use address to identify an executor?
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// capability is taken as the cpu of resource_capacity if
+	// resource_capacity is not set.
+	Capability       int64     `protobuf:"varint,3,opt,name=capability,proto3" json:"capability,omitempty"`
+	ResourceCapacity *Resource `protobuf:"bytes,4,opt,name=resource_capacity,json=resourceCapacity,proto3" json:"resource_capacity,omitempty"`
+	// labels are free-form attributes of the executor, such as zone or rack.
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// protocol_version is checked against the compatibility matrix of the
+	// server master, the registration is rejected if it's incompatible.
+	ProtocolVersion int32 `protobuf:"varint,6,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// worker_types are the types of the workers that the executor can run.
+	WorkerTypes []int64 `protobuf:"varint,7,rep,packed,name=worker_types,json=workerTypes,proto3" json:"worker_types,omitempty"`
+}
+
+func (m *RegisterExecutorRequest) Reset()         { *m = RegisterExecutorRequest{} }
+func (m *RegisterExecutorRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterExecutorRequest) ProtoMessage()    {}
+func (*RegisterExecutorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{28}
+}
+func (m *RegisterExecutorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterExecutorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterExecutorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RegisterExecutorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterExecutorRequest.Merge(m, src)
+}
+func (m *RegisterExecutorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterExecutorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterExecutorRequest.DiscardUnknown(m)
 }
 
-func _Master_QueryMetaStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMetaStoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_RegisterExecutorRequest proto.InternalMessageInfo
+
+func (m *RegisterExecutorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).QueryMetaStore(ctx, in)
+	return ""
+}
+
+func (m *RegisterExecutorRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/QueryMetaStore",
+	return ""
+}
+
+func (m *RegisterExecutorRequest) GetCapability() int64 {
+	if m != nil {
+		return m.Capability
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).QueryMetaStore(ctx, req.(*QueryMetaStoreRequest))
+	return 0
+}
+
+func (m *RegisterExecutorRequest) GetResourceCapacity() *Resource {
+	if m != nil {
+		return m.ResourceCapacity
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Master_ReportExecutorWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecWorkloadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RegisterExecutorRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).ReportExecutorWorkload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/ReportExecutorWorkload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).ReportExecutorWorkload(ctx, req.(*ExecWorkloadRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Master_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Master",
-	HandlerType: (*MasterServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterExecutor",
-			Handler:    _Master_RegisterExecutor_Handler,
-		},
-		{
-			MethodName: "SubmitJob",
-			Handler:    _Master_SubmitJob_Handler,
-		},
-		{
-			MethodName: "PauseJob",
-			Handler:    _Master_PauseJob_Handler,
-		},
-		{
-			MethodName: "CancelJob",
-			Handler:    _Master_CancelJob_Handler,
-		},
-		{
-			MethodName: "Heartbeat",
-			Handler:    _Master_Heartbeat_Handler,
-		},
-		{
-			MethodName: "ScheduleTask",
-			Handler:    _Master_ScheduleTask_Handler,
-		},
-		{
-			MethodName: "RegisterMetaStore",
-			Handler:    _Master_RegisterMetaStore_Handler,
-		},
-		{
-			MethodName: "QueryMetaStore",
-			Handler:    _Master_QueryMetaStore_Handler,
-		},
-		{
-			MethodName: "ReportExecutorWorkload",
-			Handler:    _Master_ReportExecutorWorkload_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "master.proto",
+func (m *RegisterExecutorRequest) GetProtocolVersion() int32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
 }
 
-func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RegisterExecutorRequest) GetWorkerTypes() []int64 {
+	if m != nil {
+		return m.WorkerTypes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RegisterExecutorResponse struct {
+	Err        *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	ExecutorId string `protobuf:"bytes,2,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
 }
 
-func (m *HeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Ttl != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Ttl))
-		i--
-		dAtA[i] = 0x28
+func (m *RegisterExecutorResponse) Reset()         { *m = RegisterExecutorResponse{} }
+func (m *RegisterExecutorResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterExecutorResponse) ProtoMessage()    {}
+func (*RegisterExecutorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{29}
+}
+func (m *RegisterExecutorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterExecutorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterExecutorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *RegisterExecutorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterExecutorResponse.Merge(m, src)
+}
+func (m *RegisterExecutorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterExecutorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterExecutorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterExecutorResponse proto.InternalMessageInfo
+
+func (m *RegisterExecutorResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	if m.Status != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *RegisterExecutorResponse) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	if m.ResourceUsage != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.ResourceUsage))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+type ScheduleTask struct {
+	Task *TaskRequest `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// cost is taken as the cpu of resource if resource is not set.
+	Cost int64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// preferred_location is an executor ID, an address, a host or a label
+	// in the form of "key=value". The task falls back to any executor if the
+	// preferred ones are full, unless location_required is set.
+	PreferredLocation string `protobuf:"bytes,3,opt,name=preferred_location,json=preferredLocation,proto3" json:"preferred_location,omitempty"`
+	// resource is the resource required by the task, the task is scheduled
+	// to an executor only if every dimension fits.
+	Resource         *Resource `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	LocationRequired bool      `protobuf:"varint,5,opt,name=location_required,json=locationRequired,proto3" json:"location_required,omitempty"`
+	// strategy is the name of the scheduling strategy, such as "spread",
+	// "bin-pack" or "random". The default strategy of the cluster is used
+	// if it's empty.
+	Strategy string `protobuf:"bytes,6,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// worker_id is the worker that the resource is reserved for, the resource
+	// is released after the worker exits.
+	WorkerId string `protobuf:"bytes,7,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// master_id is the master of the worker, the affinity applies to the
+	// workers of the same master.
+	MasterId string `protobuf:"bytes,8,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	// label_selector requires the executor to have all of the labels.
+	LabelSelector map[string]string `protobuf:"bytes,9,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// affinity is a preference unless affinity_required is set.
+	Affinity         WorkerAffinity `protobuf:"varint,10,opt,name=affinity,proto3,enum=pb.WorkerAffinity" json:"affinity,omitempty"`
+	AffinityRequired bool           `protobuf:"varint,11,opt,name=affinity_required,json=affinityRequired,proto3" json:"affinity_required,omitempty"`
+	// priority of the worker. If the resource is not enough, the running
+	// workers of lower priority are preempted unless they're non-preemptible.
+	Priority       int32 `protobuf:"varint,12,opt,name=priority,proto3" json:"priority,omitempty"`
+	NonPreemptible bool  `protobuf:"varint,13,opt,name=non_preemptible,json=nonPreemptible,proto3" json:"non_preemptible,omitempty"`
+	// worker_type is the type of the worker, the task is scheduled only to
+	// the executors that support it. Any executor fits if it's not set.
+	WorkerType int64 `protobuf:"varint,14,opt,name=worker_type,json=workerType,proto3" json:"worker_type,omitempty"`
+}
+
+func (m *ScheduleTask) Reset()         { *m = ScheduleTask{} }
+func (m *ScheduleTask) String() string { return proto.CompactTextString(m) }
+func (*ScheduleTask) ProtoMessage()    {}
+func (*ScheduleTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{30}
+}
+func (m *ScheduleTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ExecutorId) > 0 {
-		i -= len(m.ExecutorId)
-		copy(dAtA[i:], m.ExecutorId)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ScheduleTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleTask.Merge(m, src)
+}
+func (m *ScheduleTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleTask proto.InternalMessageInfo
+
+func (m *ScheduleTask) GetTask() *TaskRequest {
+	if m != nil {
+		return m.Task
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduleTask) GetCost() int64 {
+	if m != nil {
+		return m.Cost
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduleTask) GetPreferredLocation() string {
+	if m != nil {
+		return m.PreferredLocation
+	}
+	return ""
 }
 
-func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addrs[iNdEx])
-			copy(dAtA[i:], m.Addrs[iNdEx])
-			i = encodeVarintMaster(dAtA, i, uint64(len(m.Addrs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *ScheduleTask) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
 	}
-	if len(m.Leader) > 0 {
-		i -= len(m.Leader)
-		copy(dAtA[i:], m.Leader)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.Leader)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ScheduleTask) GetLocationRequired() bool {
+	if m != nil {
+		return m.LocationRequired
 	}
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *ScheduleTask) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SubmitJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduleTask) GetWorkerId() string {
+	if m != nil {
+		return m.WorkerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SubmitJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduleTask) GetMasterId() string {
+	if m != nil {
+		return m.MasterId
+	}
+	return ""
 }
 
-func (m *SubmitJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.Config)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Tp != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Tp))
-		i--
-		dAtA[i] = 0x8
+func (m *ScheduleTask) GetLabelSelector() map[string]string {
+	if m != nil {
+		return m.LabelSelector
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CancelJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduleTask) GetAffinity() WorkerAffinity {
+	if m != nil {
+		return m.Affinity
 	}
-	return dAtA[:n], nil
+	return WorkerAffinity_NoAffinity
 }
 
-func (m *CancelJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduleTask) GetAffinityRequired() bool {
+	if m != nil {
+		return m.AffinityRequired
+	}
+	return false
 }
 
-func (m *CancelJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobIdStr) > 0 {
-		i -= len(m.JobIdStr)
-		copy(dAtA[i:], m.JobIdStr)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.JobId != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x8
+func (m *ScheduleTask) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PauseJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduleTask) GetNonPreemptible() bool {
+	if m != nil {
+		return m.NonPreemptible
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *PauseJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduleTask) GetWorkerType() int64 {
+	if m != nil {
+		return m.WorkerType
+	}
+	return 0
 }
 
-func (m *PauseJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobIdStr) > 0 {
-		i -= len(m.JobIdStr)
-		copy(dAtA[i:], m.JobIdStr)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.JobId != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// TaskSchedulerRequest is sent from job master to server master, server master
+// applies resource from resource manager, allocates executor to tasks.
+// The request contains an array of ScheduleTask.
+type TaskSchedulerRequest struct {
+	Tasks []*ScheduleTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// If gang is set, either all of the tasks are scheduled or none of them,
+	// and the request waits for at most wait_timeout_ms if the resource is
+	// not enough. Otherwise the tasks are scheduled independently, and the
+	// response carries the scheduled ones along with the error.
+	Gang          bool  `protobuf:"varint,2,opt,name=gang,proto3" json:"gang,omitempty"`
+	WaitTimeoutMs int64 `protobuf:"varint,3,opt,name=wait_timeout_ms,json=waitTimeoutMs,proto3" json:"wait_timeout_ms,omitempty"`
 }
 
-func (m *SubmitJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskSchedulerRequest) Reset()         { *m = TaskSchedulerRequest{} }
+func (m *TaskSchedulerRequest) String() string { return proto.CompactTextString(m) }
+func (*TaskSchedulerRequest) ProtoMessage()    {}
+func (*TaskSchedulerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{31}
+}
+func (m *TaskSchedulerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskSchedulerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskSchedulerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SubmitJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskSchedulerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskSchedulerRequest.Merge(m, src)
+}
+func (m *TaskSchedulerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskSchedulerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskSchedulerRequest.DiscardUnknown(m)
 }
 
-func (m *SubmitJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobIdStr) > 0 {
-		i -= len(m.JobIdStr)
-		copy(dAtA[i:], m.JobIdStr)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.JobId != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.JobId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_TaskSchedulerRequest proto.InternalMessageInfo
+
+func (m *TaskSchedulerRequest) GetTasks() []*ScheduleTask {
+	if m != nil {
+		return m.Tasks
 	}
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *TaskSchedulerRequest) GetGang() bool {
+	if m != nil {
+		return m.Gang
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *PauseJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskSchedulerRequest) GetWaitTimeoutMs() int64 {
+	if m != nil {
+		return m.WaitTimeoutMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PauseJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScheduleResult represents the where the task(sub job) will be running.
+// Currently it contains an executor id.
+type ScheduleResult struct {
+	ExecutorId string `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	Addr       string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (m *PauseJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
+func (m *ScheduleResult) Reset()         { *m = ScheduleResult{} }
+func (m *ScheduleResult) String() string { return proto.CompactTextString(m) }
+func (*ScheduleResult) ProtoMessage()    {}
+func (*ScheduleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{32}
+}
+func (m *ScheduleResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ScheduleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleResult.Merge(m, src)
+}
+func (m *ScheduleResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleResult.DiscardUnknown(m)
 }
 
-func (m *CancelJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduleResult proto.InternalMessageInfo
+
+func (m *ScheduleResult) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CancelJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduleResult) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func (m *CancelJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type TaskSchedulerResponse struct {
+	Schedule map[int64]*ScheduleResult `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Err      *Error                    `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
 }
 
-func (m *RegisterExecutorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskSchedulerResponse) Reset()         { *m = TaskSchedulerResponse{} }
+func (m *TaskSchedulerResponse) String() string { return proto.CompactTextString(m) }
+func (*TaskSchedulerResponse) ProtoMessage()    {}
+func (*TaskSchedulerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{33}
+}
+func (m *TaskSchedulerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskSchedulerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskSchedulerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *RegisterExecutorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskSchedulerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskSchedulerResponse.Merge(m, src)
+}
+func (m *TaskSchedulerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskSchedulerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskSchedulerResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterExecutorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Capability != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Capability))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_TaskSchedulerResponse proto.InternalMessageInfo
+
+func (m *TaskSchedulerResponse) GetSchedule() map[int64]*ScheduleResult {
+	if m != nil {
+		return m.Schedule
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RegisterExecutorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskSchedulerResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RegisterExecutorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReleaseResourceRequest struct {
+	WorkerIds []string `protobuf:"bytes,1,rep,name=worker_ids,json=workerIds,proto3" json:"worker_ids,omitempty"`
 }
 
-func (m *RegisterExecutorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExecutorId) > 0 {
-		i -= len(m.ExecutorId)
-		copy(dAtA[i:], m.ExecutorId)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
+func (m *ReleaseResourceRequest) Reset()         { *m = ReleaseResourceRequest{} }
+func (m *ReleaseResourceRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseResourceRequest) ProtoMessage()    {}
+func (*ReleaseResourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{34}
+}
+func (m *ReleaseResourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseResourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseResourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReleaseResourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseResourceRequest.Merge(m, src)
+}
+func (m *ReleaseResourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseResourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseResourceRequest.DiscardUnknown(m)
 }
 
-func (m *ScheduleTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReleaseResourceRequest proto.InternalMessageInfo
+
+func (m *ReleaseResourceRequest) GetWorkerIds() []string {
+	if m != nil {
+		return m.WorkerIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScheduleTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReleaseResourceResponse struct {
+	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
 }
 
-func (m *ScheduleTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PreferredLocation) > 0 {
-		i -= len(m.PreferredLocation)
-		copy(dAtA[i:], m.PreferredLocation)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.PreferredLocation)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Cost != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Cost))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
+func (m *ReleaseResourceResponse) Reset()         { *m = ReleaseResourceResponse{} }
+func (m *ReleaseResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseResourceResponse) ProtoMessage()    {}
+func (*ReleaseResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{35}
+}
+func (m *ReleaseResourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseResourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReleaseResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseResourceResponse.Merge(m, src)
+}
+func (m *ReleaseResourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseResourceResponse.DiscardUnknown(m)
 }
 
-func (m *TaskSchedulerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReleaseResourceResponse proto.InternalMessageInfo
+
+func (m *ReleaseResourceResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TaskSchedulerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CordonExecutorRequest struct {
+	ExecutorId string `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	Uncordon   bool   `protobuf:"varint,2,opt,name=uncordon,proto3" json:"uncordon,omitempty"`
 }
 
-func (m *TaskSchedulerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tasks) > 0 {
-		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CordonExecutorRequest) Reset()         { *m = CordonExecutorRequest{} }
+func (m *CordonExecutorRequest) String() string { return proto.CompactTextString(m) }
+func (*CordonExecutorRequest) ProtoMessage()    {}
+func (*CordonExecutorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{36}
+}
+func (m *CordonExecutorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CordonExecutorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CordonExecutorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CordonExecutorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonExecutorRequest.Merge(m, src)
+}
+func (m *CordonExecutorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CordonExecutorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonExecutorRequest.DiscardUnknown(m)
 }
 
-func (m *ScheduleResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CordonExecutorRequest proto.InternalMessageInfo
+
+func (m *CordonExecutorRequest) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ScheduleResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CordonExecutorRequest) GetUncordon() bool {
+	if m != nil {
+		return m.Uncordon
+	}
+	return false
 }
 
-func (m *ScheduleResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ExecutorId) > 0 {
-		i -= len(m.ExecutorId)
-		copy(dAtA[i:], m.ExecutorId)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
-		i--
-		dAtA[i] = 0xa
+type CordonExecutorResponse struct {
+	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (m *CordonExecutorResponse) Reset()         { *m = CordonExecutorResponse{} }
+func (m *CordonExecutorResponse) String() string { return proto.CompactTextString(m) }
+func (*CordonExecutorResponse) ProtoMessage()    {}
+func (*CordonExecutorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{37}
+}
+func (m *CordonExecutorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CordonExecutorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CordonExecutorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CordonExecutorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonExecutorResponse.Merge(m, src)
+}
+func (m *CordonExecutorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CordonExecutorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonExecutorResponse.DiscardUnknown(m)
 }
 
-func (m *TaskSchedulerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CordonExecutorResponse proto.InternalMessageInfo
+
+func (m *CordonExecutorResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TaskSchedulerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DrainExecutorRequest struct {
+	ExecutorId string `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
 }
 
-func (m *TaskSchedulerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Schedule) > 0 {
-		for k := range m.Schedule {
-			v := m.Schedule[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintMaster(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintMaster(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintMaster(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *DrainExecutorRequest) Reset()         { *m = DrainExecutorRequest{} }
+func (m *DrainExecutorRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainExecutorRequest) ProtoMessage()    {}
+func (*DrainExecutorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{38}
+}
+func (m *DrainExecutorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainExecutorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainExecutorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DrainExecutorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainExecutorRequest.Merge(m, src)
+}
+func (m *DrainExecutorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainExecutorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainExecutorRequest.DiscardUnknown(m)
 }
 
-func (m *ExecWorkload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DrainExecutorRequest proto.InternalMessageInfo
+
+func (m *DrainExecutorRequest) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ExecWorkload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DrainExecutorResponse struct {
+	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	// remaining_workers are the workers still in the executor, the executor
+	// is drained if it's empty.
+	RemainingWorkers []string `protobuf:"bytes,2,rep,name=remaining_workers,json=remainingWorkers,proto3" json:"remaining_workers,omitempty"`
 }
 
-func (m *ExecWorkload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Usage != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Usage))
-		i--
-		dAtA[i] = 0x10
+func (m *DrainExecutorResponse) Reset()         { *m = DrainExecutorResponse{} }
+func (m *DrainExecutorResponse) String() string { return proto.CompactTextString(m) }
+func (*DrainExecutorResponse) ProtoMessage()    {}
+func (*DrainExecutorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{39}
+}
+func (m *DrainExecutorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainExecutorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainExecutorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Tp != 0 {
-		i = encodeVarintMaster(dAtA, i, uint64(m.Tp))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DrainExecutorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainExecutorResponse.Merge(m, src)
+}
+func (m *DrainExecutorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainExecutorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainExecutorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainExecutorResponse proto.InternalMessageInfo
+
+func (m *DrainExecutorResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ExecWorkloadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DrainExecutorResponse) GetRemainingWorkers() []string {
+	if m != nil {
+		return m.RemainingWorkers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExecWorkloadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryExecutorsRequest struct {
+	// executor_ids are the executors to query, all of them are returned if
+	// it's empty.
+	ExecutorIds []string `protobuf:"bytes,1,rep,name=executor_ids,json=executorIds,proto3" json:"executor_ids,omitempty"`
 }
 
-func (m *ExecWorkloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Workloads) > 0 {
-		for iNdEx := len(m.Workloads) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Workloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryExecutorsRequest) Reset()         { *m = QueryExecutorsRequest{} }
+func (m *QueryExecutorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorsRequest) ProtoMessage()    {}
+func (*QueryExecutorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{40}
+}
+func (m *QueryExecutorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ExecutorId) > 0 {
-		i -= len(m.ExecutorId)
-		copy(dAtA[i:], m.ExecutorId)
-		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExecutorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorsRequest.Merge(m, src)
+}
+func (m *QueryExecutorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorsRequest.DiscardUnknown(m)
 }
 
-func (m *ExecWorkloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExecutorsRequest proto.InternalMessageInfo
+
+func (m *QueryExecutorsRequest) GetExecutorIds() []string {
+	if m != nil {
+		return m.ExecutorIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExecWorkloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExecutorResource struct {
+	ExecutorId string    `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	Address    string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Capacity   *Resource `protobuf:"bytes,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Reserved   *Resource `protobuf:"bytes,4,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// used is the larger one of the usage in heartbeats and the total usage
+	// of the workloads.
+	Used      *Resource       `protobuf:"bytes,5,opt,name=used,proto3" json:"used,omitempty"`
+	Workloads []*ExecWorkload `protobuf:"bytes,6,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	Cordoned  bool            `protobuf:"varint,7,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+	WorkerIds []string        `protobuf:"bytes,8,rep,name=worker_ids,json=workerIds,proto3" json:"worker_ids,omitempty"`
 }
 
-func (m *ExecWorkloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMaster(dAtA, i, uint64(size))
+func (m *ExecutorResource) Reset()         { *m = ExecutorResource{} }
+func (m *ExecutorResource) String() string { return proto.CompactTextString(m) }
+func (*ExecutorResource) ProtoMessage()    {}
+func (*ExecutorResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{41}
+}
+func (m *ExecutorResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExecutorResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorResource.Merge(m, src)
+}
+func (m *ExecutorResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorResource.DiscardUnknown(m)
 }
 
-func encodeVarintMaster(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMaster(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ExecutorResource proto.InternalMessageInfo
+
+func (m *ExecutorResource) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *HeartbeatRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ExecutorId)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	if m.ResourceUsage != 0 {
-		n += 1 + sovMaster(uint64(m.ResourceUsage))
-	}
-	if m.Status != 0 {
-		n += 1 + sovMaster(uint64(m.Status))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovMaster(uint64(m.Timestamp))
-	}
-	if m.Ttl != 0 {
-		n += 1 + sovMaster(uint64(m.Ttl))
+
+func (m *ExecutorResource) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return n
+	return ""
 }
 
-func (m *HeartbeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovMaster(uint64(l))
-		}
+func (m *ExecutorResource) GetCapacity() *Resource {
+	if m != nil {
+		return m.Capacity
 	}
-	return n
+	return nil
 }
 
-func (m *SubmitJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tp != 0 {
-		n += 1 + sovMaster(uint64(m.Tp))
-	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+func (m *ExecutorResource) GetReserved() *Resource {
+	if m != nil {
+		return m.Reserved
 	}
-	return n
+	return nil
 }
 
-func (m *CancelJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobId != 0 {
-		n += 1 + sovMaster(uint64(m.JobId))
-	}
-	l = len(m.JobIdStr)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+func (m *ExecutorResource) GetUsed() *Resource {
+	if m != nil {
+		return m.Used
 	}
-	return n
+	return nil
 }
 
-func (m *PauseJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobId != 0 {
-		n += 1 + sovMaster(uint64(m.JobId))
-	}
-	l = len(m.JobIdStr)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+func (m *ExecutorResource) GetWorkloads() []*ExecWorkload {
+	if m != nil {
+		return m.Workloads
 	}
-	return n
+	return nil
 }
 
-func (m *SubmitJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	if m.JobId != 0 {
-		n += 1 + sovMaster(uint64(m.JobId))
-	}
-	l = len(m.JobIdStr)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+func (m *ExecutorResource) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
 	}
-	return n
+	return false
 }
 
-func (m *PauseJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovMaster(uint64(l))
+func (m *ExecutorResource) GetWorkerIds() []string {
+	if m != nil {
+		return m.WorkerIds
 	}
-	return n
+	return nil
 }
 
-func (m *CancelJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	return n
+type QueryExecutorsResponse struct {
+	Err       *Error              `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	Executors []*ExecutorResource `protobuf:"bytes,2,rep,name=executors,proto3" json:"executors,omitempty"`
 }
 
-func (m *RegisterExecutorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	if m.Capability != 0 {
-		n += 1 + sovMaster(uint64(m.Capability))
+func (m *QueryExecutorsResponse) Reset()         { *m = QueryExecutorsResponse{} }
+func (m *QueryExecutorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutorsResponse) ProtoMessage()    {}
+func (*QueryExecutorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{42}
+}
+func (m *QueryExecutorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryExecutorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutorsResponse.Merge(m, src)
+}
+func (m *QueryExecutorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutorsResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterExecutorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	l = len(m.ExecutorId)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+var xxx_messageInfo_QueryExecutorsResponse proto.InternalMessageInfo
+
+func (m *QueryExecutorsResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return n
+	return nil
 }
 
-func (m *ScheduleTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	if m.Cost != 0 {
-		n += 1 + sovMaster(uint64(m.Cost))
-	}
-	l = len(m.PreferredLocation)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+func (m *QueryExecutorsResponse) GetExecutors() []*ExecutorResource {
+	if m != nil {
+		return m.Executors
 	}
-	return n
+	return nil
 }
 
-func (m *TaskSchedulerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovMaster(uint64(l))
+type ExecWorkload struct {
+	Tp    JobType `protobuf:"varint,1,opt,name=tp,proto3,enum=pb.JobType" json:"tp,omitempty"`
+	Usage int32   `protobuf:"varint,2,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *ExecWorkload) Reset()         { *m = ExecWorkload{} }
+func (m *ExecWorkload) String() string { return proto.CompactTextString(m) }
+func (*ExecWorkload) ProtoMessage()    {}
+func (*ExecWorkload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{43}
+}
+func (m *ExecWorkload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecWorkload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecWorkload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ExecWorkload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecWorkload.Merge(m, src)
+}
+func (m *ExecWorkload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecWorkload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecWorkload.DiscardUnknown(m)
 }
 
-func (m *ScheduleResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ExecutorId)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+var xxx_messageInfo_ExecWorkload proto.InternalMessageInfo
+
+func (m *ExecWorkload) GetTp() JobType {
+	if m != nil {
+		return m.Tp
 	}
-	return n
+	return JobType_CVSDemo
 }
 
-func (m *TaskSchedulerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecWorkload) GetUsage() int32 {
+	if m != nil {
+		return m.Usage
 	}
-	var l int
-	_ = l
-	if len(m.Schedule) > 0 {
-		for k, v := range m.Schedule {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovMaster(uint64(l))
-			}
-			mapEntrySize := 1 + sovMaster(uint64(k)) + l
-			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+	return 0
+}
+
+type ExecWorkloadRequest struct {
+	ExecutorId string          `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	Workloads  []*ExecWorkload `protobuf:"bytes,2,rep,name=workloads,proto3" json:"workloads,omitempty"`
+}
+
+func (m *ExecWorkloadRequest) Reset()         { *m = ExecWorkloadRequest{} }
+func (m *ExecWorkloadRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecWorkloadRequest) ProtoMessage()    {}
+func (*ExecWorkloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{44}
+}
+func (m *ExecWorkloadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecWorkloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecWorkloadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovMaster(uint64(l))
+}
+func (m *ExecWorkloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecWorkloadRequest.Merge(m, src)
+}
+func (m *ExecWorkloadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecWorkloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecWorkloadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecWorkloadRequest proto.InternalMessageInfo
+
+func (m *ExecWorkloadRequest) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	return n
+	return ""
 }
 
-func (m *ExecWorkload) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecWorkloadRequest) GetWorkloads() []*ExecWorkload {
+	if m != nil {
+		return m.Workloads
 	}
-	var l int
-	_ = l
-	if m.Tp != 0 {
-		n += 1 + sovMaster(uint64(m.Tp))
+	return nil
+}
+
+type ExecWorkloadResponse struct {
+	Err *Error `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (m *ExecWorkloadResponse) Reset()         { *m = ExecWorkloadResponse{} }
+func (m *ExecWorkloadResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecWorkloadResponse) ProtoMessage()    {}
+func (*ExecWorkloadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c348dec43a6705, []int{45}
+}
+func (m *ExecWorkloadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecWorkloadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecWorkloadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Usage != 0 {
-		n += 1 + sovMaster(uint64(m.Usage))
+}
+func (m *ExecWorkloadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecWorkloadResponse.Merge(m, src)
+}
+func (m *ExecWorkloadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecWorkloadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecWorkloadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecWorkloadResponse proto.InternalMessageInfo
+
+func (m *ExecWorkloadResponse) GetErr() *Error {
+	if m != nil {
+		return m.Err
 	}
-	return n
+	return nil
 }
 
-func (m *ExecWorkloadRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("pb.JobType", JobType_name, JobType_value)
+	proto.RegisterEnum("pb.ConcurrencyPolicy", ConcurrencyPolicy_name, ConcurrencyPolicy_value)
+	proto.RegisterEnum("pb.WorkerAffinity", WorkerAffinity_name, WorkerAffinity_value)
+	proto.RegisterEnum("pb.JobInfo_State", JobInfo_State_name, JobInfo_State_value)
+	proto.RegisterType((*HeartbeatRequest)(nil), "pb.HeartbeatRequest")
+	proto.RegisterType((*RunningWorker)(nil), "pb.RunningWorker")
+	proto.RegisterType((*HeartbeatResponse)(nil), "pb.HeartbeatResponse")
+	proto.RegisterType((*SubmitJobRequest)(nil), "pb.SubmitJobRequest")
+	proto.RegisterType((*CancelJobRequest)(nil), "pb.CancelJobRequest")
+	proto.RegisterType((*PauseJobRequest)(nil), "pb.PauseJobRequest")
+	proto.RegisterType((*ResumeJobRequest)(nil), "pb.ResumeJobRequest")
+	proto.RegisterType((*SubmitJobResponse)(nil), "pb.SubmitJobResponse")
+	proto.RegisterType((*PauseJobResponse)(nil), "pb.PauseJobResponse")
+	proto.RegisterType((*ResumeJobResponse)(nil), "pb.ResumeJobResponse")
+	proto.RegisterType((*CancelJobResponse)(nil), "pb.CancelJobResponse")
+	proto.RegisterType((*QueryJobRequest)(nil), "pb.QueryJobRequest")
+	proto.RegisterType((*WorkerStatusInfo)(nil), "pb.WorkerStatusInfo")
+	proto.RegisterType((*JobInfo)(nil), "pb.JobInfo")
+	proto.RegisterType((*JobFailure)(nil), "pb.JobFailure")
+	proto.RegisterType((*QueryJobResponse)(nil), "pb.QueryJobResponse")
+	proto.RegisterType((*ListJobsRequest)(nil), "pb.ListJobsRequest")
+	proto.RegisterType((*ListJobsResponse)(nil), "pb.ListJobsResponse")
+	proto.RegisterType((*UpdateJobConfigRequest)(nil), "pb.UpdateJobConfigRequest")
+	proto.RegisterType((*UpdateJobConfigResponse)(nil), "pb.UpdateJobConfigResponse")
+	proto.RegisterType((*CreateScheduleRequest)(nil), "pb.CreateScheduleRequest")
+	proto.RegisterType((*CreateScheduleResponse)(nil), "pb.CreateScheduleResponse")
+	proto.RegisterType((*DeleteScheduleRequest)(nil), "pb.DeleteScheduleRequest")
+	proto.RegisterType((*DeleteScheduleResponse)(nil), "pb.DeleteScheduleResponse")
+	proto.RegisterType((*ListSchedulesRequest)(nil), "pb.ListSchedulesRequest")
+	proto.RegisterType((*ListSchedulesResponse)(nil), "pb.ListSchedulesResponse")
+	proto.RegisterType((*ScheduleInfo)(nil), "pb.ScheduleInfo")
+	proto.RegisterType((*ScheduledJob)(nil), "pb.ScheduledJob")
+	proto.RegisterType((*RegisterExecutorRequest)(nil), "pb.RegisterExecutorRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pb.RegisterExecutorRequest.LabelsEntry")
+	proto.RegisterType((*RegisterExecutorResponse)(nil), "pb.RegisterExecutorResponse")
+	proto.RegisterType((*ScheduleTask)(nil), "pb.ScheduleTask")
+	proto.RegisterMapType((map[string]string)(nil), "pb.ScheduleTask.LabelSelectorEntry")
+	proto.RegisterType((*TaskSchedulerRequest)(nil), "pb.TaskSchedulerRequest")
+	proto.RegisterType((*ScheduleResult)(nil), "pb.ScheduleResult")
+	proto.RegisterType((*TaskSchedulerResponse)(nil), "pb.TaskSchedulerResponse")
+	proto.RegisterMapType((map[int64]*ScheduleResult)(nil), "pb.TaskSchedulerResponse.ScheduleEntry")
+	proto.RegisterType((*ReleaseResourceRequest)(nil), "pb.ReleaseResourceRequest")
+	proto.RegisterType((*ReleaseResourceResponse)(nil), "pb.ReleaseResourceResponse")
+	proto.RegisterType((*CordonExecutorRequest)(nil), "pb.CordonExecutorRequest")
+	proto.RegisterType((*CordonExecutorResponse)(nil), "pb.CordonExecutorResponse")
+	proto.RegisterType((*DrainExecutorRequest)(nil), "pb.DrainExecutorRequest")
+	proto.RegisterType((*DrainExecutorResponse)(nil), "pb.DrainExecutorResponse")
+	proto.RegisterType((*QueryExecutorsRequest)(nil), "pb.QueryExecutorsRequest")
+	proto.RegisterType((*ExecutorResource)(nil), "pb.ExecutorResource")
+	proto.RegisterType((*QueryExecutorsResponse)(nil), "pb.QueryExecutorsResponse")
+	proto.RegisterType((*ExecWorkload)(nil), "pb.ExecWorkload")
+	proto.RegisterType((*ExecWorkloadRequest)(nil), "pb.ExecWorkloadRequest")
+	proto.RegisterType((*ExecWorkloadResponse)(nil), "pb.ExecWorkloadResponse")
+}
+
+func init() { proto.RegisterFile("master.proto", fileDescriptor_f9c348dec43a6705) }
+
+var fileDescriptor_f9c348dec43a6705 = []byte{
+	// 2457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x19, 0x4d, 0x6f, 0xdc, 0xc6,
+	0x55, 0xe4, 0x7e, 0xbf, 0xfd, 0x10, 0x35, 0xd9, 0x95, 0x69, 0x2a, 0x56, 0x14, 0x1a, 0x89, 0x15,
+	0xbb, 0x51, 0x02, 0xa5, 0xad, 0x13, 0xa3, 0x40, 0x63, 0xcb, 0x36, 0x2a, 0xd7, 0xb2, 0x15, 0xca,
+	0x89, 0x7b, 0x69, 0x17, 0xdc, 0xe5, 0x48, 0xa6, 0xc5, 0x25, 0xd7, 0x1c, 0xd2, 0xb6, 0x0c, 0xf4,
+	0xd0, 0x7f, 0xd0, 0x5f, 0x52, 0xa0, 0xbf, 0xa0, 0xd7, 0xa2, 0xa7, 0x1c, 0x7b, 0x2c, 0xec, 0x7b,
+	0x81, 0x16, 0xbd, 0x16, 0x2d, 0xe6, 0x73, 0x49, 0x2e, 0x2d, 0x33, 0x40, 0x2f, 0xbd, 0x71, 0xde,
+	0x9b, 0x79, 0xf3, 0xbe, 0xe6, 0x7d, 0x11, 0x7a, 0x33, 0x97, 0x24, 0x38, 0xde, 0x99, 0xc7, 0x51,
+	0x12, 0x21, 0x7d, 0x3e, 0xb1, 0xba, 0x38, 0x8e, 0x23, 0x01, 0xb0, 0x06, 0xf8, 0x25, 0x9e, 0xa6,
+	0x89, 0x5a, 0xaf, 0xce, 0x70, 0xe2, 0x92, 0x24, 0x8a, 0x31, 0x07, 0xd8, 0x7f, 0xd7, 0xc0, 0xf8,
+	0x05, 0x76, 0xe3, 0x64, 0x82, 0xdd, 0xc4, 0xc1, 0xcf, 0x52, 0x4c, 0x12, 0xf4, 0x01, 0x74, 0xe5,
+	0xb9, 0xb1, 0xef, 0x99, 0xda, 0x96, 0xb6, 0xdd, 0x71, 0x40, 0x82, 0xf6, 0x3d, 0xf4, 0x11, 0x0c,
+	0x62, 0x4c, 0xa2, 0x34, 0x9e, 0xe2, 0x71, 0x4a, 0xdc, 0x13, 0x6c, 0xea, 0x5b, 0xda, 0x76, 0xc3,
+	0xe9, 0x4b, 0xe8, 0xb7, 0x14, 0x88, 0xd6, 0xa1, 0x49, 0x12, 0x37, 0x49, 0x89, 0x59, 0x63, 0x68,
+	0xb1, 0x42, 0xef, 0x43, 0x27, 0xf1, 0x67, 0x98, 0x24, 0xee, 0x6c, 0x6e, 0xd6, 0xb7, 0xb4, 0xed,
+	0xba, 0xb3, 0x00, 0x20, 0x03, 0x6a, 0x49, 0x12, 0x98, 0x0d, 0x06, 0xa7, 0x9f, 0xe8, 0x12, 0xc0,
+	0x8b, 0x28, 0x3e, 0xc5, 0x94, 0x1b, 0x62, 0x36, 0xb7, 0x6a, 0xdb, 0x1d, 0xa7, 0xc3, 0x21, 0xfb,
+	0x1e, 0x41, 0xd7, 0xa0, 0xc5, 0x17, 0xc4, 0x6c, 0x6d, 0xd5, 0xb6, 0xbb, 0xbb, 0x6b, 0x3b, 0xf3,
+	0xc9, 0x8e, 0x93, 0x86, 0xa1, 0x1f, 0x9e, 0x3c, 0x66, 0x18, 0x47, 0xee, 0xb0, 0xff, 0xa1, 0x41,
+	0x3f, 0x87, 0x42, 0x1b, 0xd0, 0x51, 0xd4, 0x85, 0xac, 0x6d, 0x49, 0x9c, 0x22, 0xb9, 0x86, 0x29,
+	0x52, 0xe7, 0x48, 0x0e, 0xd8, 0xf7, 0xd0, 0x36, 0xb4, 0xa5, 0xc0, 0x4c, 0xc2, 0xee, 0x6e, 0x8f,
+	0xdd, 0x2c, 0x60, 0x8e, 0xc2, 0x22, 0x0b, 0xda, 0xf3, 0xd8, 0x8f, 0x62, 0x3f, 0x39, 0x63, 0x02,
+	0x37, 0x1c, 0xb5, 0x46, 0x57, 0x60, 0x35, 0x8c, 0xc2, 0xf1, 0x3c, 0xc6, 0x78, 0x36, 0x4f, 0xfc,
+	0x49, 0x80, 0x99, 0xec, 0x6d, 0x67, 0x10, 0x46, 0xe1, 0xe1, 0x02, 0x4a, 0xcd, 0x22, 0x18, 0x4d,
+	0xce, 0xe6, 0xd8, 0x6c, 0x6e, 0x69, 0xdb, 0x35, 0x47, 0x68, 0xe6, 0xd1, 0xd9, 0x9c, 0xe9, 0x7b,
+	0xee, 0x87, 0x21, 0xf6, 0xcc, 0x16, 0x23, 0x20, 0x56, 0xf6, 0x6f, 0x60, 0x2d, 0x63, 0x63, 0x32,
+	0x8f, 0x42, 0x82, 0xd1, 0x06, 0xd4, 0x70, 0x1c, 0x33, 0x81, 0xbb, 0xbb, 0x1d, 0xca, 0xf7, 0x1d,
+	0xea, 0x38, 0x0e, 0x85, 0x52, 0x4a, 0x01, 0x76, 0x3d, 0x1c, 0x0b, 0x99, 0xc5, 0x0a, 0x0d, 0xa1,
+	0xe1, 0x7a, 0x5e, 0x4c, 0x0d, 0x4a, 0x8d, 0xc0, 0x17, 0xf6, 0x1f, 0x35, 0x30, 0x8e, 0xd2, 0xc9,
+	0xcc, 0x4f, 0xee, 0x45, 0x13, 0xe9, 0x44, 0x1b, 0xa0, 0x27, 0x73, 0x46, 0x7e, 0xb0, 0xdb, 0xa5,
+	0xe4, 0xef, 0x45, 0x13, 0xca, 0xa5, 0xa3, 0x27, 0x73, 0x4a, 0x7f, 0x1a, 0x85, 0xc7, 0xfe, 0x09,
+	0xa3, 0xdf, 0x73, 0xc4, 0x0a, 0x21, 0xa8, 0xa7, 0x04, 0xc7, 0x4c, 0x9b, 0x1d, 0x87, 0x7d, 0xa3,
+	0x8b, 0xd0, 0x7e, 0x1a, 0x4d, 0xc6, 0xa1, 0x3b, 0xc3, 0x4c, 0x77, 0x1d, 0xa7, 0xf5, 0x34, 0x9a,
+	0x3c, 0x70, 0x67, 0x79, 0xb5, 0x36, 0x0a, 0x6a, 0xbd, 0x04, 0xe0, 0xe1, 0x39, 0x0e, 0x3d, 0x32,
+	0x8e, 0x42, 0xe9, 0x34, 0x02, 0xf2, 0x30, 0xb4, 0x7f, 0x09, 0xc6, 0x9e, 0x1b, 0x4e, 0x71, 0x90,
+	0x61, 0xf9, 0x22, 0x34, 0xe9, 0x4d, 0xc2, 0x0d, 0x1a, 0xb7, 0x74, 0x53, 0x73, 0x1a, 0x4f, 0xa3,
+	0xc9, 0xbe, 0x87, 0xde, 0x07, 0xe0, 0xa8, 0x31, 0x49, 0xa4, 0x52, 0xda, 0x0c, 0x75, 0x94, 0xc4,
+	0xf6, 0x3d, 0x58, 0x3d, 0x74, 0x53, 0x82, 0xff, 0x17, 0xb4, 0x3e, 0x07, 0xc3, 0xc1, 0x24, 0x9d,
+	0x65, 0x89, 0xe5, 0x4f, 0x68, 0x85, 0x13, 0x3e, 0xac, 0x65, 0xb4, 0x5f, 0xc5, 0xbc, 0x0b, 0xe6,
+	0xf4, 0xf3, 0x99, 0xab, 0x15, 0xae, 0xfa, 0x0c, 0x8c, 0x85, 0xa0, 0x15, 0x6e, 0xb2, 0x3f, 0x87,
+	0xb5, 0x8c, 0x34, 0x15, 0x4f, 0x64, 0x0c, 0x53, 0xe5, 0xc4, 0x67, 0xb0, 0xfa, 0x4d, 0x8a, 0xe3,
+	0xb3, 0xca, 0x0a, 0x7b, 0x05, 0x06, 0x7f, 0xfb, 0x47, 0x2c, 0x1e, 0xed, 0x87, 0xc7, 0xd1, 0xf9,
+	0x51, 0x00, 0x41, 0x7d, 0x1a, 0x79, 0x32, 0xca, 0xb1, 0x6f, 0x74, 0x19, 0xfa, 0x2c, 0xd2, 0x8e,
+	0x67, 0x98, 0xb0, 0x10, 0xc8, 0x75, 0xd5, 0x63, 0xc0, 0x03, 0x0e, 0xa3, 0xb1, 0x0c, 0xbf, 0x4c,
+	0x98, 0xdb, 0xf6, 0x1c, 0xfa, 0x69, 0xff, 0xbb, 0x0e, 0xad, 0x7b, 0xd1, 0x84, 0xdd, 0x79, 0x2e,
+	0x97, 0xe8, 0x0a, 0x34, 0x68, 0xbc, 0xe4, 0xb7, 0x0e, 0x78, 0x50, 0x13, 0x27, 0x77, 0x28, 0xe3,
+	0xd8, 0xe1, 0x78, 0x34, 0x60, 0x2f, 0xad, 0xc6, 0xc2, 0x41, 0xfe, 0x71, 0xd5, 0x73, 0x8f, 0xeb,
+	0x47, 0x2a, 0x1c, 0x37, 0x98, 0x1e, 0x87, 0x94, 0x62, 0x51, 0x11, 0x2a, 0x48, 0xef, 0x2c, 0xa2,
+	0x6a, 0x73, 0xab, 0xf6, 0xd6, 0xed, 0x72, 0x13, 0xba, 0x0a, 0xed, 0x63, 0xd7, 0x0f, 0xd2, 0x18,
+	0xcb, 0x30, 0x3c, 0x10, 0x1c, 0xdf, 0xe5, 0x60, 0x47, 0xe1, 0xe9, 0xdb, 0x24, 0x89, 0x1b, 0x27,
+	0x63, 0x1a, 0xf5, 0xcd, 0x36, 0x13, 0xbc, 0xc3, 0x20, 0x8f, 0xfc, 0x19, 0xa6, 0x2f, 0x1e, 0x87,
+	0x1e, 0x47, 0x76, 0xf8, 0x8b, 0xc7, 0xa1, 0xc7, 0x50, 0x43, 0x68, 0x30, 0x05, 0x9b, 0xc0, 0xe0,
+	0x7c, 0x91, 0x8b, 0x03, 0xdd, 0x42, 0x1c, 0xf8, 0x08, 0x06, 0xcf, 0x52, 0x9c, 0xe2, 0xf1, 0x3c,
+	0x22, 0x7e, 0xe2, 0x47, 0xa1, 0xd9, 0x63, 0x9a, 0xea, 0x33, 0xe8, 0xa1, 0x00, 0x16, 0xc2, 0x45,
+	0xbf, 0x10, 0x2e, 0x28, 0x15, 0xae, 0xc5, 0xf1, 0x73, 0x1c, 0x13, 0x4a, 0x65, 0xc0, 0xa9, 0x70,
+	0xe8, 0x77, 0x1c, 0x88, 0x3e, 0x84, 0x9e, 0xd8, 0xc6, 0xb9, 0x5c, 0x65, 0x5c, 0x76, 0x39, 0x8c,
+	0xb9, 0xac, 0xfd, 0x5b, 0x68, 0x30, 0xeb, 0xa1, 0x2e, 0xb4, 0x0e, 0x71, 0xe8, 0xf9, 0xe1, 0x89,
+	0xb1, 0x42, 0x17, 0x8f, 0x5d, 0x3f, 0xb9, 0x39, 0x3d, 0x35, 0x34, 0x04, 0xd0, 0x7c, 0x18, 0x06,
+	0x7e, 0x88, 0x0d, 0x1d, 0xf5, 0xa1, 0xc3, 0x9f, 0x03, 0xdd, 0x57, 0xa3, 0x28, 0xaa, 0x4e, 0xec,
+	0x19, 0x75, 0xd4, 0x83, 0xf6, 0x5d, 0x3f, 0xf4, 0xc9, 0x13, 0xec, 0x19, 0x0d, 0xba, 0xe2, 0x1b,
+	0xb1, 0x67, 0x34, 0xe9, 0xbe, 0x6f, 0xa8, 0x7c, 0x9e, 0xd1, 0xa2, 0xb4, 0x6f, 0x05, 0xd1, 0xf4,
+	0x14, 0x7b, 0x46, 0xdb, 0xfe, 0x12, 0x60, 0x61, 0x12, 0xea, 0xd8, 0x4c, 0xcb, 0xdc, 0xf7, 0xd8,
+	0x37, 0x75, 0x9f, 0x18, 0xbb, 0x24, 0x0a, 0x65, 0xec, 0xe7, 0x2b, 0xfb, 0x01, 0x18, 0x8b, 0x67,
+	0x56, 0x25, 0xca, 0x5c, 0x82, 0xda, 0xd3, 0x68, 0xc2, 0xa8, 0x74, 0x55, 0x0a, 0x60, 0x4e, 0x43,
+	0xe1, 0xf6, 0xcf, 0x60, 0xf5, 0xbe, 0x4f, 0x68, 0xd0, 0x22, 0xf2, 0xd9, 0x7e, 0xc2, 0x3d, 0x14,
+	0x13, 0x53, 0xdb, 0xaa, 0x95, 0xfb, 0xbc, 0xd8, 0x60, 0x1f, 0x82, 0xb1, 0x38, 0x5d, 0x85, 0x9b,
+	0x0f, 0xa0, 0xfe, 0x34, 0x9a, 0x10, 0x53, 0xdf, 0xaa, 0x15, 0xd9, 0x61, 0x08, 0xfb, 0x01, 0xac,
+	0x7f, 0x3b, 0xf7, 0xdc, 0x84, 0x86, 0xaa, 0x3d, 0x66, 0xb0, 0x4a, 0xd1, 0xe4, 0x6d, 0xb9, 0xcc,
+	0xfe, 0x35, 0x5c, 0x58, 0xa2, 0x57, 0x85, 0xd1, 0x65, 0x57, 0xd3, 0x4b, 0x5c, 0xcd, 0xfe, 0x93,
+	0x06, 0xa3, 0xbd, 0x18, 0xbb, 0x09, 0x3e, 0x9a, 0x3e, 0xc1, 0x5e, 0x1a, 0x60, 0xc9, 0x2e, 0x82,
+	0x3a, 0x4b, 0x96, 0xc2, 0xa8, 0xf4, 0x9b, 0xc2, 0xa6, 0xb1, 0x32, 0x29, 0xfb, 0x46, 0x1b, 0x2a,
+	0x6e, 0x9c, 0x9b, 0xa1, 0xf3, 0x41, 0xe4, 0xbc, 0x94, 0xfb, 0x29, 0x34, 0xe7, 0x51, 0xe0, 0x4f,
+	0xcf, 0x58, 0x6d, 0x32, 0xd8, 0x1d, 0x51, 0xa2, 0x7b, 0x51, 0x38, 0x4d, 0xe3, 0x18, 0x87, 0xd3,
+	0xb3, 0x43, 0x86, 0x74, 0xc4, 0x26, 0xdb, 0x81, 0xf5, 0xa2, 0x00, 0x55, 0xf4, 0xb3, 0x01, 0x9d,
+	0x10, 0xbf, 0x14, 0xb1, 0x43, 0x64, 0x4f, 0x0a, 0xa0, 0xf1, 0xc1, 0xbe, 0x06, 0xa3, 0xdb, 0x38,
+	0xc0, 0x95, 0x94, 0x62, 0xff, 0x04, 0xd6, 0x8b, 0x9b, 0xab, 0xe4, 0x9b, 0x75, 0x18, 0x52, 0xd7,
+	0x93, 0x87, 0xa4, 0xf7, 0xda, 0x1e, 0x8c, 0x0a, 0xf0, 0x2a, 0xe2, 0xec, 0x40, 0x87, 0xc8, 0x13,
+	0xc2, 0x39, 0x0d, 0xba, 0x45, 0x92, 0x61, 0x1e, 0xba, 0xd8, 0x62, 0xff, 0x47, 0x83, 0x5e, 0x16,
+	0xf7, 0xff, 0x62, 0xee, 0xbc, 0xdd, 0x5a, 0x79, 0xbb, 0xa1, 0xab, 0xd0, 0x7a, 0xe2, 0xd3, 0xc6,
+	0xe4, 0xcc, 0x6c, 0x2f, 0xeb, 0xc0, 0xa3, 0x31, 0x47, 0x6e, 0xb0, 0x5f, 0x41, 0x2f, 0x8b, 0x78,
+	0xc7, 0xf3, 0xbc, 0x0c, 0x7d, 0xa9, 0xbc, 0xac, 0xcb, 0xf4, 0x24, 0x90, 0x5d, 0xaf, 0x72, 0x6d,
+	0xed, 0xfc, 0x5c, 0x6b, 0xff, 0x53, 0x87, 0x0b, 0x0e, 0x3e, 0xf1, 0x49, 0x82, 0xe3, 0x3b, 0xa2,
+	0x21, 0x92, 0x2e, 0x66, 0x42, 0x8b, 0xd6, 0xc3, 0x98, 0x10, 0xc1, 0x84, 0x5c, 0x52, 0x4c, 0xf6,
+	0x2d, 0x77, 0x1c, 0xb9, 0x44, 0x9b, 0x00, 0x53, 0x77, 0xee, 0x4e, 0xfc, 0x80, 0x6a, 0x98, 0xe7,
+	0xf0, 0x0c, 0x04, 0x7d, 0x05, 0x6b, 0xaa, 0xd3, 0xa2, 0xe0, 0xa9, 0xec, 0x20, 0x8a, 0xbd, 0x86,
+	0x21, 0xb7, 0xed, 0x89, 0x5d, 0xe8, 0xe7, 0xd0, 0x0c, 0xdc, 0x09, 0x0e, 0x68, 0xba, 0xa7, 0x1a,
+	0xbd, 0xc2, 0xf7, 0x97, 0xf2, 0xbe, 0x73, 0x9f, 0xed, 0xbc, 0x13, 0x26, 0xf1, 0x99, 0x23, 0x8e,
+	0xa1, 0x4f, 0xc0, 0x60, 0x4d, 0xe2, 0x34, 0x0a, 0x54, 0x28, 0x6a, 0x32, 0x1f, 0x58, 0x95, 0xf0,
+	0x4c, 0xde, 0xcb, 0xb4, 0x26, 0xbc, 0x00, 0xa8, 0x39, 0xdd, 0x45, 0x6f, 0x42, 0xac, 0xaf, 0xa0,
+	0x9b, 0xb9, 0x84, 0x56, 0x46, 0xa7, 0xf8, 0x4c, 0x28, 0x8a, 0x7e, 0xd2, 0xd4, 0xfe, 0xdc, 0x0d,
+	0x52, 0x69, 0x20, 0xbe, 0xb8, 0xa1, 0x7f, 0xa9, 0xd9, 0xbf, 0x02, 0x73, 0x99, 0xef, 0x6a, 0x31,
+	0x3f, 0xd7, 0xc8, 0xea, 0xc5, 0x46, 0xd6, 0xfe, 0x57, 0x7d, 0xe1, 0x4b, 0x8f, 0x5c, 0x72, 0x8a,
+	0x2e, 0x43, 0x3d, 0x71, 0xc9, 0xa9, 0xa0, 0xb7, 0x4a, 0xe9, 0x51, 0xb8, 0x50, 0x93, 0xc3, 0x90,
+	0xbc, 0x1c, 0x24, 0x89, 0x88, 0xcb, 0xec, 0x1b, 0x7d, 0x0a, 0x68, 0x1e, 0xe3, 0x63, 0x1c, 0xc7,
+	0xd8, 0x1b, 0x07, 0xd1, 0xd4, 0x65, 0xa5, 0x06, 0xaf, 0x09, 0xd7, 0x14, 0xe6, 0xbe, 0x40, 0xe4,
+	0x5a, 0xc7, 0xfa, 0xb9, 0xad, 0xe3, 0x35, 0x58, 0x93, 0xe4, 0xc6, 0x31, 0x7e, 0x96, 0xfa, 0x31,
+	0xf6, 0x44, 0x83, 0x68, 0x48, 0x84, 0x23, 0xe0, 0xf4, 0xb9, 0x92, 0x24, 0x76, 0x13, 0x7c, 0xc2,
+	0x1f, 0x65, 0xc7, 0x51, 0xeb, 0x7c, 0x85, 0xdb, 0x3a, 0xaf, 0xcf, 0x6d, 0x17, 0xfa, 0xdc, 0x7b,
+	0x30, 0x60, 0x2e, 0x31, 0x26, 0x38, 0xc0, 0xd3, 0x24, 0x8a, 0xcd, 0x0e, 0xf3, 0xa8, 0xcb, 0xd9,
+	0x37, 0x4a, 0xd5, 0xc4, 0xdd, 0xe8, 0x48, 0xec, 0xe2, 0xde, 0xd4, 0x0f, 0xb2, 0x30, 0xb4, 0x03,
+	0x6d, 0xf7, 0xf8, 0xd8, 0x0f, 0xa9, 0x1f, 0x03, 0x7b, 0x6c, 0x68, 0x51, 0x57, 0xde, 0x14, 0x18,
+	0x47, 0xed, 0xa1, 0xe2, 0xcb, 0xef, 0x85, 0xf8, 0x5d, 0x2e, 0xbe, 0x44, 0x64, 0xc5, 0x57, 0xd1,
+	0xaa, 0xf7, 0xee, 0x36, 0xbb, 0x5f, 0xa5, 0xcd, 0x1e, 0x14, 0xdb, 0x6c, 0xeb, 0x6b, 0x40, 0xcb,
+	0x72, 0xfe, 0x20, 0x87, 0x7e, 0x05, 0x43, 0xaa, 0x2e, 0xa9, 0x3a, 0x15, 0x41, 0x3e, 0x86, 0x06,
+	0x75, 0x30, 0x5e, 0xfe, 0x14, 0x62, 0x20, 0x73, 0x43, 0x8e, 0xa6, 0x0e, 0x78, 0xe2, 0x86, 0xbc,
+	0xe0, 0x68, 0x3b, 0xec, 0x1b, 0x7d, 0x0c, 0xab, 0x2f, 0x5c, 0x9f, 0x87, 0xd7, 0x28, 0x4d, 0xc6,
+	0x33, 0x22, 0xc2, 0x49, 0x9f, 0x82, 0x1f, 0x71, 0xe8, 0x01, 0xb1, 0xef, 0xc0, 0x20, 0x93, 0xee,
+	0xd2, 0xa0, 0xc2, 0xb8, 0x07, 0x41, 0x9d, 0x46, 0x32, 0x99, 0x4d, 0xe8, 0xb7, 0xfd, 0x17, 0x0d,
+	0x46, 0x05, 0x19, 0xc4, 0x8b, 0xdc, 0x83, 0xb6, 0x8c, 0xad, 0xa6, 0xb6, 0x88, 0x3c, 0xa5, 0x9b,
+	0x95, 0x74, 0xdc, 0x57, 0xd4, 0x41, 0xf9, 0xac, 0xf5, 0xb2, 0x67, 0x6d, 0x3d, 0x84, 0x7e, 0xee,
+	0x5c, 0x56, 0xf7, 0x35, 0xae, 0xfb, 0xed, 0xac, 0xee, 0xbb, 0xdc, 0xc7, 0xf2, 0x62, 0x67, 0xed,
+	0x71, 0x1d, 0xd6, 0x1d, 0x1c, 0x60, 0x97, 0x60, 0xf5, 0x00, 0x85, 0x45, 0xf2, 0xa3, 0x27, 0xad,
+	0x30, 0x7a, 0xb2, 0x7f, 0x0a, 0x17, 0x96, 0x0e, 0x56, 0x29, 0x21, 0x1e, 0xc1, 0x68, 0x2f, 0x8a,
+	0xbd, 0x28, 0x2c, 0xe6, 0x90, 0x77, 0xda, 0xc2, 0x82, 0x76, 0x1a, 0x4e, 0xd9, 0x59, 0x61, 0x7e,
+	0xb5, 0xa6, 0xf5, 0x4c, 0x91, 0x6a, 0x15, 0x66, 0xae, 0xc3, 0xf0, 0x76, 0xec, 0xfa, 0x3f, 0x98,
+	0x17, 0xdb, 0x85, 0x51, 0xe1, 0x60, 0x95, 0xa0, 0x7c, 0x8d, 0xa6, 0xb4, 0x99, 0xeb, 0xd3, 0x11,
+	0xdc, 0x58, 0xb6, 0x98, 0x3a, 0xd3, 0xac, 0xa1, 0x10, 0x3c, 0x1e, 0x10, 0xfb, 0x06, 0x8c, 0x58,
+	0xd3, 0x21, 0xaf, 0x50, 0xad, 0xc2, 0x87, 0xd0, 0xcb, 0x30, 0x27, 0x4d, 0xd3, 0x5d, 0x70, 0x47,
+	0xec, 0x3f, 0xe8, 0x60, 0x64, 0x58, 0xe3, 0xe1, 0xf4, 0x9d, 0x0a, 0xce, 0x64, 0x71, 0x3d, 0x9f,
+	0xc5, 0xb7, 0xa1, 0xad, 0x52, 0x70, 0xe9, 0xb8, 0x4f, 0x62, 0x45, 0x74, 0xc7, 0xf1, 0x73, 0xec,
+	0xbd, 0x35, 0xba, 0x33, 0x2c, 0xda, 0x62, 0x03, 0x2f, 0xcf, 0x6c, 0x94, 0xec, 0x62, 0x18, 0x5a,
+	0x1f, 0x52, 0x25, 0x05, 0x91, 0xeb, 0xc9, 0x4e, 0x9c, 0xc5, 0x05, 0x2a, 0xd9, 0x63, 0x81, 0x70,
+	0x16, 0x5b, 0xa8, 0x83, 0x70, 0x77, 0x50, 0x63, 0x40, 0xb5, 0x2e, 0x78, 0x73, 0xbb, 0xe8, 0xcd,
+	0x3e, 0xac, 0x17, 0x95, 0x5d, 0xc5, 0xa0, 0xbb, 0xd0, 0x91, 0xfa, 0x93, 0x15, 0xec, 0x50, 0x72,
+	0x98, 0xd5, 0xbd, 0xb3, 0xd8, 0x66, 0xdf, 0x84, 0x5e, 0x56, 0x80, 0xf3, 0xa7, 0x85, 0x43, 0x68,
+	0x64, 0xa7, 0xcc, 0x7c, 0x61, 0x1f, 0xc3, 0x7b, 0x39, 0x1d, 0x54, 0x7d, 0x41, 0x39, 0x85, 0xea,
+	0xef, 0x54, 0xa8, 0xfd, 0x05, 0x0c, 0xf3, 0xf7, 0x54, 0xd0, 0xc9, 0xd5, 0x1f, 0x43, 0x4b, 0x48,
+	0x40, 0xdb, 0xef, 0xbd, 0xef, 0x8e, 0x6e, 0xe3, 0x59, 0x64, 0xac, 0xa0, 0x26, 0xe8, 0xb7, 0x0f,
+	0x0c, 0x0d, 0xb5, 0xa0, 0xb6, 0x77, 0x7b, 0xcf, 0xd0, 0x29, 0xf6, 0xae, 0x7b, 0x4a, 0x7b, 0x44,
+	0xa3, 0x76, 0xf5, 0x3a, 0xac, 0x2d, 0xd5, 0xcf, 0xa8, 0x03, 0x8d, 0x9b, 0x41, 0x10, 0xbd, 0x30,
+	0x56, 0x58, 0xf7, 0x1f, 0xc5, 0x13, 0xdf, 0x33, 0x34, 0x7a, 0xd0, 0xc1, 0xf3, 0xc0, 0x9d, 0x62,
+	0x43, 0xbf, 0xfa, 0x35, 0x0c, 0xf2, 0x19, 0x14, 0x0d, 0x00, 0x1e, 0x44, 0x72, 0x65, 0xac, 0xd0,
+	0xf1, 0x80, 0x5a, 0x69, 0xc8, 0x80, 0xde, 0xcd, 0x30, 0xf1, 0x15, 0x44, 0xdf, 0xfd, 0x5d, 0x17,
+	0x9a, 0x07, 0x2c, 0xe1, 0xa3, 0x87, 0x60, 0x14, 0xcb, 0x2d, 0xb4, 0x71, 0x4e, 0xf1, 0x68, 0xbd,
+	0x5f, 0x8e, 0xe4, 0x7a, 0xb2, 0x57, 0xd0, 0x0d, 0xe8, 0xa8, 0x01, 0x25, 0x62, 0xae, 0x51, 0x9c,
+	0x16, 0x5b, 0xa3, 0x02, 0x54, 0x9d, 0xbd, 0x0e, 0x6d, 0x39, 0x71, 0x44, 0xef, 0xd1, 0x4d, 0x85,
+	0x41, 0xab, 0x35, 0xcc, 0x03, 0xb3, 0x97, 0xaa, 0xc9, 0x23, 0xbf, 0xb4, 0x38, 0x56, 0xb5, 0x46,
+	0x05, 0x68, 0xf6, 0xac, 0x9a, 0x41, 0xf2, 0xb3, 0xc5, 0x59, 0xb1, 0x35, 0x2a, 0x40, 0xb3, 0x0c,
+	0xcb, 0x31, 0x09, 0x67, 0xb8, 0x30, 0x9b, 0xb4, 0x86, 0x79, 0x60, 0xf6, 0xa0, 0x9c, 0x68, 0xf0,
+	0x83, 0x85, 0xe9, 0x88, 0x35, 0xcc, 0x03, 0xd5, 0xc1, 0xfb, 0xb0, 0x5a, 0x18, 0x34, 0x20, 0x8b,
+	0x6e, 0x2d, 0x9f, 0x66, 0x58, 0x1b, 0xa5, 0x38, 0x45, 0x6d, 0x1f, 0x06, 0xf9, 0xae, 0x1c, 0x5d,
+	0x64, 0xa2, 0x96, 0x8d, 0x1a, 0x2c, 0xab, 0x0c, 0x95, 0x25, 0x95, 0xef, 0xaf, 0x39, 0xa9, 0xd2,
+	0x06, 0xdd, 0xb2, 0xca, 0x50, 0x8a, 0xd4, 0x5d, 0xe8, 0xe7, 0x7a, 0x6b, 0x64, 0x4a, 0x65, 0x14,
+	0xdb, 0x70, 0xeb, 0x62, 0x09, 0x26, 0x6b, 0x59, 0xf5, 0x2b, 0x84, 0x5b, 0xb6, 0xf8, 0xf7, 0xcb,
+	0x1a, 0x15, 0xa0, 0xea, 0xec, 0x9d, 0x42, 0xaf, 0x60, 0x96, 0x94, 0x35, 0x19, 0x16, 0x4a, 0x0b,
+	0x1e, 0x6e, 0xae, 0x42, 0xcd, 0xc0, 0xcd, 0x55, 0x5e, 0x81, 0x58, 0x1b, 0xa5, 0xb8, 0x9c, 0xb9,
+	0x72, 0x39, 0x5f, 0x98, 0xab, 0xac, 0xba, 0xb0, 0xac, 0x32, 0x54, 0x56, 0xc7, 0xb9, 0x74, 0xce,
+	0x05, 0x2c, 0x2b, 0x0d, 0xac, 0x8b, 0x25, 0x98, 0x2c, 0x4b, 0xf9, 0x34, 0xc2, 0x59, 0x2a, 0xcd,
+	0xe3, 0x96, 0x55, 0x86, 0x52, 0xa4, 0x1c, 0x58, 0x93, 0x71, 0xe5, 0x00, 0x27, 0xee, 0x51, 0x12,
+	0xc5, 0x18, 0xe5, 0xc2, 0x8d, 0x02, 0x4b, 0x82, 0x97, 0xde, 0x82, 0x5d, 0x62, 0x6f, 0x41, 0x70,
+	0xc1, 0xde, 0x12, 0x35, 0xab, 0x0c, 0xa5, 0x48, 0x1d, 0xd0, 0xba, 0x71, 0x1e, 0xc5, 0x89, 0xe4,
+	0x5d, 0xe5, 0xb3, 0x0b, 0x4b, 0x19, 0x45, 0x10, 0x34, 0x97, 0x11, 0x92, 0xdc, 0x2d, 0xf3, 0xcf,
+	0xaf, 0x37, 0xb5, 0xef, 0x5f, 0x6f, 0x6a, 0x7f, 0x7b, 0xbd, 0xa9, 0xfd, 0xfe, 0xcd, 0xe6, 0xca,
+	0xf7, 0x6f, 0x36, 0x57, 0xfe, 0xfa, 0x66, 0x73, 0x65, 0xd2, 0x64, 0x0d, 0xf7, 0x17, 0xff, 0x1d,
+	0x00, 0x2d, 0xbd, 0x38, 0x53, 0xef, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MasterClient is the client API for Master service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MasterClient interface {
+	RegisterExecutor(ctx context.Context, in *RegisterExecutorRequest, opts ...grpc.CallOption) (*RegisterExecutorResponse, error)
+	SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*SubmitJobResponse, error)
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error)
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	QueryJob(ctx context.Context, in *QueryJobRequest, opts ...grpc.CallOption) (*QueryJobResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// UpdateJobConfig updates the config of a job that is not terminated, the
+	// running job master applies or rejects the config.
+	UpdateJobConfig(ctx context.Context, in *UpdateJobConfigRequest, opts ...grpc.CallOption) (*UpdateJobConfigResponse, error)
+	// CreateSchedule registers a job template that is submitted periodically
+	// according to a cron expression.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// DeleteSchedule deletes a schedule, the jobs it has submitted are not
+	// affected.
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	ScheduleTask(ctx context.Context, in *TaskSchedulerRequest, opts ...grpc.CallOption) (*TaskSchedulerResponse, error)
+	// ReleaseResource releases the resource reserved for the workers by
+	// ScheduleTask, it's called when the workers fail to be dispatched.
+	ReleaseResource(ctx context.Context, in *ReleaseResourceRequest, opts ...grpc.CallOption) (*ReleaseResourceResponse, error)
+	// CordonExecutor stops scheduling new workers to an executor, or resumes
+	// it if uncordon is set. The running workers are not affected.
+	CordonExecutor(ctx context.Context, in *CordonExecutorRequest, opts ...grpc.CallOption) (*CordonExecutorResponse, error)
+	// DrainExecutor cordons an executor and asks the job masters to migrate
+	// the workers in it elsewhere. It returns the workers still in the
+	// executor, and should be called again until none is left.
+	DrainExecutor(ctx context.Context, in *DrainExecutorRequest, opts ...grpc.CallOption) (*DrainExecutorResponse, error)
+	// QueryExecutors returns the resource of the executors, including the
+	// usage of each workload type they report.
+	QueryExecutors(ctx context.Context, in *QueryExecutorsRequest, opts ...grpc.CallOption) (*QueryExecutorsResponse, error)
+	// RegisterMetaStore is called from backend metastore and
+	// registers to server master metastore manager
+	RegisterMetaStore(ctx context.Context, in *RegisterMetaStoreRequest, opts ...grpc.CallOption) (*RegisterMetaStoreResponse, error)
+	// QueryMetaStore queries metastore manager and returns
+	// the information of a matching metastore
+	QueryMetaStore(ctx context.Context, in *QueryMetaStoreRequest, opts ...grpc.CallOption) (*QueryMetaStoreResponse, error)
+	// ReportExecutorWorkload is called from executor to server master to report
+	// resource usage in executor.
+	ReportExecutorWorkload(ctx context.Context, in *ExecWorkloadRequest, opts ...grpc.CallOption) (*ExecWorkloadResponse, error)
+}
+
+type masterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMasterClient(cc *grpc.ClientConn) MasterClient {
+	return &masterClient{cc}
+}
+
+func (c *masterClient) RegisterExecutor(ctx context.Context, in *RegisterExecutorRequest, opts ...grpc.CallOption) (*RegisterExecutorResponse, error) {
+	out := new(RegisterExecutorResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/RegisterExecutor", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ExecutorId)
-	if l > 0 {
-		n += 1 + l + sovMaster(uint64(l))
+	return out, nil
+}
+
+func (c *masterClient) SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*SubmitJobResponse, error) {
+	out := new(SubmitJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/SubmitJob", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Workloads) > 0 {
-		for _, e := range m.Workloads {
-			l = e.Size()
-			n += 1 + l + sovMaster(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *masterClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error) {
+	out := new(PauseJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/PauseJob", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ExecWorkloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *masterClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error) {
+	out := new(ResumeJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ResumeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovMaster(uint64(l))
+	return out, nil
+}
+
+func (c *masterClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
+}
+
+func (c *masterClient) QueryJob(ctx context.Context, in *QueryJobRequest, opts ...grpc.CallOption) (*QueryJobResponse, error) {
+	out := new(QueryJobResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/QueryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) UpdateJobConfig(ctx context.Context, in *UpdateJobConfigRequest, opts ...grpc.CallOption) (*UpdateJobConfigResponse, error) {
+	out := new(UpdateJobConfigResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/UpdateJobConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) ScheduleTask(ctx context.Context, in *TaskSchedulerRequest, opts ...grpc.CallOption) (*TaskSchedulerResponse, error) {
+	out := new(TaskSchedulerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ScheduleTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) ReleaseResource(ctx context.Context, in *ReleaseResourceRequest, opts ...grpc.CallOption) (*ReleaseResourceResponse, error) {
+	out := new(ReleaseResourceResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ReleaseResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) CordonExecutor(ctx context.Context, in *CordonExecutorRequest, opts ...grpc.CallOption) (*CordonExecutorResponse, error) {
+	out := new(CordonExecutorResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/CordonExecutor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) DrainExecutor(ctx context.Context, in *DrainExecutorRequest, opts ...grpc.CallOption) (*DrainExecutorResponse, error) {
+	out := new(DrainExecutorResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/DrainExecutor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) QueryExecutors(ctx context.Context, in *QueryExecutorsRequest, opts ...grpc.CallOption) (*QueryExecutorsResponse, error) {
+	out := new(QueryExecutorsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/QueryExecutors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) RegisterMetaStore(ctx context.Context, in *RegisterMetaStoreRequest, opts ...grpc.CallOption) (*RegisterMetaStoreResponse, error) {
+	out := new(RegisterMetaStoreResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/RegisterMetaStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) QueryMetaStore(ctx context.Context, in *QueryMetaStoreRequest, opts ...grpc.CallOption) (*QueryMetaStoreResponse, error) {
+	out := new(QueryMetaStoreResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/QueryMetaStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) ReportExecutorWorkload(ctx context.Context, in *ExecWorkloadRequest, opts ...grpc.CallOption) (*ExecWorkloadResponse, error) {
+	out := new(ExecWorkloadResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ReportExecutorWorkload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MasterServer is the server API for Master service.
+type MasterServer interface {
+	RegisterExecutor(context.Context, *RegisterExecutorRequest) (*RegisterExecutorResponse, error)
+	SubmitJob(context.Context, *SubmitJobRequest) (*SubmitJobResponse, error)
+	PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error)
+	ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	QueryJob(context.Context, *QueryJobRequest) (*QueryJobResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// UpdateJobConfig updates the config of a job that is not terminated, the
+	// running job master applies or rejects the config.
+	UpdateJobConfig(context.Context, *UpdateJobConfigRequest) (*UpdateJobConfigResponse, error)
+	// CreateSchedule registers a job template that is submitted periodically
+	// according to a cron expression.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// DeleteSchedule deletes a schedule, the jobs it has submitted are not
+	// affected.
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	ScheduleTask(context.Context, *TaskSchedulerRequest) (*TaskSchedulerResponse, error)
+	// ReleaseResource releases the resource reserved for the workers by
+	// ScheduleTask, it's called when the workers fail to be dispatched.
+	ReleaseResource(context.Context, *ReleaseResourceRequest) (*ReleaseResourceResponse, error)
+	// CordonExecutor stops scheduling new workers to an executor, or resumes
+	// it if uncordon is set. The running workers are not affected.
+	CordonExecutor(context.Context, *CordonExecutorRequest) (*CordonExecutorResponse, error)
+	// DrainExecutor cordons an executor and asks the job masters to migrate
+	// the workers in it elsewhere. It returns the workers still in the
+	// executor, and should be called again until none is left.
+	DrainExecutor(context.Context, *DrainExecutorRequest) (*DrainExecutorResponse, error)
+	// QueryExecutors returns the resource of the executors, including the
+	// usage of each workload type they report.
+	QueryExecutors(context.Context, *QueryExecutorsRequest) (*QueryExecutorsResponse, error)
+	// RegisterMetaStore is called from backend metastore and
+	// registers to server master metastore manager
+	RegisterMetaStore(context.Context, *RegisterMetaStoreRequest) (*RegisterMetaStoreResponse, error)
+	// QueryMetaStore queries metastore manager and returns
+	// the information of a matching metastore
+	QueryMetaStore(context.Context, *QueryMetaStoreRequest) (*QueryMetaStoreResponse, error)
+	// ReportExecutorWorkload is called from executor to server master to report
+	// resource usage in executor.
+	ReportExecutorWorkload(context.Context, *ExecWorkloadRequest) (*ExecWorkloadResponse, error)
+}
+
+// UnimplementedMasterServer can be embedded to have forward compatible implementations.
+type UnimplementedMasterServer struct {
+}
+
+func (*UnimplementedMasterServer) RegisterExecutor(ctx context.Context, req *RegisterExecutorRequest) (*RegisterExecutorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterExecutor not implemented")
+}
+func (*UnimplementedMasterServer) SubmitJob(ctx context.Context, req *SubmitJobRequest) (*SubmitJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
+}
+func (*UnimplementedMasterServer) PauseJob(ctx context.Context, req *PauseJobRequest) (*PauseJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (*UnimplementedMasterServer) ResumeJob(ctx context.Context, req *ResumeJobRequest) (*ResumeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (*UnimplementedMasterServer) CancelJob(ctx context.Context, req *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (*UnimplementedMasterServer) QueryJob(ctx context.Context, req *QueryJobRequest) (*QueryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryJob not implemented")
+}
+func (*UnimplementedMasterServer) ListJobs(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (*UnimplementedMasterServer) UpdateJobConfig(ctx context.Context, req *UpdateJobConfigRequest) (*UpdateJobConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobConfig not implemented")
+}
+func (*UnimplementedMasterServer) CreateSchedule(ctx context.Context, req *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedMasterServer) DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (*UnimplementedMasterServer) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (*UnimplementedMasterServer) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedMasterServer) ScheduleTask(ctx context.Context, req *TaskSchedulerRequest) (*TaskSchedulerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTask not implemented")
+}
+func (*UnimplementedMasterServer) ReleaseResource(ctx context.Context, req *ReleaseResourceRequest) (*ReleaseResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseResource not implemented")
+}
+func (*UnimplementedMasterServer) CordonExecutor(ctx context.Context, req *CordonExecutorRequest) (*CordonExecutorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonExecutor not implemented")
+}
+func (*UnimplementedMasterServer) DrainExecutor(ctx context.Context, req *DrainExecutorRequest) (*DrainExecutorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainExecutor not implemented")
+}
+func (*UnimplementedMasterServer) QueryExecutors(ctx context.Context, req *QueryExecutorsRequest) (*QueryExecutorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryExecutors not implemented")
+}
+func (*UnimplementedMasterServer) RegisterMetaStore(ctx context.Context, req *RegisterMetaStoreRequest) (*RegisterMetaStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMetaStore not implemented")
+}
+func (*UnimplementedMasterServer) QueryMetaStore(ctx context.Context, req *QueryMetaStoreRequest) (*QueryMetaStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMetaStore not implemented")
+}
+func (*UnimplementedMasterServer) ReportExecutorWorkload(ctx context.Context, req *ExecWorkloadRequest) (*ExecWorkloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExecutorWorkload not implemented")
+}
+
+func RegisterMasterServer(s *grpc.Server, srv MasterServer) {
+	s.RegisterService(&_Master_serviceDesc, srv)
+}
+
+func _Master_RegisterExecutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterExecutorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).RegisterExecutor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/RegisterExecutor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).RegisterExecutor(ctx, req.(*RegisterExecutorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).SubmitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/SubmitJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).SubmitJob(ctx, req.(*SubmitJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/PauseJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ResumeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_QueryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).QueryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/QueryJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).QueryJob(ctx, req.(*QueryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_UpdateJobConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).UpdateJobConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/UpdateJobConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).UpdateJobConfig(ctx, req.(*UpdateJobConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/DeleteSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_ScheduleTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskSchedulerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).ScheduleTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ScheduleTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ScheduleTask(ctx, req.(*TaskSchedulerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_ReleaseResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).ReleaseResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ReleaseResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ReleaseResource(ctx, req.(*ReleaseResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_CordonExecutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonExecutorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).CordonExecutor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/CordonExecutor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).CordonExecutor(ctx, req.(*CordonExecutorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_DrainExecutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainExecutorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).DrainExecutor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/DrainExecutor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).DrainExecutor(ctx, req.(*DrainExecutorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_QueryExecutors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).QueryExecutors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/QueryExecutors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).QueryExecutors(ctx, req.(*QueryExecutorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_RegisterMetaStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMetaStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).RegisterMetaStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/RegisterMetaStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).RegisterMetaStore(ctx, req.(*RegisterMetaStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_QueryMetaStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetaStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).QueryMetaStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/QueryMetaStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).QueryMetaStore(ctx, req.(*QueryMetaStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_ReportExecutorWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).ReportExecutorWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ReportExecutorWorkload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ReportExecutorWorkload(ctx, req.(*ExecWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Master_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Master",
+	HandlerType: (*MasterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterExecutor",
+			Handler:    _Master_RegisterExecutor_Handler,
+		},
+		{
+			MethodName: "SubmitJob",
+			Handler:    _Master_SubmitJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _Master_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _Master_ResumeJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _Master_CancelJob_Handler,
+		},
+		{
+			MethodName: "QueryJob",
+			Handler:    _Master_QueryJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _Master_ListJobs_Handler,
+		},
+		{
+			MethodName: "UpdateJobConfig",
+			Handler:    _Master_UpdateJobConfig_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Master_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _Master_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _Master_ListSchedules_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Master_Heartbeat_Handler,
+		},
+		{
+			MethodName: "ScheduleTask",
+			Handler:    _Master_ScheduleTask_Handler,
+		},
+		{
+			MethodName: "ReleaseResource",
+			Handler:    _Master_ReleaseResource_Handler,
+		},
+		{
+			MethodName: "CordonExecutor",
+			Handler:    _Master_CordonExecutor_Handler,
+		},
+		{
+			MethodName: "DrainExecutor",
+			Handler:    _Master_DrainExecutor_Handler,
+		},
+		{
+			MethodName: "QueryExecutors",
+			Handler:    _Master_QueryExecutors_Handler,
+		},
+		{
+			MethodName: "RegisterMetaStore",
+			Handler:    _Master_RegisterMetaStore_Handler,
+		},
+		{
+			MethodName: "QueryMetaStore",
+			Handler:    _Master_QueryMetaStore_Handler,
+		},
+		{
+			MethodName: "ReportExecutorWorkload",
+			Handler:    _Master_ReportExecutorWorkload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "master.proto",
+}
+
+func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.WorkerIds) > 0 {
+		for iNdEx := len(m.WorkerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WorkerIds[iNdEx])
+			copy(dAtA[i:], m.WorkerIds[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.WorkerIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ResourceUsage != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.ResourceUsage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunningWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunningWorker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunningWorker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pinned {
+		i--
+		if m.Pinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WorkerType != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.WorkerType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NonPreemptible {
+		i--
+		if m.NonPreemptible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MasterId) > 0 {
+		i -= len(m.MasterId)
+		copy(dAtA[i:], m.MasterId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.MasterId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkerId) > 0 {
+		i -= len(m.WorkerId)
+		copy(dAtA[i:], m.WorkerId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.WorkerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addrs[iNdEx])
+			copy(dAtA[i:], m.Addrs[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.Addrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.JobName) > 0 {
+		i -= len(m.JobName)
+		copy(dAtA[i:], m.JobName)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tp != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Tp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.JobId != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.JobId != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.JobId != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerStatusInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerStatusInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerStatusInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ext) > 0 {
+		i -= len(m.Ext)
+		copy(dAtA[i:], m.Ext)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Ext)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WorkerId) > 0 {
+		i -= len(m.WorkerId)
+		copy(dAtA[i:], m.WorkerId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.WorkerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConfigError) > 0 {
+		i -= len(m.ConfigError)
+		copy(dAtA[i:], m.ConfigError)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ConfigError)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ConfigVersion != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.ConfigVersion))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.QueuePosition != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.QueuePosition))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Tp != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Tp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		dAtA11 := make([]byte, len(m.States)*10)
+		var j10 int
+		for _, num := range m.States {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintMaster(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateJobConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateJobConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateJobConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateJobConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateJobConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateJobConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigVersion != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.ConfigVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Tp != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Tp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextTime) > 0 {
+		i -= len(m.NextTime)
+		copy(dAtA[i:], m.NextTime)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.NextTime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NextTime) > 0 {
+		i -= len(m.NextTime)
+		copy(dAtA[i:], m.NextTime)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.NextTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Policy != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Tp != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Tp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ScheduleTime) > 0 {
+		i -= len(m.ScheduleTime)
+		copy(dAtA[i:], m.ScheduleTime)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ScheduleTime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIdStr) > 0 {
+		i -= len(m.JobIdStr)
+		copy(dAtA[i:], m.JobIdStr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.JobIdStr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterExecutorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterExecutorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterExecutorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerTypes) > 0 {
+		dAtA18 := make([]byte, len(m.WorkerTypes)*10)
+		var j17 int
+		for _, num1 := range m.WorkerTypes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintMaster(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMaster(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMaster(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMaster(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ResourceCapacity != nil {
+		{
+			size, err := m.ResourceCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Capability != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Capability))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterExecutorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterExecutorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterExecutorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerType != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.WorkerType))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NonPreemptible {
+		i--
+		if m.NonPreemptible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AffinityRequired {
+		i--
+		if m.AffinityRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Affinity != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Affinity))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LabelSelector) > 0 {
+		for k := range m.LabelSelector {
+			v := m.LabelSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMaster(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMaster(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMaster(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MasterId) > 0 {
+		i -= len(m.MasterId)
+		copy(dAtA[i:], m.MasterId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.MasterId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.WorkerId) > 0 {
+		i -= len(m.WorkerId)
+		copy(dAtA[i:], m.WorkerId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.WorkerId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LocationRequired {
+		i--
+		if m.LocationRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreferredLocation) > 0 {
+		i -= len(m.PreferredLocation)
+		copy(dAtA[i:], m.PreferredLocation)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.PreferredLocation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Cost != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskSchedulerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskSchedulerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskSchedulerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WaitTimeoutMs != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.WaitTimeoutMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Gang {
+		i--
+		if m.Gang {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskSchedulerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskSchedulerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskSchedulerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedule) > 0 {
+		for k := range m.Schedule {
+			v := m.Schedule[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMaster(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintMaster(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintMaster(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseResourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseResourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerIds) > 0 {
+		for iNdEx := len(m.WorkerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WorkerIds[iNdEx])
+			copy(dAtA[i:], m.WorkerIds[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.WorkerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CordonExecutorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CordonExecutorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CordonExecutorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Uncordon {
+		i--
+		if m.Uncordon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CordonExecutorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CordonExecutorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CordonExecutorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainExecutorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainExecutorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainExecutorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainExecutorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainExecutorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainExecutorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingWorkers) > 0 {
+		for iNdEx := len(m.RemainingWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemainingWorkers[iNdEx])
+			copy(dAtA[i:], m.RemainingWorkers[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.RemainingWorkers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutorIds) > 0 {
+		for iNdEx := len(m.ExecutorIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutorIds[iNdEx])
+			copy(dAtA[i:], m.ExecutorIds[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutorResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerIds) > 0 {
+		for iNdEx := len(m.WorkerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WorkerIds[iNdEx])
+			copy(dAtA[i:], m.WorkerIds[iNdEx])
+			i = encodeVarintMaster(dAtA, i, uint64(len(m.WorkerIds[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Cordoned {
+		i--
+		if m.Cordoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Workloads) > 0 {
+		for iNdEx := len(m.Workloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Used != nil {
+		{
+			size, err := m.Used.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Reserved != nil {
+		{
+			size, err := m.Reserved.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Capacity != nil {
+		{
+			size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executors) > 0 {
+		for iNdEx := len(m.Executors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecWorkload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecWorkload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecWorkload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Usage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tp != 0 {
+		i = encodeVarintMaster(dAtA, i, uint64(m.Tp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecWorkloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecWorkloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecWorkloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Workloads) > 0 {
+		for iNdEx := len(m.Workloads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecWorkloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecWorkloadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecWorkloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMaster(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMaster(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HeartbeatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.ResourceUsage != 0 {
+		n += 1 + sovMaster(uint64(m.ResourceUsage))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMaster(uint64(m.Status))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovMaster(uint64(m.Timestamp))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovMaster(uint64(m.Ttl))
+	}
+	if len(m.WorkerIds) > 0 {
+		for _, s := range m.WorkerIds {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RunningWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkerId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.MasterId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMaster(uint64(m.Priority))
+	}
+	if m.NonPreemptible {
+		n += 2
+	}
+	if m.WorkerType != 0 {
+		n += 1 + sovMaster(uint64(m.WorkerType))
+	}
+	if m.Pinned {
+		n += 2
+	}
+	return n
+}
+
+func (m *HeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubmitJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tp != 0 {
+		n += 1 + sovMaster(uint64(m.Tp))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.JobName)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMaster(uint64(m.Priority))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancelJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != 0 {
+		n += 1 + sovMaster(uint64(m.JobId))
+	}
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != 0 {
+		n += 1 + sovMaster(uint64(m.JobId))
+	}
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.JobId != 0 {
+		n += 1 + sovMaster(uint64(m.JobId))
+	}
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkerStatusInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkerId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovMaster(uint64(m.Code))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Ext)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *JobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovMaster(uint64(m.State))
+	}
+	if m.Tp != 0 {
+		n += 1 + sovMaster(uint64(m.Tp))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMaster(uint64(m.Priority))
+	}
+	if m.QueuePosition != 0 {
+		n += 1 + sovMaster(uint64(m.QueuePosition))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	if m.ConfigVersion != 0 {
+		n += 1 + sovMaster(uint64(m.ConfigVersion))
+	}
+	l = len(m.ConfigError)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *JobFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ListJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovMaster(uint64(e))
+		}
+		n += 1 + sovMaster(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ListJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateJobConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateJobConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.ConfigVersion != 0 {
+		n += 1 + sovMaster(uint64(m.ConfigVersion))
+	}
+	return n
+}
+
+func (m *CreateScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Tp != 0 {
+		n += 1 + sovMaster(uint64(m.Tp))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMaster(uint64(m.Priority))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovMaster(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *CreateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.NextTime)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ListSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Tp != 0 {
+		n += 1 + sovMaster(uint64(m.Tp))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMaster(uint64(m.Priority))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovMaster(uint64(m.Policy))
+	}
+	l = len(m.NextTime)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobIdStr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.ScheduleTime)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovMaster(uint64(m.State))
+	}
+	return n
+}
+
+func (m *RegisterExecutorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Capability != 0 {
+		n += 1 + sovMaster(uint64(m.Capability))
+	}
+	if m.ResourceCapacity != nil {
+		l = m.ResourceCapacity.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + len(v) + sovMaster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+		}
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovMaster(uint64(m.ProtocolVersion))
+	}
+	if len(m.WorkerTypes) > 0 {
+		l = 0
+		for _, e := range m.WorkerTypes {
+			l += sovMaster(uint64(e))
+		}
+		n += 1 + sovMaster(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RegisterExecutorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovMaster(uint64(m.Cost))
+	}
+	l = len(m.PreferredLocation)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.LocationRequired {
+		n += 2
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.WorkerId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.MasterId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.LabelSelector) > 0 {
+		for k, v := range m.LabelSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + len(v) + sovMaster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+		}
+	}
+	if m.Affinity != 0 {
+		n += 1 + sovMaster(uint64(m.Affinity))
+	}
+	if m.AffinityRequired {
+		n += 2
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMaster(uint64(m.Priority))
+	}
+	if m.NonPreemptible {
+		n += 2
+	}
+	if m.WorkerType != 0 {
+		n += 1 + sovMaster(uint64(m.WorkerType))
+	}
+	return n
+}
+
+func (m *TaskSchedulerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	if m.Gang {
+		n += 2
+	}
+	if m.WaitTimeoutMs != 0 {
+		n += 1 + sovMaster(uint64(m.WaitTimeoutMs))
+	}
+	return n
+}
+
+func (m *ScheduleResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskSchedulerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for k, v := range m.Schedule {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovMaster(uint64(l))
+			}
+			mapEntrySize := 1 + sovMaster(uint64(k)) + l
+			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+		}
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleaseResourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WorkerIds) > 0 {
+		for _, s := range m.WorkerIds {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *CordonExecutorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Uncordon {
+		n += 2
+	}
+	return n
+}
+
+func (m *CordonExecutorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainExecutorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainExecutorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.RemainingWorkers) > 0 {
+		for _, s := range m.RemainingWorkers {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExecutorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExecutorIds) > 0 {
+		for _, s := range m.ExecutorIds {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutorResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Reserved != nil {
+		l = m.Reserved.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.Used != nil {
+		l = m.Used.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Workloads) > 0 {
+		for _, e := range m.Workloads {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	if m.Cordoned {
+		n += 2
+	}
+	if len(m.WorkerIds) > 0 {
+		for _, s := range m.WorkerIds {
+			l = len(s)
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExecutorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Executors) > 0 {
+		for _, e := range m.Executors {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecWorkload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tp != 0 {
+		n += 1 + sovMaster(uint64(m.Tp))
+	}
+	if m.Usage != 0 {
+		n += 1 + sovMaster(uint64(m.Usage))
+	}
+	return n
+}
+
+func (m *ExecWorkloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if len(m.Workloads) > 0 {
+		for _, e := range m.Workloads {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecWorkloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
 }
 
 func sovMaster(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozMaster(x uint64) (n int) {
-	return sovMaster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozMaster(x uint64) (n int) {
+	return sovMaster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsage", wireType)
+			}
+			m.ResourceUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceUsage |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerIds = append(m.WorkerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &RunningWorker{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunningWorker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunningWorker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunningWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonPreemptible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonPreemptible = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerType", wireType)
+			}
+			m.WorkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerType |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tp", wireType)
+			}
+			m.Tp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tp |= JobType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatusInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatusInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatusInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ext = append(m.Ext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ext == nil {
+				m.Ext = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobInfo_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tp", wireType)
+			}
+			m.Tp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &WorkerStatusInfo{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &WorkerStatusInfo{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, &JobFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
+			}
+			m.QueuePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuePosition |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigVersion", wireType)
+			}
+			m.ConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &JobInfo{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v JobInfo_State
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobInfo_State(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMaster
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMaster
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobInfo_State, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobInfo_State
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobInfo_State(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &JobInfo{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateJobConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateJobConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateJobConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateJobConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateJobConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateJobConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigVersion", wireType)
+			}
+			m.ConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tp", wireType)
+			}
+			m.Tp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tp |= JobType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= ConcurrencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, &ScheduleInfo{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tp", wireType)
+			}
+			m.Tp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tp |= JobType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= ConcurrencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &ScheduledJob{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobInfo_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterExecutorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterExecutorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterExecutorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+			}
+			m.Capability = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capability |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceCapacity == nil {
+				m.ResourceCapacity = &Resource{}
+			}
+			if err := m.ResourceCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WorkerTypes = append(m.WorkerTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMaster
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMaster
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WorkerTypes) == 0 {
+					m.WorkerTypes = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WorkerTypes = append(m.WorkerTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerTypes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterExecutorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterExecutorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterExecutorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduleTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2496,25 +11890,232 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HeartbeatRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &TaskRequest{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LocationRequired = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2542,13 +12143,140 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			m.MasterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelSelector == nil {
+				m.LabelSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LabelSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
-			m.ResourceUsage = 0
+			m.Affinity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -2558,16 +12286,16 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourceUsage |= int32(b&0x7F) << shift
+				m.Affinity |= WorkerAffinity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AffinityRequired", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -2577,16 +12305,159 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AffinityRequired = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonPreemptible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonPreemptible = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerType", wireType)
+			}
+			m.WorkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerType |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskSchedulerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskSchedulerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskSchedulerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &ScheduleTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gang", wireType)
 			}
-			m.Timestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -2596,16 +12467,17 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.Gang = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeoutMs", wireType)
 			}
-			m.Ttl = 0
+			m.WaitTimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -2615,7 +12487,7 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ttl |= uint64(b&0x7F) << shift
+				m.WaitTimeoutMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2641,7 +12513,7 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2664,51 +12536,15 @@ func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMaster
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Err == nil {
-				m.Err = &Error{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2736,11 +12572,11 @@ func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2768,7 +12604,7 @@ func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2791,7 +12627,7 @@ func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitJobRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskSchedulerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2814,36 +12650,17 @@ func (m *SubmitJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskSchedulerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskSchedulerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tp", wireType)
-			}
-			m.Tp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Tp |= JobType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -2853,31 +12670,112 @@ func (m *SubmitJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaster
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
-			if m.Config == nil {
-				m.Config = []byte{}
+			if m.Schedule == nil {
+				m.Schedule = make(map[int64]*ScheduleResult)
+			}
+			var mapkey int64
+			var mapvalue *ScheduleResult
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ScheduleResult{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Schedule[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -2887,23 +12785,27 @@ func (m *SubmitJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2926,7 +12828,7 @@ func (m *SubmitJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelJobRequest) Unmarshal(dAtA []byte) error {
+func (m *ReleaseResourceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2949,34 +12851,15 @@ func (m *CancelJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseResourceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			m.JobId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3004,7 +12887,7 @@ func (m *CancelJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			m.WorkerIds = append(m.WorkerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3027,7 +12910,7 @@ func (m *CancelJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseJobRequest) Unmarshal(dAtA []byte) error {
+func (m *ReleaseResourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3050,36 +12933,17 @@ func (m *PauseJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseResourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			m.JobId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3089,23 +12953,27 @@ func (m *PauseJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobIdStr = string(dAtA[iNdEx:postIndex])
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3128,7 +12996,7 @@ func (m *PauseJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitJobResponse) Unmarshal(dAtA []byte) error {
+func (m *CordonExecutorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3151,17 +13019,17 @@ func (m *SubmitJobResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitJobResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CordonExecutorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CordonExecutorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3171,52 +13039,29 @@ func (m *SubmitJobResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Err == nil {
-				m.Err = &Error{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			m.JobId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIdStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uncordon", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3226,24 +13071,12 @@ func (m *SubmitJobResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobIdStr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Uncordon = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -3265,7 +13098,7 @@ func (m *SubmitJobResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseJobResponse) Unmarshal(dAtA []byte) error {
+func (m *CordonExecutorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,10 +13121,10 @@ func (m *PauseJobResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseJobResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CordonExecutorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CordonExecutorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3351,7 +13184,7 @@ func (m *PauseJobResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelJobResponse) Unmarshal(dAtA []byte) error {
+func (m *DrainExecutorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3374,17 +13207,17 @@ func (m *CancelJobResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelJobResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrainExecutorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrainExecutorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3394,27 +13227,23 @@ func (m *CancelJobResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Err == nil {
-				m.Err = &Error{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3437,7 +13266,7 @@ func (m *CancelJobResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterExecutorRequest) Unmarshal(dAtA []byte) error {
+func (m *DrainExecutorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3460,17 +13289,17 @@ func (m *RegisterExecutorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterExecutorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrainExecutorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterExecutorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrainExecutorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3480,27 +13309,31 @@ func (m *RegisterExecutorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingWorkers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3528,27 +13361,8 @@ func (m *RegisterExecutorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.RemainingWorkers = append(m.RemainingWorkers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
-			}
-			m.Capability = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Capability |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -3570,7 +13384,7 @@ func (m *RegisterExecutorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterExecutorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExecutorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3593,51 +13407,15 @@ func (m *RegisterExecutorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterExecutorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExecutorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterExecutorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExecutorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMaster
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Err == nil {
-				m.Err = &Error{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3665,7 +13443,7 @@ func (m *RegisterExecutorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			m.ExecutorIds = append(m.ExecutorIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3688,7 +13466,7 @@ func (m *RegisterExecutorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleTask) Unmarshal(dAtA []byte) error {
+func (m *ExecutorResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3711,15 +13489,79 @@ func (m *ScheduleTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3746,18 +13588,18 @@ func (m *ScheduleTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TaskRequest{}
+			if m.Capacity == nil {
+				m.Capacity = &Resource{}
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
 			}
-			m.Cost = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3767,16 +13609,33 @@ func (m *ScheduleTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cost |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reserved == nil {
+				m.Reserved = &Resource{}
+			}
+			if err := m.Reserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreferredLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3786,77 +13645,31 @@ func (m *ScheduleTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreferredLocation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaster
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskSchedulerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaster
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Used == nil {
+				m.Used = &Resource{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskSchedulerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskSchedulerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workloads", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3883,66 +13696,16 @@ func (m *TaskSchedulerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &ScheduleTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Workloads = append(m.Workloads, &ExecWorkload{})
+			if err := m.Workloads[len(m.Workloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaster
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMaster
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -3952,27 +13715,15 @@ func (m *ScheduleResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Cordoned = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4000,7 +13751,7 @@ func (m *ScheduleResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.WorkerIds = append(m.WorkerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4023,7 +13774,7 @@ func (m *ScheduleResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskSchedulerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExecutorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4046,15 +13797,15 @@ func (m *TaskSchedulerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskSchedulerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExecutorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskSchedulerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExecutorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4081,95 +13832,16 @@ func (m *TaskSchedulerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Schedule == nil {
-				m.Schedule = make(map[int64]*ScheduleResult)
+			if m.Err == nil {
+				m.Err = &Error{}
 			}
-			var mapkey int64
-			var mapvalue *ScheduleResult
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMaster
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMaster
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMaster
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthMaster
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthMaster
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ScheduleResult{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipMaster(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthMaster
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Schedule[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4196,10 +13868,8 @@ func (m *TaskSchedulerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Err == nil {
-				m.Err = &Error{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Executors = append(m.Executors, &ExecutorResource{})
+			if err := m.Executors[len(m.Executors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14,9 +14,20 @@ var (
 	MasterInfoKey      KeyAdapter = keyHexEncoderDecoder("/data-flow/master/info")
 	MasterMetaKey      KeyAdapter = keyHexEncoderDecoder("/data-flow/master/meta")
 	NodeInfoKeyAdapter KeyAdapter = keyHexEncoderDecoder("/data-flow/node/info")
+	// ExecutorKeyAdapter is the executors registered in the server master,
+	// unlike the node info keys, they're not bound to the executor sessions.
+	ExecutorKeyAdapter KeyAdapter = keyHexEncoderDecoder("/data-flow/executor")
 	JobKeyAdapter      KeyAdapter = keyHexEncoderDecoder("/data-flow/job")
-	TaskKeyAdapter     KeyAdapter = keyHexEncoderDecoder("/data-flow/task")
-	WorkerKeyAdapter   KeyAdapter = keyHexEncoderDecoder("/data-flow/worker")
+	// JobArchiveKeyAdapter is not under JobKeyAdapter, so that archived jobs
+	// are not loaded with the running ones.
+	JobArchiveKeyAdapter KeyAdapter = keyHexEncoderDecoder("/data-flow/archive/job")
+	// JobFailureKeyAdapter is the failures of the job masters of the running
+	// jobs, they're kept apart from the jobs so that recording a failure
+	// doesn't race with updating the job config.
+	JobFailureKeyAdapter KeyAdapter = keyHexEncoderDecoder("/data-flow/failure/job")
+	ScheduleKeyAdapter   KeyAdapter = keyHexEncoderDecoder("/data-flow/schedule")
+	TaskKeyAdapter       KeyAdapter = keyHexEncoderDecoder("/data-flow/task")
+	WorkerKeyAdapter     KeyAdapter = keyHexEncoderDecoder("/data-flow/worker")
 )
 
 type KeyAdapter interface {
@@ -0,0 +1,180 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hanfei1991/microcosm/pkg/errors"
+)
+
+// Schedule is a parsed cron expression with the standard five fields:
+// minute, hour, day of month, month and day of week.
+type Schedule struct {
+	minute bits
+	hour   bits
+	dom    bits
+	month  bits
+	dow    bits
+	// domStar and dowStar record whether the day fields start with "*". If
+	// both of them are restricted, a day matches if either of them matches.
+	domStar bool
+	dowStar bool
+}
+
+// bits is a set of the values of a field.
+type bits uint64
+
+func (b bits) has(v int) bool {
+	return b&(1<<uint(v)) != 0
+}
+
+type bounds struct {
+	min, max int
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	// both 0 and 7 are Sunday
+	dowBounds = bounds{0, 7}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxSearchYears limits how far Next looks ahead, an expression such as
+// "0 0 30 2 *" never matches.
+const maxSearchYears = 5
+
+// Parse parses a cron expression. Each field is "*", a value, a range "a-b",
+// or a list of them separated by ",", and an optional step "/n" can follow
+// "*", a range or a value, in which case the value is the start of a range
+// up to the maximum. The descriptors such as "@daily" are supported as well.
+func Parse(spec string) (*Schedule, error) {
+	expr := strings.TrimSpace(spec)
+	if d, ok := descriptors[expr]; ok {
+		expr = d
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.ErrInvalidCronSpec.GenWithStackByArgs(spec, "expected 5 fields")
+	}
+	s := &Schedule{
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}
+	var err error
+	for i, f := range []struct {
+		b      *bits
+		bounds bounds
+	}{
+		{&s.minute, minuteBounds},
+		{&s.hour, hourBounds},
+		{&s.dom, domBounds},
+		{&s.month, monthBounds},
+		{&s.dow, dowBounds},
+	} {
+		*f.b, err = parseField(fields[i], f.bounds)
+		if err != nil {
+			return nil, errors.ErrInvalidCronSpec.GenWithStackByArgs(spec, err.Error())
+		}
+	}
+	if s.dow.has(7) {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+func parseField(field string, b bounds) (bits, error) {
+	var ret bits
+	for _, part := range strings.Split(field, ",") {
+		rangeAndStep := strings.SplitN(part, "/", 2)
+		lo, hi := b.min, b.max
+		if rangeAndStep[0] != "*" {
+			loHi := strings.SplitN(rangeAndStep[0], "-", 2)
+			var err error
+			if lo, err = parseValue(loHi[0], b); err != nil {
+				return 0, err
+			}
+			switch {
+			case len(loHi) == 2:
+				if hi, err = parseValue(loHi[1], b); err != nil {
+					return 0, err
+				}
+			case len(rangeAndStep) == 1:
+				hi = lo
+			}
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("bad range %s", part)
+		}
+		step := 1
+		if len(rangeAndStep) == 2 {
+			var err error
+			step, err = strconv.Atoi(rangeAndStep[1])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("bad step %s", part)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			ret |= 1 << uint(v)
+		}
+	}
+	return ret, nil
+}
+
+func parseValue(s string, b bounds) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < b.min || v > b.max {
+		return 0, fmt.Errorf("bad value %s", s)
+	}
+	return v, nil
+}
+
+// Next returns the first time after t that matches the schedule, in the
+// location of t. The zero time is returned if no time matches.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	loc := t.Location()
+	yearLimit := t.Year() + maxSearchYears
+	for t.Year() <= yearLimit {
+		if !s.month.has(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.hour.has(t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !s.minute.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom.has(t.Day())
+	dowMatch := s.dow.has(int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
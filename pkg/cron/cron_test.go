@@ -0,0 +1,103 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every",
+	} {
+		_, err := Parse(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2022, 1, 14, 10, 30, 15, 0, time.UTC) // Friday
+	testCases := []struct {
+		spec string
+		next []time.Time
+	}{
+		{
+			spec: "* * * * *",
+			next: []time.Time{
+				time.Date(2022, 1, 14, 10, 31, 0, 0, time.UTC),
+				time.Date(2022, 1, 14, 10, 32, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "*/20 9-11 * * *",
+			next: []time.Time{
+				time.Date(2022, 1, 14, 10, 40, 0, 0, time.UTC),
+				time.Date(2022, 1, 14, 11, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 14, 11, 20, 0, 0, time.UTC),
+				time.Date(2022, 1, 14, 11, 40, 0, 0, time.UTC),
+				time.Date(2022, 1, 15, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "@daily",
+			next: []time.Time{
+				time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 16, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// Sunday is either 0 or 7
+			spec: "0 8 * * 1,7",
+			next: []time.Time{
+				time.Date(2022, 1, 16, 8, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 17, 8, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 23, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// either the day of month or the day of week matches
+			spec: "0 0 20 * 6",
+			next: []time.Time{
+				time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 22, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 0 29 2 *",
+			next: []time.Time{
+				time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 0 30 2 *",
+			next: []time.Time{{}},
+		},
+	}
+	for _, tc := range testCases {
+		s, err := Parse(tc.spec)
+		require.NoError(t, err, tc.spec)
+		cur := from
+		for _, expected := range tc.next {
+			cur = s.Next(cur)
+			require.Equal(t, expected, cur, tc.spec)
+		}
+	}
+}
@@ -26,7 +26,12 @@ var (
 	ErrClusterResourceNotEnough = errors.Normalize("cluster resource is not enough, please scale out the cluster", errors.RFCCodeText("DFLOW:ErrClusterResourceNotEnough"))
 	ErrBuildJobFailed           = errors.Normalize("build job failed", errors.RFCCodeText("DFLOW:ErrBuildJobFailed"))
 
+	ErrUnknownSchedulingStrategy = errors.Normalize("unknown scheduling strategy %s", errors.RFCCodeText("DFLOW:ErrUnknownSchedulingStrategy"))
+	ErrMessageNotDelivered       = errors.Normalize("message of topic %s is not delivered to node %s", errors.RFCCodeText("DFLOW:ErrMessageNotDelivered"))
+
 	ErrExecutorDupRegister   = errors.Normalize("executor %s has been registered", errors.RFCCodeText("DFLOW:ErrExecutorDupRegister"))
+	ErrIncompatibleVersion   = errors.Normalize("executor %s of protocol version %d is incompatible with server master of protocol version %d", errors.RFCCodeText("DFLOW:ErrIncompatibleVersion"))
+	ErrExecutorRegister      = errors.Normalize("failed to register executor: %s", errors.RFCCodeText("DFLOW:ErrExecutorRegister"))
 	ErrGrpcBuildConn         = errors.Normalize("dial grpc connection to %s failed", errors.RFCCodeText("DFLOW:ErrGrpcBuildConn"))
 	ErrDecodeEtcdKeyFail     = errors.Normalize("failed to decode etcd key: %s", errors.RFCCodeText("DFLOW:ErrDecodeEtcdKeyFail"))
 	ErrDecodeEtcdValueFail   = errors.Normalize("failed to decode etcd value: %s", errors.RFCCodeText("DFLOW:ErrDecodeEtcdValueFail"))
@@ -54,12 +59,22 @@ var (
 	ErrDuplicateWorkerID              = errors.Normalize("duplicate worker ID encountered: %s, report a bug", errors.RFCCodeText("DFLOW:ErrDuplicateWorkerID"))
 	ErrMasterClosed                   = errors.Normalize("master has been closed explicitly: master ID %s", errors.RFCCodeText("DFLOW:ErrMasterClosed"))
 	ErrMasterConcurrencyExceeded      = errors.Normalize("master has reached concurrency quota", errors.RFCCodeText("DFLOW:ErrMasterConcurrencyExceeded"))
+	ErrJobNotFound                    = errors.Normalize("job %s is not found", errors.RFCCodeText("DFLOW:ErrJobNotFound"))
+	ErrJobNameExists                  = errors.Normalize("job %s already exists", errors.RFCCodeText("DFLOW:ErrJobNameExists"))
+	ErrJobConfigConflict              = errors.Normalize("config of job %s has been updated concurrently", errors.RFCCodeText("DFLOW:ErrJobConfigConflict"))
+	ErrScheduleNotFound               = errors.Normalize("schedule %s is not found", errors.RFCCodeText("DFLOW:ErrScheduleNotFound"))
+	ErrScheduleNameExists             = errors.Normalize("schedule %s already exists", errors.RFCCodeText("DFLOW:ErrScheduleNameExists"))
+	ErrInvalidCronSpec                = errors.Normalize("invalid cron spec %s: %s", errors.RFCCodeText("DFLOW:ErrInvalidCronSpec"))
 
 	ErrWorkerTypeNotFound         = errors.Normalize("worker type is not found: type %d", errors.RFCCodeText("DFLOW:ErrWorkerTypeNotFound"))
 	ErrWorkerNotFound             = errors.Normalize("worker is not found: worker ID %s", errors.RFCCodeText("DFLOW:ErrWorkerNotFound"))
 	ErrWorkerOffline              = errors.Normalize("worker is offline: workerID %s", errors.RFCCodeText("DFLOW:ErrWorkerOffline"))
+	ErrWorkerPreempted            = errors.Normalize("worker is preempted: workerID %s, reason %s", errors.RFCCodeText("DFLOW:ErrWorkerPreempted"))
+	ErrWorkerMigrated             = errors.Normalize("worker is migrated: workerID %s, reason %s", errors.RFCCodeText("DFLOW:ErrWorkerMigrated"))
+	ErrWorkerNotMigratable        = errors.Normalize("worker can't be migrated: workerID %s, reason %s", errors.RFCCodeText("DFLOW:ErrWorkerNotMigratable"))
 	ErrWorkerTimedOut             = errors.Normalize("worker heartbeat timed out: workerID %s", errors.RFCCodeText("DFLOW:ErrWorkerTimedOut"))
 	ErrWorkerSuicide              = errors.Normalize("worker has committed suicide due to master having timed out", errors.RFCCodeText("DFLOW:ErrWorkerSuicide"))
+	ErrWorkerStopped              = errors.Normalize("worker has been stopped by its master: workerID %s", errors.RFCCodeText("DFLOW:ErrWorkerStopped"))
 	ErrWorkerNoMeta               = errors.Normalize("worker metadata does not exist", errors.RFCCodeText("DFLOW:ErrWorkerNoMeta"))
 	ErrWorkerUpdateStatusTryAgain = errors.Normalize("worker should try again in updating the status", errors.RFCCodeText("DFLOW:ErrWorkerStatusTryAgain"))
 	ErrInvalidJobType             = errors.Normalize("invalid job type: %s", errors.RFCCodeText("DFLOW:ErrInvalidJobType"))
@@ -93,4 +108,5 @@ var (
 	ErrMetaEmptyKey        = errors.Normalize("meta empty key", errors.RFCCodeText("DFLOW:ErrMetaEmptyKey"))
 	ErrMetaRevisionUnmatch = errors.Normalize("meta revision unmatch", errors.RFCCodeText("DFLOW:ErrMetaRevisionUnmatch"))
 	ErrMetaNestedTxn       = errors.Normalize("meta unsupported nested txn", errors.RFCCodeText("DFLOW:ErrMetaNestedTxn"))
+	ErrMetaEntryNotFound   = errors.Normalize("meta entry is not found: key %s", errors.RFCCodeText("DFLOW:ErrMetaEntryNotFound"))
 )
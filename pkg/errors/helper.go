@@ -45,6 +45,10 @@ func ToPBError(err error) *pb.Error {
 		pbErr.Code = pb.ErrorCode_SubJobBuildFailed
 	case ErrGrpcBuildConn.RFCCode():
 		pbErr.Code = pb.ErrorCode_BuildGrpcConnFailed
+	case ErrJobNameExists.RFCCode():
+		pbErr.Code = pb.ErrorCode_JobNameExists
+	case ErrIncompatibleVersion.RFCCode():
+		pbErr.Code = pb.ErrorCode_IncompatibleVersion
 	default:
 		pbErr.Code = pb.ErrorCode_UnknownError
 	}
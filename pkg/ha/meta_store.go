@@ -0,0 +1,55 @@
+package ha
+
+import (
+	"context"
+	"time"
+
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/hanfei1991/microcosm/pkg/metadata"
+	"go.etcd.io/etcd/clientv3"
+)
+
+// metaStoreTimeout limits how long an operation of the metaStore takes.
+const metaStoreTimeout = 5 * time.Second
+
+// NewMetaStore creates a HAStore backed by the metastore.
+func NewMetaStore(metaKV metadata.MetaKV) HAStore {
+	return &metaStore{metaKV: metaKV}
+}
+
+type metaStore struct {
+	metaKV metadata.MetaKV
+}
+
+func (s *metaStore) Put(k, v string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), metaStoreTimeout)
+	defer cancel()
+	if _, err := s.metaKV.Put(ctx, k, v); err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "put "+k)
+	}
+	return nil
+}
+
+func (s *metaStore) Del(k string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), metaStoreTimeout)
+	defer cancel()
+	if _, err := s.metaKV.Delete(ctx, k); err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "delete "+k)
+	}
+	return nil
+}
+
+func (s *metaStore) Get(k string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), metaStoreTimeout)
+	defer cancel()
+	raw, err := s.metaKV.Get(ctx, k)
+	if err != nil {
+		return "", errors.Wrap(errors.ErrMetaOpFail, err, "get "+k)
+	}
+	for _, kv := range raw.(*clientv3.GetResponse).Kvs {
+		if string(kv.Key) == k {
+			return string(kv.Value), nil
+		}
+	}
+	return "", errors.ErrMetaEntryNotFound.GenWithStackByArgs(k)
+}
@@ -0,0 +1,27 @@
+package ha
+
+import (
+	"testing"
+
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/hanfei1991/microcosm/pkg/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewMetaStore(metadata.NewMetaMock())
+	require.NoError(t, store.Put("/executor/1", "v1"))
+	require.NoError(t, store.Put("/executor/10", "v10"))
+	v, err := store.Get("/executor/1")
+	require.NoError(t, err)
+	require.Equal(t, "v1", v)
+
+	require.NoError(t, store.Del("/executor/1"))
+	_, err = store.Get("/executor/1")
+	require.True(t, errors.ErrMetaEntryNotFound.Equal(err))
+	v, err = store.Get("/executor/10")
+	require.NoError(t, err)
+	require.Equal(t, "v10", v)
+}
@@ -17,16 +17,23 @@ var (
 )
 
 type Txn struct {
-	m   *MetaMock
-	ops []clientv3.Op
+	m       *MetaMock
+	cmps    []clientv3.Cmp
+	ops     []clientv3.Op
+	elseOps []clientv3.Op
 }
 
+// If only supports comparing the version or create revision of a key with 0,
+// which checks whether the key exists, and checking whether the value or the
+// mod revision of a key equals to the given one.
 func (t *Txn) If(cs ...clientv3.Cmp) clientv3.Txn {
-	panic("unimplemented")
+	t.cmps = append(t.cmps, cs...)
+	return t
 }
 
-func (t *Txn) Else(cs ...clientv3.Op) clientv3.Txn {
-	panic("unimplemented")
+func (t *Txn) Else(ops ...clientv3.Op) clientv3.Txn {
+	t.elseOps = append(t.elseOps, ops...)
+	return t
 }
 
 func (t *Txn) Then(ops ...clientv3.Op) clientv3.Txn {
@@ -35,32 +42,63 @@ func (t *Txn) Then(ops ...clientv3.Op) clientv3.Txn {
 }
 
 func (t *Txn) Commit() (*clientv3.TxnResponse, error) {
-	for _, op := range t.ops {
-		_, err := t.m.Put(context.Background(), string(op.KeyBytes()), string(op.ValueBytes()))
-		if err != nil {
-			return nil, err
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	succeeded := true
+	for _, cmp := range t.cmps {
+		if !t.m.compare(cmp) {
+			succeeded = false
+			break
 		}
 	}
-	return nil, nil
+	ops := t.ops
+	if !succeeded {
+		ops = t.elseOps
+	}
+	for _, op := range ops {
+		switch {
+		case op.IsPut():
+			t.m.put(string(op.KeyBytes()), string(op.ValueBytes()))
+		case op.IsDelete():
+			t.m.delete(string(op.KeyBytes()))
+		default:
+			panic("unimplemented")
+		}
+	}
+	return &clientv3.TxnResponse{Succeeded: succeeded}, nil
 }
 
 type MetaMock struct {
 	sync.Mutex
-	store    map[string]string
-	revision int64
+	store        map[string]string
+	modRevisions map[string]int64
+	revision     int64
 }
 
 func NewMetaMock() *MetaMock {
 	return &MetaMock{
-		store: make(map[string]string),
+		store:        make(map[string]string),
+		modRevisions: make(map[string]int64),
 	}
 }
 
+func (m *MetaMock) put(key, value string) {
+	m.revision++
+	m.store[key] = value
+	m.modRevisions[key] = m.revision
+}
+
+func (m *MetaMock) delete(key string) {
+	m.revision++
+	delete(m.store, key)
+	delete(m.modRevisions, key)
+}
+
 func (m *MetaMock) Delete(ctx context.Context, key string, opts ...interface{}) (interface{}, error) {
 	m.Lock()
 	defer m.Unlock()
-	delete(m.store, key)
-	m.revision++
+	m.delete(key)
 	return nil, nil
 }
 
@@ -71,8 +109,7 @@ func (m *MetaMock) Watch(ctx context.Context, key string, opts ...interface{}) i
 func (m *MetaMock) Put(ctx context.Context, key, value string, opts ...interface{}) (interface{}, error) {
 	m.Lock()
 	defer m.Unlock()
-	m.store[key] = value
-	m.revision++
+	m.put(key, value)
 	return nil, nil
 }
 
@@ -89,14 +126,51 @@ func (m *MetaMock) Get(ctx context.Context, key string, opts ...interface{}) (in
 			continue
 		}
 		ret.Kvs = append(ret.Kvs, &mvccpb.KeyValue{
-			Key:   []byte(k),
-			Value: []byte(v),
+			Key:         []byte(k),
+			Value:       []byte(v),
+			ModRevision: m.modRevisions[k],
 		})
 	}
 	m.revision++
 	return ret, nil
 }
 
+func (m *MetaMock) compare(cmp clientv3.Cmp) bool {
+	var target int64
+	switch cmp.Target {
+	case etcdserverpb.Compare_VALUE:
+		if cmp.Result != etcdserverpb.Compare_EQUAL {
+			panic("unimplemented")
+		}
+		value, exists := m.store[string(cmp.Key)]
+		return exists && value == string(cmp.TargetUnion.(*etcdserverpb.Compare_Value).Value)
+	case etcdserverpb.Compare_MOD:
+		if cmp.Result != etcdserverpb.Compare_EQUAL {
+			panic("unimplemented")
+		}
+		// the mod revision of a key that doesn't exist is 0
+		return m.modRevisions[string(cmp.Key)] == cmp.TargetUnion.(*etcdserverpb.Compare_ModRevision).ModRevision
+	case etcdserverpb.Compare_VERSION:
+		target = cmp.TargetUnion.(*etcdserverpb.Compare_Version).Version
+	case etcdserverpb.Compare_CREATE:
+		target = cmp.TargetUnion.(*etcdserverpb.Compare_CreateRevision).CreateRevision
+	default:
+		panic("unimplemented")
+	}
+	if target != 0 {
+		panic("unimplemented")
+	}
+	_, exists := m.store[string(cmp.Key)]
+	switch cmp.Result {
+	case etcdserverpb.Compare_EQUAL:
+		return !exists
+	case etcdserverpb.Compare_NOT_EQUAL, etcdserverpb.Compare_GREATER:
+		return exists
+	default:
+		panic("unimplemented")
+	}
+}
+
 func (m *MetaMock) Txn(ctx context.Context) interface{} {
 	return &Txn{
 		m: m,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hanfei1991/microcosm/pkg/version"
 	"github.com/pingcap/errors"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	p2pImpl "github.com/pingcap/tiflow/pkg/p2p"
@@ -51,13 +52,13 @@ var defaultClientConfig = &p2pImpl.MessageClientConfig{
 	BatchSendInterval:       100 * time.Millisecond, // essentially disables flushing
 	MaxBatchBytes:           8 * 1024 * 1024,        // 8MB
 	MaxBatchCount:           4096,
-	RetryRateLimitPerSecond: 1.0,      // once per second
-	ClientVersion:           "v5.4.0", // a fake version
+	RetryRateLimitPerSecond: 1.0, // once per second
 }
 
 func NewMessageRouter(nodeID NodeID, advertisedAddr string) MessageRouter {
 	config := *defaultClientConfig // copy
 	config.AdvertisedAddr = advertisedAddr
+	config.ClientVersion = version.ReleaseSemver()
 	return p2pImpl.NewMessageRouter(
 		nodeID,
 		&security.Credential{ /* TLS not supported for now */ },
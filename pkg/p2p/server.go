@@ -5,6 +5,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/hanfei1991/microcosm/pkg/version"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	p2pImpl "github.com/pingcap/tiflow/pkg/p2p"
@@ -41,6 +42,9 @@ var defaultServerConfig = Config{
 	WorkerPoolSize:                       4,
 	MaxPeerCount:                         1024,
 	WaitUnregisterHandleTimeoutThreshold: time.Millisecond * 100,
+	// The streams from the clients of other major or minor versions are
+	// rejected.
+	ServerVersion: version.ReleaseSemver(),
 }
 
 // MessageRPCService is a background service wrapping a MessageServer instance.
@@ -9,11 +9,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hanfei1991/microcosm/pkg/version"
 	"github.com/phayes/freeport"
 	p2pImpl "github.com/pingcap/tiflow/pkg/p2p"
 	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/proto/p2p"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
+	"google.golang.org/grpc"
 )
 
 func makeListenerForServerTests(t *testing.T) (l net.Listener, addr string) {
@@ -31,7 +34,7 @@ var clientConfigForUnitTesting = &p2pImpl.MessageClientConfig{
 	MaxBatchBytes:           math.MaxInt64,
 	MaxBatchCount:           math.MaxInt64,
 	RetryRateLimitPerSecond: 999.0,
-	ClientVersion:           "v5.4.0", // a fake version
+	ClientVersion:           version.ReleaseSemver(),
 	AdvertisedAddr:          "fake-addr:8300",
 }
 
@@ -81,3 +84,44 @@ func TestMessageRPCServiceBasics(t *testing.T) {
 	cancel()
 	wg.Wait()
 }
+
+func TestMessageRPCServiceVersionIncompatible(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	l, addr := makeListenerForServerTests(t)
+	messageSrvc, err := NewMessageRPCService("test-node-1", &security.Credential{} /* no TLS */)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := messageSrvc.Serve(ctx, l)
+		require.Error(t, err)
+		require.Regexp(t, ".*canceled.*", err.Error())
+	}()
+
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+	stream, err := p2p.NewCDCPeerToPeerClient(conn).SendMessage(ctx)
+	require.NoError(t, err)
+
+	// The stream from a client of another major version is rejected.
+	err = stream.Send(&p2p.MessagePacket{
+		Meta: &p2p.StreamMeta{
+			SenderId:      "test-client-1",
+			ReceiverId:    "test-node-1",
+			Epoch:         0,
+			ClientVersion: "1000.0.0",
+		},
+	})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Regexp(t, ".*incompatible.*", resp.String())
+
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,19 @@
+package version
+
+import "strings"
+
+// ReleaseVersion is the version of the binaries, it's overridden by ldflags
+// when released.
+var ReleaseVersion = "v0.1.0"
+
+// ProtocolVersion is the version of the RPCs and the messages between the
+// server master, the executors and the workers. It's increased on every
+// incompatible change of them, the server master checks it when an executor
+// registers.
+const ProtocolVersion int32 = 1
+
+// ReleaseSemver returns ReleaseVersion without the leading "v", which is a
+// valid semantic version.
+func ReleaseSemver() string {
+	return strings.TrimPrefix(ReleaseVersion, "v")
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/hanfei1991/microcosm/pkg/etcdutils"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"go.etcd.io/etcd/embed"
 	"go.uber.org/zap"
@@ -40,6 +41,11 @@ const (
 	defaultCampaignTimeout    = 5 * time.Second
 	defaultDiscoverTicker     = 3 * time.Second
 
+	defaultRebalanceInterval      = "30s"
+	defaultRebalanceThreshold     = 0.2
+	defaultMaxMigrationsPerRound  = 2
+	defaultMaxMigrationsPerMinute = 10
+
 	defaultPeerUrls            = "http://127.0.0.1:8291"
 	defaultInitialClusterState = embed.ClusterStateFlagNew
 )
@@ -69,6 +75,9 @@ func NewConfig() *Config {
 	fs.StringVar(&cfg.LogLevel, "L", "info", "log level: debug, info, warn, error, fatal")
 	fs.StringVar(&cfg.LogFile, "log-file", "", "log file path")
 	fs.StringVar(&cfg.LogFormat, "log-format", "text", `the format of the log, "text" or "json"`)
+	fs.StringVar(&cfg.SchedulingStrategy, "scheduling-strategy", "", `default scheduling strategy, "spread", "bin-pack" or "random" (default "spread")`)
+	fs.BoolVar(&cfg.Rebalance.Enable, "enable-rebalance", false, "move workers from hot executors to cold ones in background")
+	fs.BoolVar(&cfg.Rebalance.DryRun, "rebalance-dry-run", false, "log the workers to be moved for rebalancing without moving them")
 	// fs.StringVar(&cfg.LogRotate, "log-rotate", "day", "log file rotate type, hour/day")
 
 	fs.StringVar(&cfg.Etcd.Name, "name", "", "human-readable name for this DM-master member")
@@ -103,6 +112,12 @@ type Config struct {
 	KeepAliveIntervalStr string `toml:"keepalive-interval" json:"keepalive-interval"`
 	RPCTimeoutStr        string `toml:"rpc-timeout" json:"rpc-timeout"`
 
+	// SchedulingStrategy is the default scheduling strategy of the cluster,
+	// which is one of "spread", "bin-pack" and "random".
+	SchedulingStrategy string `toml:"scheduling-strategy" json:"scheduling-strategy"`
+
+	Rebalance RebalanceConfig `toml:"rebalance" json:"rebalance"`
+
 	KeepAliveTTL      time.Duration `toml:"-" json:"-"`
 	KeepAliveInterval time.Duration `toml:"-" json:"-"`
 	RPCTimeout        time.Duration `toml:"-" json:"-"`
@@ -111,6 +126,51 @@ type Config struct {
 	printSampleConfig bool
 }
 
+// RebalanceConfig controls how the workers are moved from the executors of
+// high cpu utilization to the ones of low utilization in background.
+type RebalanceConfig struct {
+	Enable      bool   `toml:"enable" json:"enable"`
+	IntervalStr string `toml:"interval" json:"interval"`
+	// Threshold is the difference of cpu utilization between the hottest
+	// and the coldest executors, above which the workers are moved.
+	Threshold float64 `toml:"threshold" json:"threshold"`
+	// MaxMigrationsPerRound and MaxMigrationsPerMinute limit how fast the
+	// workers are moved.
+	MaxMigrationsPerRound  int `toml:"max-migrations-per-round" json:"max-migrations-per-round"`
+	MaxMigrationsPerMinute int `toml:"max-migrations-per-minute" json:"max-migrations-per-minute"`
+	// DryRun logs the workers to be moved without moving them.
+	DryRun bool `toml:"dry-run" json:"dry-run"`
+
+	Interval time.Duration `toml:"-" json:"-"`
+}
+
+func (c *RebalanceConfig) adjust() (err error) {
+	if c.IntervalStr == "" {
+		c.IntervalStr = defaultRebalanceInterval
+	}
+	c.Interval, err = time.ParseDuration(c.IntervalStr)
+	if err != nil {
+		return err
+	}
+	// time.NewTicker panics on a non-positive interval.
+	if c.Interval <= 0 {
+		c.IntervalStr = defaultRebalanceInterval
+		if c.Interval, err = time.ParseDuration(c.IntervalStr); err != nil {
+			return err
+		}
+	}
+	if c.Threshold <= 0 {
+		c.Threshold = defaultRebalanceThreshold
+	}
+	if c.MaxMigrationsPerRound <= 0 {
+		c.MaxMigrationsPerRound = defaultMaxMigrationsPerRound
+	}
+	if c.MaxMigrationsPerMinute <= 0 {
+		c.MaxMigrationsPerMinute = defaultMaxMigrationsPerMinute
+	}
+	return nil
+}
+
 func (c *Config) String() string {
 	cfg, err := json.Marshal(c)
 	if err != nil {
@@ -203,7 +263,16 @@ func (c *Config) adjust() (err error) {
 	if err != nil {
 		return err
 	}
-	return nil
+
+	if err = c.Rebalance.adjust(); err != nil {
+		return err
+	}
+
+	if c.SchedulingStrategy == "" {
+		c.SchedulingStrategy = resource.DefaultStrategy
+	}
+	_, err = resource.NewSchedulingStrategy(c.SchedulingStrategy)
+	return err
 }
 
 // configFromFile loads config from file.
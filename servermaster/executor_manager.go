@@ -2,11 +2,13 @@ package servermaster
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 	"time"
 
 	"github.com/hanfei1991/microcosm/model"
 	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/adapter"
 	"github.com/hanfei1991/microcosm/pkg/autoid"
 	"github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/hanfei1991/microcosm/pkg/ha"
@@ -21,8 +23,17 @@ import (
 type ExecutorManager interface {
 	HandleHeartbeat(req *pb.HeartbeatRequest) (*pb.HeartbeatResponse, error)
 	Allocate(tasks []*pb.ScheduleTask) (bool, *pb.TaskSchedulerResponse)
+	AllocateGang(ctx context.Context, tasks []*pb.ScheduleTask, wait time.Duration) (bool, *pb.TaskSchedulerResponse)
+	ReleaseResource(workerIDs []string)
+	UpdateExecutorWorkload(id model.ExecutorID, workloads map[model.WorkloadType]model.RescUnit) error
+	Preempt(tasks []*pb.ScheduleTask) ([]*resource.Victim, bool)
+	CordonExecutor(id model.ExecutorID, cordoned bool) error
+	DrainExecutor(id model.ExecutorID) ([]string, []*resource.Victim, error)
+	Rebalance(opts resource.RebalanceOptions) []*resource.Migration
+	QueryExecutors(ids []model.ExecutorID) ([]*resource.ExecutorResource, error)
 	AllocateNewExec(req *pb.RegisterExecutorRequest) (*model.NodeInfo, error)
-	RegisterExec(info *model.NodeInfo)
+	RegisterExec(info *model.NodeInfo) error
+	SetHAStore(store ha.HAStore)
 	Start(ctx context.Context)
 }
 
@@ -37,21 +48,27 @@ type ExecutorManagerImpl struct {
 	initHeartbeatTTL  time.Duration
 	keepAliveInterval time.Duration
 
-	// TODO: complete ha store.
-	haStore ha.HAStore // nolint:structcheck,unused
+	// haStore persists the executors, so that a new leader knows which of
+	// them are alive after failover. It's nil before the leader service
+	// starts.
+	haStore ha.HAStore
 
 	rescMgr resource.RescMgr
 	logRL   *rate.Limiter
 }
 
-func NewExecutorManagerImpl(initHeartbeatTTL, keepAliveInterval time.Duration, ctx *test.Context) *ExecutorManagerImpl {
+func NewExecutorManagerImpl(
+	initHeartbeatTTL, keepAliveInterval time.Duration,
+	strategy resource.SchedulingStrategy,
+	ctx *test.Context,
+) *ExecutorManagerImpl {
 	return &ExecutorManagerImpl{
 		testContext:       ctx,
 		executors:         make(map[model.ExecutorID]*Executor),
 		idAllocator:       autoid.NewUUIDAllocator(),
 		initHeartbeatTTL:  initHeartbeatTTL,
 		keepAliveInterval: keepAliveInterval,
-		rescMgr:           resource.NewCapRescMgr(),
+		rescMgr:           resource.NewCapRescMgr(strategy),
 		logRL:             rate.NewLimiter(rate.Every(time.Second*5), 1 /*burst*/),
 	}
 }
@@ -67,10 +84,11 @@ func (e *ExecutorManagerImpl) removeExecutorImpl(id model.ExecutorID) error {
 	}
 	delete(e.executors, id)
 	e.rescMgr.Unregister(id)
-	//err := e.haStore.Del(exec.EtcdKey())
-	//if err != nil {
-	//	return err
-	//}
+	if e.haStore != nil {
+		if err := e.haStore.Del(executorKey(id)); err != nil {
+			return err
+		}
+	}
 	log.L().Logger.Info("notify to offline exec")
 	if test.GetGlobalTestFlag() {
 		e.testContext.NotifyExecutorChange(&test.ExecutorChangeEvent{
@@ -107,9 +125,9 @@ func (e *ExecutorManagerImpl) HandleHeartbeat(req *pb.HeartbeatRequest) (*pb.Hea
 	exec.lastUpdateTime = time.Now()
 	exec.heartbeatTTL = time.Duration(req.Ttl) * time.Millisecond
 	exec.Status = model.ExecutorStatus(req.Status)
-	usage := model.RescUnit(req.GetResourceUsage())
-	// TODO: update reserve resources by heartbeats.
-	err := e.rescMgr.Update(exec.ID, usage, usage, exec.Status)
+	// The heartbeat only carries the cpu usage.
+	usage := model.Resource{CPU: model.RescUnit(req.GetResourceUsage())}
+	err := e.rescMgr.Update(exec.ID, usage, runningWorkers(req), exec.Status)
 	if err != nil {
 		return nil, err
 	}
@@ -117,9 +135,31 @@ func (e *ExecutorManagerImpl) HandleHeartbeat(req *pb.HeartbeatRequest) (*pb.Hea
 	return resp, nil
 }
 
-// RegisterExec registers executor to both executor manager and resource manager
-func (e *ExecutorManagerImpl) RegisterExec(info *model.NodeInfo) {
+// runningWorkers returns the workers reported in the heartbeat. The workers
+// that are only reported by IDs, by executors of older versions, carry no
+// resource.
+func runningWorkers(req *pb.HeartbeatRequest) []*pb.RunningWorker {
+	workers := append([]*pb.RunningWorker(nil), req.GetWorkers()...)
+	reported := make(map[string]struct{}, len(workers))
+	for _, worker := range workers {
+		reported[worker.GetWorkerId()] = struct{}{}
+	}
+	for _, workerID := range req.GetWorkerIds() {
+		if _, ok := reported[workerID]; !ok {
+			workers = append(workers, &pb.RunningWorker{WorkerId: workerID})
+		}
+	}
+	return workers
+}
+
+// RegisterExec registers executor to both executor manager and resource manager,
+// and persists it in the HAStore. The executor is rejected if its protocol
+// version is incompatible.
+func (e *ExecutorManagerImpl) RegisterExec(info *model.NodeInfo) error {
 	log.L().Info("register executor", zap.Any("info", info))
+	if err := checkExecutorVersion(info); err != nil {
+		return err
+	}
 	exec := &Executor{
 		NodeInfo:       *info,
 		lastUpdateTime: time.Now(),
@@ -127,10 +167,40 @@ func (e *ExecutorManagerImpl) RegisterExec(info *model.NodeInfo) {
 		Status:         model.Initing,
 		logRL:          rate.NewLimiter(rate.Every(time.Second*5), 1 /*burst*/),
 	}
+	if err := persistExecutor(e.getHAStore(), info, model.Initing); err != nil {
+		return err
+	}
 	e.mu.Lock()
 	e.executors[info.ID] = exec
 	e.mu.Unlock()
-	e.rescMgr.Register(exec.ID, exec.Addr, model.RescUnit(exec.Capability))
+	e.rescMgr.Register(exec.ID, exec.Addr, exec.Capability, exec.Labels, exec.WorkerTypes)
+	return nil
+}
+
+// SetHAStore sets the HAStore that the executors are persisted in.
+func (e *ExecutorManagerImpl) SetHAStore(store ha.HAStore) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.haStore = store
+}
+
+func (e *ExecutorManagerImpl) getHAStore() ha.HAStore {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.haStore
+}
+
+// persistExecutor writes the executor and its status to the HAStore, it's
+// skipped if the store is nil.
+func persistExecutor(store ha.HAStore, info *model.NodeInfo, status model.ExecutorStatus) error {
+	if store == nil {
+		return nil
+	}
+	value, err := json.Marshal(&executorRecord{NodeInfo: *info, Status: status})
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "marshal executor")
+	}
+	return store.Put(executorKey(info.ID), string(value))
 }
 
 // AllocateNewExec allocates new executor info to a give RegisterExecutorRequest
@@ -140,9 +210,13 @@ func (e *ExecutorManagerImpl) AllocateNewExec(req *pb.RegisterExecutorRequest) (
 
 	e.mu.Lock()
 	info := &model.NodeInfo{
-		ID:         model.ExecutorID(e.idAllocator.AllocID()),
-		Addr:       req.Address,
-		Capability: int(req.Capability),
+		ID:              model.ExecutorID(e.idAllocator.AllocID()),
+		Addr:            req.Address,
+		Capability:      model.NewResourceFromPB(req.ResourceCapacity, req.Capability),
+		Labels:          req.Labels,
+		Version:         req.Version,
+		ProtocolVersion: req.ProtocolVersion,
+		WorkerTypes:     req.WorkerTypes,
 	}
 	if _, ok := e.executors[info.ID]; ok {
 		e.mu.Unlock()
@@ -150,7 +224,9 @@ func (e *ExecutorManagerImpl) AllocateNewExec(req *pb.RegisterExecutorRequest) (
 	}
 	e.mu.Unlock()
 
-	e.RegisterExec(info)
+	if err := e.RegisterExec(info); err != nil {
+		return nil, err
+	}
 	return info, nil
 }
 
@@ -158,6 +234,63 @@ func (e *ExecutorManagerImpl) Allocate(tasks []*pb.ScheduleTask) (bool, *pb.Task
 	return e.rescMgr.Allocate(tasks)
 }
 
+func (e *ExecutorManagerImpl) AllocateGang(
+	ctx context.Context, tasks []*pb.ScheduleTask, wait time.Duration,
+) (bool, *pb.TaskSchedulerResponse) {
+	return e.rescMgr.AllocateGang(ctx, tasks, wait)
+}
+
+// ReleaseResource releases the resource reserved for the workers.
+func (e *ExecutorManagerImpl) ReleaseResource(workerIDs []string) {
+	e.rescMgr.Release(workerIDs)
+}
+
+// UpdateExecutorWorkload updates the resource usage of the workloads in the
+// executor, it's taken into account in scheduling.
+func (e *ExecutorManagerImpl) UpdateExecutorWorkload(
+	id model.ExecutorID, workloads map[model.WorkloadType]model.RescUnit,
+) error {
+	return e.rescMgr.UpdateWorkload(id, workloads)
+}
+
+// Preempt chooses the workers to be preempted for the tasks.
+func (e *ExecutorManagerImpl) Preempt(tasks []*pb.ScheduleTask) ([]*resource.Victim, bool) {
+	return e.rescMgr.Preempt(tasks)
+}
+
+// CordonExecutor stops scheduling new workers to the executor, or resumes it.
+func (e *ExecutorManagerImpl) CordonExecutor(id model.ExecutorID, cordoned bool) error {
+	return e.rescMgr.Cordon(id, cordoned)
+}
+
+// DrainExecutor cordons the executor, and returns the workers still in it and
+// the ones to be moved elsewhere.
+func (e *ExecutorManagerImpl) DrainExecutor(id model.ExecutorID) ([]string, []*resource.Victim, error) {
+	return e.rescMgr.Drain(id)
+}
+
+// Rebalance chooses the workers to be moved from the hot executors to the
+// cold ones.
+func (e *ExecutorManagerImpl) Rebalance(opts resource.RebalanceOptions) []*resource.Migration {
+	return e.rescMgr.Rebalance(opts)
+}
+
+// QueryExecutors returns the resource of the executors, or all of them if
+// ids is empty.
+func (e *ExecutorManagerImpl) QueryExecutors(ids []model.ExecutorID) ([]*resource.ExecutorResource, error) {
+	return e.rescMgr.Executors(ids)
+}
+
+// executorRecord is an executor persisted in the HAStore.
+type executorRecord struct {
+	model.NodeInfo
+	Status model.ExecutorStatus `json:"status"`
+}
+
+func executorKey(id model.ExecutorID) string {
+	return adapter.ExecutorKeyAdapter.Encode(string(id))
+}
+
 // Executor records the status of an executor instance.
 type Executor struct {
 	model.NodeInfo
@@ -215,7 +348,15 @@ func (e *ExecutorManagerImpl) checkAliveImpl() error {
 	e.mu.Lock()
 	for id, exec := range e.executors {
 		if !exec.checkAlive() {
+			// The executor is marked as tombstone before it's removed, so
+			// that a new leader doesn't take it as alive even if it fails to
+			// be removed from the HAStore.
+			store := e.haStore
 			e.mu.Unlock()
+			if err := persistExecutor(store, &exec.NodeInfo, model.Tombstone); err != nil {
+				log.L().Warn("failed to mark executor as tombstone",
+					zap.String("id", string(id)), zap.Error(err))
+			}
 			err := e.removeExecutorImpl(id)
 			return err
 		}
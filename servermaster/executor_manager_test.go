@@ -2,11 +2,17 @@ package servermaster
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/hanfei1991/microcosm/model"
 	"github.com/hanfei1991/microcosm/pb"
+	derror "github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/hanfei1991/microcosm/pkg/ha"
+	"github.com/hanfei1991/microcosm/pkg/version"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,13 +23,16 @@ func TestExecutorManager(t *testing.T) {
 	defer cancel()
 	heartbeatTTL := time.Millisecond * 100
 	checkInterval := time.Millisecond * 10
-	mgr := NewExecutorManagerImpl(heartbeatTTL, checkInterval, nil)
+	strategy, err := resource.NewSchedulingStrategy(resource.DefaultStrategy)
+	require.Nil(t, err)
+	mgr := NewExecutorManagerImpl(heartbeatTTL, checkInterval, strategy, nil)
 
 	// register an executor server
 	executorAddr := "127.0.0.1:10001"
 	registerReq := &pb.RegisterExecutorRequest{
-		Address:    executorAddr,
-		Capability: 2,
+		Address:         executorAddr,
+		Capability:      2,
+		ProtocolVersion: version.ProtocolVersion,
 	}
 	info, err := mgr.AllocateNewExec(registerReq)
 	require.Nil(t, err)
@@ -78,3 +87,82 @@ func TestExecutorManager(t *testing.T) {
 	require.NotNil(t, resp.Err)
 	require.Equal(t, pb.ErrorCode_UnknownExecutor, resp.Err.GetCode())
 }
+
+// failDelStore is a HAStore that fails to delete keys.
+type failDelStore struct {
+	ha.HAStore
+}
+
+func (s *failDelStore) Del(string) error {
+	return errors.New("injected error")
+}
+
+func TestExecutorManagerHAStore(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	strategy, err := resource.NewSchedulingStrategy(resource.DefaultStrategy)
+	require.Nil(t, err)
+	mgr := NewExecutorManagerImpl(time.Millisecond*100, time.Millisecond*10, strategy, nil)
+	store := ha.NewMockStore()
+	mgr.SetHAStore(store)
+
+	loadRecord := func(id model.ExecutorID) (*executorRecord, error) {
+		value, err := store.Get(executorKey(id))
+		if err != nil {
+			return nil, err
+		}
+		record := &executorRecord{}
+		require.NoError(t, json.Unmarshal([]byte(value), record))
+		return record, nil
+	}
+
+	// the executor is persisted on register, and deleted after it's removed
+	info, err := mgr.AllocateNewExec(&pb.RegisterExecutorRequest{Address: "127.0.0.1:10001", Capability: 2, ProtocolVersion: version.ProtocolVersion})
+	require.NoError(t, err)
+	record, err := loadRecord(info.ID)
+	require.NoError(t, err)
+	require.Equal(t, *info, record.NodeInfo)
+	require.Equal(t, model.Initing, record.Status)
+	mgr.Start(ctx)
+	require.Eventually(t, func() bool {
+		_, err := loadRecord(info.ID)
+		return err != nil
+	}, time.Second*2, time.Millisecond*50)
+
+	// the executor is left as tombstone if it fails to be deleted
+	mgr.SetHAStore(&failDelStore{HAStore: store})
+	info, err = mgr.AllocateNewExec(&pb.RegisterExecutorRequest{Address: "127.0.0.1:10002", Capability: 2, ProtocolVersion: version.ProtocolVersion})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		record, err := loadRecord(info.ID)
+		return err == nil && record.Status == model.Tombstone
+	}, time.Second*2, time.Millisecond*50)
+}
+
+func TestExecutorManagerIncompatibleVersion(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := resource.NewSchedulingStrategy(resource.DefaultStrategy)
+	require.Nil(t, err)
+	mgr := NewExecutorManagerImpl(time.Millisecond*100, time.Millisecond*10, strategy, nil)
+
+	// an executor that doesn't report its protocol version is rejected
+	_, err = mgr.AllocateNewExec(&pb.RegisterExecutorRequest{Address: "127.0.0.1:10001", Capability: 2, Version: "v0.0.1"})
+	require.True(t, derror.ErrIncompatibleVersion.Equal(err))
+	mgr.mu.Lock()
+	require.Equal(t, 0, len(mgr.executors))
+	mgr.mu.Unlock()
+
+	info, err := mgr.AllocateNewExec(&pb.RegisterExecutorRequest{
+		Address:         "127.0.0.1:10002",
+		Capability:      2,
+		Version:         version.ReleaseVersion,
+		ProtocolVersion: version.ProtocolVersion,
+		WorkerTypes:     []int64{1, 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, version.ReleaseVersion, info.Version)
+	require.Equal(t, []int64{1, 2}, info.WorkerTypes)
+}
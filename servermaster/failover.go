@@ -6,19 +6,23 @@ import (
 
 	"github.com/hanfei1991/microcosm/model"
 	"github.com/hanfei1991/microcosm/pkg/adapter"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/pingcap/tiflow/dm/pkg/log"
 	"go.etcd.io/etcd/clientv3"
+	"go.uber.org/zap"
 )
 
-// resetExecutor loads existing executor information from meta storage
+// resetExecutor loads the executors persisted by the previous leader from
+// meta storage, the ones that have been marked as tombstone are dropped.
 // TODO: to make concurrent happens before semantic more accurate, we may introduce
 // some mechanisms such as cdc etcd_worker.
 func (s *Server) resetExecutor(ctx context.Context) error {
-	resp, err := s.etcdClient.Get(ctx, adapter.NodeInfoKeyAdapter.Path(), clientv3.WithPrefix())
+	resp, err := s.etcdClient.Get(ctx, adapter.ExecutorKeyAdapter.Path(), clientv3.WithPrefix())
 	if err != nil {
-		return err
+		return errors.Wrap(errors.ErrEtcdAPIError, err)
 	}
 	for _, kv := range resp.Kvs {
-		err := s.resetExecHandler(kv.Value)
+		err := s.resetExecHandler(ctx, kv.Key, kv.Value)
 		if err != nil {
 			return err
 		}
@@ -27,14 +31,30 @@ func (s *Server) resetExecutor(ctx context.Context) error {
 }
 
 // resetExecHandle unmarshals executor info and resets related information
-func (s *Server) resetExecHandler(value []byte) error {
-	info := &model.NodeInfo{}
-	err := json.Unmarshal(value, info)
+func (s *Server) resetExecHandler(ctx context.Context, key, value []byte) error {
+	record := &executorRecord{}
+	err := json.Unmarshal(value, record)
 	if err != nil {
 		return err
 	}
-	if info.Type == model.NodeTypeExecutor {
-		s.executorManager.RegisterExec(info)
+	if record.Status == model.Tombstone {
+		log.L().Info("drop tombstone executor", zap.String("id", string(record.ID)))
+		return s.dropExecutorRecord(ctx, key)
+	}
+	err = s.executorManager.RegisterExec(&record.NodeInfo)
+	// The executor registered with a server master of another version, which
+	// happens in rolling upgrades.
+	if errors.ErrIncompatibleVersion.Equal(err) {
+		log.L().Warn("drop incompatible executor",
+			zap.String("id", string(record.ID)), zap.Error(err))
+		return s.dropExecutorRecord(ctx, key)
+	}
+	return err
+}
+
+func (s *Server) dropExecutorRecord(ctx context.Context, key []byte) error {
+	if _, err := s.etcdClient.Delete(ctx, string(key)); err != nil {
+		return errors.Wrap(errors.ErrEtcdAPIError, err)
 	}
 	return nil
 }
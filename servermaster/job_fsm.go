@@ -1,9 +1,13 @@
 package servermaster
 
 import (
+	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/pkg/clock"
 	"github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"go.uber.org/zap"
@@ -12,11 +16,73 @@ import (
 type jobHolder struct {
 	lib.WorkerHandle
 	*lib.MasterMetaExt
+
+	// failures are the failures of the job master, they are filled only in
+	// the jobHolder returned by QueryJob and IterJobs.
+	failures []jobFailure
+	// endTime and errMsg are set only if the job is terminated.
+	endTime time.Time
+	errMsg  string
+	// queuePosition is the 1-based position of the job in the admission
+	// queue, it is set only if the job is queued.
+	queuePosition int
+}
+
+// jobFailure records why a job master failed.
+type jobFailure struct {
+	Time   time.Time `json:"time"`
+	Reason string    `json:"reason"`
+}
+
+// terminatedJob is a job in a terminal state, it is kept in JobFsm until it
+// is archived by the JobManager.
+type terminatedJob struct {
+	*lib.MasterMetaExt
+	state    JobState
+	endTime  time.Time
+	errMsg   string
+	failures []jobFailure
+}
+
+// blockedJob is a job waiting for its upstream jobs to finish.
+type blockedJob struct {
+	*lib.MasterMetaExt
+	// waiting are the upstream jobs that are not finished yet.
+	waiting map[lib.MasterID]struct{}
 }
 
+// jobRestart records the failures of a job master, and the time after which
+// the job master can be created again.
+type jobRestart struct {
+	failures    []jobFailure
+	nextAttempt time.Time
+}
+
+// JobState is the state of a job in JobFsm.
+type JobState int
+
+const (
+	JobStatePending = JobState(iota + 1)
+	JobStateWaitAck
+	JobStateOnline
+	JobStateCanceling
+	// terminal states
+	JobStateFinished
+	JobStateFailed
+	JobStateCanceled
+	// JobStateQueued means the job is waiting in the admission queue
+	JobStateQueued
+	// JobStateBlocked means the job is waiting for its upstream jobs
+	JobStateBlocked
+)
+
+// admissionRetryInterval is how long the admission of queued jobs is held
+// after a job master is rejected for lack of cluster resource.
+const admissionRetryInterval = time.Second
+
 // JobFsm manages state of all job masters, job master state forms a finite-state
-// machine. Note job master managed in JobFsm is in running status, which means
-// the job is not terminated or finished.
+// machine. The running states are shown below, a job in a terminal state is
+// kept in JobFsm only until it is archived.
 //
 // ,-------.                   ,-------.            ,-------.
 // |WaitAck|                   |Online |            |Pending|
@@ -44,13 +110,67 @@ type jobHolder struct {
 //     |                           |                    |
 //     |                           |                    |
 //     |                           |                    |
+//
+// A job in any of the states above can be canceled. A pending job is moved
+// to Canceled state at once, while a job in WaitAck or Online state is moved
+// to Canceling state, and then to Canceled state after its job master goes
+// offline.
+//
+// Each time a job master fails to be dispatched or goes offline, the failure
+// is recorded and the job is created again after a backoff according to its
+// restart policy. The job is moved to Failed state when its restart policy is
+// exhausted.
+//
+// An online job is moved to Finished state if its job master reports
+// WorkerStatusFinished, or to Failed state if WorkerStatusError is reported.
+//
+// If a job master is rejected because the cluster resource is not enough,
+// the job is moved to Queued state instead of being counted as a failure.
+// The queued jobs are admitted one at a time by the order of priority and
+// submit time, the next one is admitted after the job master of the previous
+// one is dispatched, and the admission is held for a while after each
+// rejection. A submitted job also waits in the queue if there are other
+// queued jobs.
+//
+// A job that depends on other jobs is kept in Blocked state until all of its
+// upstream jobs are finished, and then it is moved to Queued state. If any
+// of its upstream jobs is failed or canceled, the job is moved to the same
+// terminal state, which cascades to its own downstream jobs.
+//
+// After failover, the jobs loaded from metastore are added to WaitAck state
+// by JobRecovered, the ones whose job masters are not taken over in time are
+// moved to Pending state by PendUnadoptedJobs.
 type JobFsm struct {
 	JobStats
 
-	jobsMu      sync.RWMutex
-	pendingJobs map[lib.MasterID]*lib.MasterMetaExt
-	waitAckJobs map[lib.MasterID]*lib.MasterMetaExt
-	onlineJobs  map[lib.MasterID]*jobHolder
+	jobsMu        sync.RWMutex
+	pendingJobs   map[lib.MasterID]*lib.MasterMetaExt
+	waitAckJobs   map[lib.MasterID]*lib.MasterMetaExt
+	onlineJobs    map[lib.MasterID]*jobHolder
+	cancelingJobs map[lib.MasterID]*jobHolder
+
+	terminatedJobs map[lib.MasterID]*terminatedJob
+
+	// queuedJobs is the admission queue, ordered by priority and submit time.
+	queuedJobs []*lib.MasterMetaExt
+	// admissionRetryTime is the time before which no queued job is admitted.
+	admissionRetryTime time.Time
+	// admittingJob is the queued job whose job master is being dispatched,
+	// the next queued job is admitted only after the result is reported.
+	admittingJob lib.MasterID
+
+	blockedJobs map[lib.MasterID]*blockedJob
+
+	// recoveredJobs are the jobs added by JobRecovered whose job masters
+	// have not come online yet.
+	recoveredJobs map[lib.MasterID]struct{}
+	restarts      map[lib.MasterID]*jobRestart
+	// unsavedFailures are the jobs whose failures have been recorded since
+	// they were last persisted.
+	unsavedFailures map[lib.MasterID]struct{}
+
+	// to help unit testing
+	clock clock.Clock
 }
 
 // JobStats defines a statistics interface for JobFsm
@@ -58,13 +178,25 @@ type JobStats interface {
 	PendingJobCount() int
 	WaitAckJobCount() int
 	OnlineJobCount() int
+	CancelingJobCount() int
+	QueuedJobCount() int
+	BlockedJobCount() int
+	TerminatedJobCount() int
 }
 
 func NewJobFsm() *JobFsm {
 	return &JobFsm{
-		pendingJobs: make(map[lib.MasterID]*lib.MasterMetaExt),
-		waitAckJobs: make(map[lib.MasterID]*lib.MasterMetaExt),
-		onlineJobs:  make(map[lib.MasterID]*jobHolder),
+		pendingJobs:    make(map[lib.MasterID]*lib.MasterMetaExt),
+		waitAckJobs:    make(map[lib.MasterID]*lib.MasterMetaExt),
+		onlineJobs:     make(map[lib.MasterID]*jobHolder),
+		cancelingJobs:  make(map[lib.MasterID]*jobHolder),
+		terminatedJobs: make(map[lib.MasterID]*terminatedJob),
+		blockedJobs:    make(map[lib.MasterID]*blockedJob),
+		recoveredJobs:  make(map[lib.MasterID]struct{}),
+		restarts:       make(map[lib.MasterID]*jobRestart),
+		clock:          clock.New(),
+
+		unsavedFailures: make(map[lib.MasterID]struct{}),
 	}
 }
 
@@ -74,14 +206,67 @@ func (fsm *JobFsm) JobDispatched(job *lib.MasterMetaExt) {
 	fsm.waitAckJobs[job.ID] = job
 }
 
-func (fsm *JobFsm) IterPendingJobs(dispatchJobFn func(job *lib.MasterMetaExt) (string, error)) error {
+// JobRecovered adds a job loaded from metastore after failover to WaitAck
+// state, its job master may still be running and be taken over later.
+func (fsm *JobFsm) JobRecovered(job *lib.MasterMetaExt) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+	fsm.waitAckJobs[job.ID] = job
+	fsm.recoveredJobs[job.ID] = struct{}{}
+}
+
+// PendUnadoptedJobs moves the recovered jobs whose job masters have not come
+// online to Pending state, so that they will be dispatched again. The ones
+// canceled in the meantime are removed from JobFsm and returned.
+func (fsm *JobFsm) PendUnadoptedJobs() (canceled []lib.MasterID) {
 	fsm.jobsMu.Lock()
 	defer fsm.jobsMu.Unlock()
 
+	for id := range fsm.recoveredJobs {
+		if job, ok := fsm.waitAckJobs[id]; ok {
+			log.L().Info("recovered job master is not online, dispatch it again",
+				zap.String("job-id", id))
+			fsm.pendingJobs[id] = job
+			delete(fsm.waitAckJobs, id)
+			continue
+		}
+		if holder, ok := fsm.cancelingJobs[id]; ok && holder.WorkerHandle == nil {
+			delete(fsm.cancelingJobs, id)
+			fsm.terminateJob(holder.MasterMetaExt, JobStateCanceled, "")
+			canceled = append(canceled, id)
+		}
+	}
+	fsm.recoveredJobs = make(map[lib.MasterID]struct{})
+	return canceled
+}
+
+// IterPendingJobs dispatches the pending jobs whose backoff has passed. If a
+// job fails to be dispatched, the failure is recorded and the job stays in
+// Pending state. The jobs whose restart policies are exhausted are moved to
+// Failed state and returned. The job masters are scheduled asynchronously,
+// a job rejected for lack of cluster resource is moved to Queued state by
+// JobDispatchFailed.
+func (fsm *JobFsm) IterPendingJobs(dispatchJobFn func(job *lib.MasterMetaExt) (string, error)) (failed []lib.MasterID) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	now := fsm.clock.Now()
 	for oldJobID, job := range fsm.pendingJobs {
+		if restart, ok := fsm.restarts[oldJobID]; ok && now.Before(restart.nextAttempt) {
+			continue
+		}
 		id, err := dispatchJobFn(job)
 		if err != nil {
-			return err
+			if errors.ErrMasterConcurrencyExceeded.Equal(err) {
+				// try the rest of pending jobs in the next tick
+				break
+			}
+			log.L().Warn("dispatch job failed", zap.String("job-id", oldJobID), zap.Error(err))
+			if fsm.recordFailure(job, err) {
+				delete(fsm.pendingJobs, oldJobID)
+				failed = append(failed, oldJobID)
+			}
+			continue
 		}
 		delete(fsm.pendingJobs, oldJobID)
 		job.ID = id
@@ -89,49 +274,630 @@ func (fsm *JobFsm) IterPendingJobs(dispatchJobFn func(job *lib.MasterMetaExt) (s
 		log.L().Info("job master recovered", zap.Any("job", job))
 	}
 
-	return nil
+	return failed
+}
+
+// JobQueued adds a job to the admission queue.
+func (fsm *JobFsm) JobQueued(job *lib.MasterMetaExt) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+	fsm.enqueueJob(job)
+}
+
+// enqueueJob inserts a job into the admission queue after the jobs with
+// higher or equal priority that are submitted earlier, the caller should
+// hold jobsMu.
+func (fsm *JobFsm) enqueueJob(job *lib.MasterMetaExt) {
+	idx := sort.Search(len(fsm.queuedJobs), func(i int) bool {
+		queued := fsm.queuedJobs[i]
+		if queued.Priority != job.Priority {
+			return queued.Priority < job.Priority
+		}
+		return queued.SubmitTime.After(job.SubmitTime)
+	})
+	fsm.queuedJobs = append(fsm.queuedJobs, nil)
+	copy(fsm.queuedJobs[idx+1:], fsm.queuedJobs[idx:])
+	fsm.queuedJobs[idx] = job
+}
+
+// removeQueuedJob removes a job from the admission queue and returns it, the
+// caller should hold jobsMu.
+func (fsm *JobFsm) removeQueuedJob(id lib.MasterID) (*lib.MasterMetaExt, bool) {
+	for i, job := range fsm.queuedJobs {
+		if job.ID == id {
+			fsm.queuedJobs = append(fsm.queuedJobs[:i], fsm.queuedJobs[i+1:]...)
+			return job, true
+		}
+	}
+	return nil, false
+}
+
+// JobBlocked adds a job that depends on other jobs to Blocked state. The
+// states of the upstream jobs are looked up in JobFsm first, and then in
+// upstreamStates, which should contain the archived upstream jobs. If all of
+// the upstream jobs are finished, the job is not added and blocked is returned
+// as false. If any of them is failed, canceled or not found, the job is moved
+// to a terminal state at once and terminated is returned as true.
+func (fsm *JobFsm) JobBlocked(job *lib.MasterMetaExt, upstreamStates map[lib.MasterID]JobState) (blocked, terminated bool) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	waiting := make(map[lib.MasterID]struct{})
+	for _, upstream := range job.DependsOn {
+		state, ok := fsm.jobState(upstream)
+		if !ok {
+			state, ok = upstreamStates[upstream]
+		}
+		if !ok {
+			fsm.terminateJob(job, JobStateFailed, fmt.Sprintf("upstream job %s is not found", upstream))
+			return false, true
+		}
+		switch state {
+		case JobStateFinished:
+		case JobStateFailed, JobStateCanceled:
+			fsm.terminateJob(job, state, upstreamTerminatedMsg(upstream, state))
+			return false, true
+		default:
+			waiting[upstream] = struct{}{}
+		}
+	}
+	if len(waiting) == 0 {
+		return false, false
+	}
+	fsm.blockedJobs[job.ID] = &blockedJob{
+		MasterMetaExt: job,
+		waiting:       waiting,
+	}
+	return true, false
+}
+
+// unblockDownstream is called after a job is terminated. If the job is
+// finished, the downstream jobs that are not waiting for other jobs are moved
+// to Queued state, otherwise the downstream jobs are moved to the same
+// terminal state. The caller should hold jobsMu.
+func (fsm *JobFsm) unblockDownstream(id lib.MasterID, state JobState) {
+	for downstreamID, blocked := range fsm.blockedJobs {
+		if _, ok := blocked.waiting[id]; !ok {
+			continue
+		}
+		if state != JobStateFinished {
+			log.L().Info("upstream job is terminated, terminate the downstream job",
+				zap.String("job-id", downstreamID), zap.String("upstream", id))
+			delete(fsm.blockedJobs, downstreamID)
+			fsm.terminateJob(blocked.MasterMetaExt, state, upstreamTerminatedMsg(id, state))
+			continue
+		}
+		delete(blocked.waiting, id)
+		if len(blocked.waiting) == 0 {
+			log.L().Info("upstream jobs are finished, queue the job", zap.String("job-id", downstreamID))
+			delete(fsm.blockedJobs, downstreamID)
+			fsm.enqueueJob(blocked.MasterMetaExt)
+		}
+	}
+}
+
+func upstreamTerminatedMsg(upstream lib.MasterID, state JobState) string {
+	if state == JobStateCanceled {
+		return fmt.Sprintf("upstream job %s is canceled", upstream)
+	}
+	return fmt.Sprintf("upstream job %s is failed", upstream)
+}
+
+// jobState returns the state of a job in JobFsm, the caller should hold
+// jobsMu.
+func (fsm *JobFsm) jobState(id lib.MasterID) (JobState, bool) {
+	if _, ok := fsm.pendingJobs[id]; ok {
+		return JobStatePending, true
+	}
+	if _, ok := fsm.waitAckJobs[id]; ok {
+		return JobStateWaitAck, true
+	}
+	if _, ok := fsm.onlineJobs[id]; ok {
+		return JobStateOnline, true
+	}
+	if _, ok := fsm.cancelingJobs[id]; ok {
+		return JobStateCanceling, true
+	}
+	if job, ok := fsm.terminatedJobs[id]; ok {
+		return job.state, true
+	}
+	if _, ok := fsm.blockedJobs[id]; ok {
+		return JobStateBlocked, true
+	}
+	for _, job := range fsm.queuedJobs {
+		if job.ID == id {
+			return JobStateQueued, true
+		}
+	}
+	return 0, false
+}
+
+// AdmissionBlocked returns whether a submitted job should wait in the
+// admission queue, which is true if there are queued jobs, a queued job is
+// being admitted or a job master has been rejected for lack of cluster
+// resource recently.
+func (fsm *JobFsm) AdmissionBlocked() bool {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	return len(fsm.queuedJobs) > 0 || fsm.admittingJob != "" ||
+		fsm.clock.Now().Before(fsm.admissionRetryTime)
+}
+
+// AdmitQueuedJobs dispatches the first queued job unless the admission is held
+// or the previous admitted job is not dispatched yet. The job master is
+// scheduled asynchronously, the job is moved back to the queue by
+// JobDispatchFailed if it is rejected for lack of resource, so that a job of
+// lower priority never takes the resource before it. If a job fails to be
+// dispatched synchronously, the failure is recorded and the job is moved to
+// Pending state. The jobs whose restart policies are exhausted are moved to
+// Failed state and returned.
+func (fsm *JobFsm) AdmitQueuedJobs(dispatchJobFn func(job *lib.MasterMetaExt) (string, error)) (failed []lib.MasterID) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	if fsm.admittingJob != "" || fsm.clock.Now().Before(fsm.admissionRetryTime) {
+		return nil
+	}
+	for len(fsm.queuedJobs) > 0 {
+		job := fsm.queuedJobs[0]
+		id, err := dispatchJobFn(job)
+		if err != nil {
+			if errors.ErrMasterConcurrencyExceeded.Equal(err) {
+				break
+			}
+			log.L().Warn("admit job failed", zap.String("job-id", job.ID), zap.Error(err))
+			fsm.queuedJobs = fsm.queuedJobs[1:]
+			if fsm.recordFailure(job, err) {
+				failed = append(failed, job.ID)
+			} else {
+				fsm.pendingJobs[job.ID] = job
+			}
+			continue
+		}
+		fsm.queuedJobs = fsm.queuedJobs[1:]
+		fsm.waitAckJobs[id] = job
+		fsm.admittingJob = id
+		log.L().Info("job is admitted", zap.String("job-id", id))
+		break
+	}
+	return failed
+}
+
+// JobDispatchSucceeded is called after a job master is dispatched, the next
+// queued job can be admitted if the job is the one being admitted.
+func (fsm *JobFsm) JobDispatchSucceeded(id lib.MasterID) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+	if fsm.admittingJob == id {
+		fsm.admittingJob = ""
+	}
+}
+
+// recordFailure records a failure of the job master and computes when the
+// job master can be created again. If the restart policy is exhausted, the
+// job is moved to Failed state and true is returned. The caller should hold
+// jobsMu and remove the job from its current state.
+func (fsm *JobFsm) recordFailure(job *lib.MasterMetaExt, reason error) (failed bool) {
+	restart, ok := fsm.restarts[job.ID]
+	if !ok {
+		restart = &jobRestart{}
+		fsm.restarts[job.ID] = restart
+	}
+	now := fsm.clock.Now()
+	failure := jobFailure{Time: now}
+	if reason != nil {
+		failure.Reason = reason.Error()
+	}
+	restart.failures = append(restart.failures, failure)
+
+	policy := &lib.DefaultRestartPolicy
+	if job.RestartPolicy != nil {
+		policy = job.RestartPolicy
+	}
+	if policy.Exhausted(len(restart.failures)) {
+		log.L().Warn("job failed, restart policy is exhausted",
+			zap.String("job-id", job.ID), zap.Int("failures", len(restart.failures)))
+		fsm.terminateJob(job, JobStateFailed, failure.Reason)
+		return true
+	}
+	restart.nextAttempt = now.Add(policy.Backoff(len(restart.failures)))
+	fsm.unsavedFailures[job.ID] = struct{}{}
+	return false
+}
+
+// FailuresRecovered restores the failures of a job loaded from metastore
+// after failover, it should be called before the job is added to JobFsm, so
+// that its restart policy is not reset by failover.
+func (fsm *JobFsm) FailuresRecovered(job *lib.MasterMetaExt, failures []jobFailure) {
+	if len(failures) == 0 {
+		return
+	}
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	policy := &lib.DefaultRestartPolicy
+	if job.RestartPolicy != nil {
+		policy = job.RestartPolicy
+	}
+	fsm.restarts[job.ID] = &jobRestart{
+		failures:    failures,
+		nextAttempt: failures[len(failures)-1].Time.Add(policy.Backoff(len(failures))),
+	}
+}
+
+// UnsavedFailures returns the failures of the jobs that have been recorded
+// since they were last persisted, the caller should persist them and call
+// FailuresSaved.
+func (fsm *JobFsm) UnsavedFailures() map[lib.MasterID][]jobFailure {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+
+	ret := make(map[lib.MasterID][]jobFailure, len(fsm.unsavedFailures))
+	for id := range fsm.unsavedFailures {
+		if restart, ok := fsm.restarts[id]; ok {
+			ret[id] = append([]jobFailure(nil), restart.failures...)
+		}
+	}
+	return ret
+}
+
+// FailuresSaved marks the first count failures of a job as persisted, the
+// job stays unsaved if more failures have been recorded in the meantime.
+func (fsm *JobFsm) FailuresSaved(id lib.MasterID, count int) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	if restart, ok := fsm.restarts[id]; !ok || len(restart.failures) == count {
+		delete(fsm.unsavedFailures, id)
+	}
+}
+
+// terminateJob moves a job to a terminal state, the caller should hold
+// jobsMu and remove the job from its current state.
+func (fsm *JobFsm) terminateJob(job *lib.MasterMetaExt, state JobState, errMsg string) {
+	terminated := &terminatedJob{
+		MasterMetaExt: job,
+		state:         state,
+		endTime:       fsm.clock.Now(),
+		errMsg:        errMsg,
+	}
+	if restart, ok := fsm.restarts[job.ID]; ok {
+		terminated.failures = restart.failures
+		delete(fsm.restarts, job.ID)
+	}
+	// the failures are persisted in the archive
+	delete(fsm.unsavedFailures, job.ID)
+	fsm.terminatedJobs[job.ID] = terminated
+	fsm.unblockDownstream(job.ID, state)
 }
 
-func (fsm *JobFsm) JobOnline(worker lib.WorkerHandle) error {
+// JobTerminated adds a job loaded from metastore in a terminal state, it has
+// not been archived before failover.
+func (fsm *JobFsm) JobTerminated(job *lib.MasterMetaExt, state JobState) {
 	fsm.jobsMu.Lock()
 	defer fsm.jobsMu.Unlock()
+	fsm.terminateJob(job, state, "")
+}
+
+// CheckCompletedJobs moves the online jobs whose job masters have reported
+// WorkerStatusFinished to Finished state, and the ones that have reported
+// WorkerStatusError to Failed state. The handles of the job masters are
+// returned, the caller should ask them to stop.
+func (fsm *JobFsm) CheckCompletedJobs() (finished, failed []lib.WorkerHandle) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	for id, holder := range fsm.onlineJobs {
+		status := holder.WorkerHandle.Status()
+		if status == nil {
+			continue
+		}
+		switch status.Code {
+		case lib.WorkerStatusFinished:
+			fsm.terminateJob(holder.MasterMetaExt, JobStateFinished, "")
+			finished = append(finished, holder.WorkerHandle)
+		case lib.WorkerStatusError:
+			fsm.terminateJob(holder.MasterMetaExt, JobStateFailed, status.ErrorMessage)
+			failed = append(failed, holder.WorkerHandle)
+		default:
+			continue
+		}
+		delete(fsm.onlineJobs, id)
+	}
+	return finished, failed
+}
+
+// TerminatedJobs returns the jobs in terminal states that are not archived.
+func (fsm *JobFsm) TerminatedJobs() []terminatedJob {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+
+	jobs := make([]terminatedJob, 0, len(fsm.terminatedJobs))
+	for _, job := range fsm.terminatedJobs {
+		jobs = append(jobs, *job)
+	}
+	return jobs
+}
+
+// JobArchived removes a terminated job from JobFsm after it is archived.
+func (fsm *JobFsm) JobArchived(id lib.MasterID) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+	delete(fsm.terminatedJobs, id)
+}
+
+// JobOnline moves a job from WaitAck to Online. If the job has been canceled
+// before its job master comes online, the job stays in Canceling state and
+// canceling is returned as true, the caller should ask the job master to stop.
+func (fsm *JobFsm) JobOnline(worker lib.WorkerHandle) (canceling bool, err error) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	delete(fsm.recoveredJobs, worker.ID())
+	if holder, ok := fsm.cancelingJobs[worker.ID()]; ok {
+		holder.WorkerHandle = worker
+		return true, nil
+	}
 
 	job, ok := fsm.waitAckJobs[worker.ID()]
 	if !ok {
-		return errors.ErrWorkerNotFound.GenWithStackByArgs(worker.ID())
+		return false, errors.ErrWorkerNotFound.GenWithStackByArgs(worker.ID())
 	}
 	fsm.onlineJobs[worker.ID()] = &jobHolder{
 		WorkerHandle:  worker,
 		MasterMetaExt: job,
 	}
 	delete(fsm.waitAckJobs, worker.ID())
-	return nil
+	return false, nil
 }
 
-func (fsm *JobFsm) JobOffline(worker lib.WorkerHandle) {
+// JobOffline moves a job from Online to Pending, or to Failed if its restart
+// policy is exhausted. If the job is being canceled, it is moved to Canceled
+// state and canceled is returned as true.
+func (fsm *JobFsm) JobOffline(worker lib.WorkerHandle, reason error) (canceled, failed bool) {
 	fsm.jobsMu.Lock()
 	defer fsm.jobsMu.Unlock()
 
+	if holder, ok := fsm.cancelingJobs[worker.ID()]; ok {
+		delete(fsm.cancelingJobs, worker.ID())
+		fsm.terminateJob(holder.MasterMetaExt, JobStateCanceled, "")
+		return true, false
+	}
+
 	job, ok := fsm.onlineJobs[worker.ID()]
 	if !ok {
 		log.L().Warn("non-online worker offline, ignore it", zap.String("id", worker.ID()))
-		return
+		return false, false
 	}
-	fsm.pendingJobs[worker.ID()] = job.MasterMetaExt
 	delete(fsm.onlineJobs, worker.ID())
+	// A job master moved out of a drained executor is not failed, it's
+	// created again elsewhere at once.
+	if !errors.ErrWorkerMigrated.Equal(reason) && fsm.recordFailure(job.MasterMetaExt, reason) {
+		return false, true
+	}
+	fsm.pendingJobs[worker.ID()] = job.MasterMetaExt
+	return false, false
 }
 
-func (fsm *JobFsm) JobDispatchFailed(worker lib.WorkerHandle) error {
+// JobDispatchFailed moves a job from WaitAck to Pending, or to Failed if its
+// restart policy is exhausted. If the job master is rejected for lack of
+// cluster resource, the job is moved to Queued state instead. If the job is
+// being canceled, it is moved to Canceled state and canceled is returned as
+// true.
+func (fsm *JobFsm) JobDispatchFailed(worker lib.WorkerHandle, reason error) (canceled, failed bool, err error) {
 	fsm.jobsMu.Lock()
 	defer fsm.jobsMu.Unlock()
 
+	if fsm.admittingJob == worker.ID() {
+		fsm.admittingJob = ""
+	}
+	if holder, ok := fsm.cancelingJobs[worker.ID()]; ok {
+		delete(fsm.cancelingJobs, worker.ID())
+		fsm.terminateJob(holder.MasterMetaExt, JobStateCanceled, "")
+		return true, false, nil
+	}
+
 	job, ok := fsm.waitAckJobs[worker.ID()]
 	if !ok {
-		return errors.ErrWorkerNotFound.GenWithStackByArgs(worker.ID())
+		return false, false, errors.ErrWorkerNotFound.GenWithStackByArgs(worker.ID())
 	}
-	fsm.pendingJobs[worker.ID()] = job
 	delete(fsm.waitAckJobs, worker.ID())
-	return nil
+	if errors.ErrClusterResourceNotEnough.Equal(reason) {
+		log.L().Info("cluster resource is not enough, queue the job", zap.String("job-id", job.ID))
+		fsm.enqueueJob(job)
+		fsm.admissionRetryTime = fsm.clock.Now().Add(admissionRetryInterval)
+		return false, false, nil
+	}
+	if fsm.recordFailure(job, reason) {
+		return false, true, nil
+	}
+	fsm.pendingJobs[worker.ID()] = job
+	return false, false, nil
+}
+
+// JobCancel cancels a job. A pending, queued or blocked job has no running job
+// master, so it is moved to Canceled state directly and canceled is returned
+// as true. Otherwise the
+// job is moved to Canceling state, and the worker handle of its job master is
+// returned if the job master is online, the caller should ask it to stop.
+func (fsm *JobFsm) JobCancel(id lib.MasterID) (handle lib.WorkerHandle, canceled bool, err error) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	if job, ok := fsm.pendingJobs[id]; ok {
+		delete(fsm.pendingJobs, id)
+		fsm.terminateJob(job, JobStateCanceled, "")
+		return nil, true, nil
+	}
+	if job, ok := fsm.removeQueuedJob(id); ok {
+		fsm.terminateJob(job, JobStateCanceled, "")
+		return nil, true, nil
+	}
+	if blocked, ok := fsm.blockedJobs[id]; ok {
+		delete(fsm.blockedJobs, id)
+		fsm.terminateJob(blocked.MasterMetaExt, JobStateCanceled, "")
+		return nil, true, nil
+	}
+	if job, ok := fsm.waitAckJobs[id]; ok {
+		fsm.cancelingJobs[id] = &jobHolder{MasterMetaExt: job}
+		delete(fsm.waitAckJobs, id)
+		return nil, false, nil
+	}
+	if holder, ok := fsm.onlineJobs[id]; ok {
+		fsm.cancelingJobs[id] = holder
+		delete(fsm.onlineJobs, id)
+		return holder.WorkerHandle, false, nil
+	}
+	if holder, ok := fsm.cancelingJobs[id]; ok {
+		return holder.WorkerHandle, false, nil
+	}
+	return nil, false, errors.ErrJobNotFound.GenWithStackByArgs(id)
+}
+
+// JobConfigUpdated replaces the job with the one carrying the updated config,
+// so that the job master is created with the updated config if it is created
+// again. The worker handle of the job master is returned if the job is online,
+// a job being canceled or terminated is treated as not found.
+func (fsm *JobFsm) JobConfigUpdated(job *lib.MasterMetaExt) (lib.WorkerHandle, error) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+
+	id := job.ID
+	if holder, ok := fsm.onlineJobs[id]; ok {
+		holder.MasterMetaExt = job
+		return holder.WorkerHandle, nil
+	}
+	if _, ok := fsm.waitAckJobs[id]; ok {
+		fsm.waitAckJobs[id] = job
+		return nil, nil
+	}
+	if _, ok := fsm.pendingJobs[id]; ok {
+		fsm.pendingJobs[id] = job
+		return nil, nil
+	}
+	for i, queued := range fsm.queuedJobs {
+		if queued.ID == id {
+			fsm.queuedJobs[i] = job
+			return nil, nil
+		}
+	}
+	if blocked, ok := fsm.blockedJobs[id]; ok {
+		blocked.MasterMetaExt = job
+		return nil, nil
+	}
+	return nil, errors.ErrJobNotFound.GenWithStackByArgs(id)
+}
+
+// JobHandle returns the worker handle of the job master if the job is online,
+// nil is returned if the job master is not online yet. A job being canceled
+// is treated as not found.
+func (fsm *JobFsm) JobHandle(id lib.MasterID) (lib.WorkerHandle, error) {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+
+	if holder, ok := fsm.onlineJobs[id]; ok {
+		return holder.WorkerHandle, nil
+	}
+	if _, ok := fsm.waitAckJobs[id]; ok {
+		return nil, nil
+	}
+	if _, ok := fsm.pendingJobs[id]; ok {
+		return nil, nil
+	}
+	for _, job := range fsm.queuedJobs {
+		if job.ID == id {
+			return nil, nil
+		}
+	}
+	if _, ok := fsm.blockedJobs[id]; ok {
+		return nil, nil
+	}
+	return nil, errors.ErrJobNotFound.GenWithStackByArgs(id)
+}
+
+// QueryJob returns the job and its state. The WorkerHandle of the returned
+// job is nil if the job master is not online.
+func (fsm *JobFsm) QueryJob(id lib.MasterID) (jobHolder, JobState, error) {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+
+	if job, ok := fsm.pendingJobs[id]; ok {
+		return fsm.withFailures(jobHolder{MasterMetaExt: job}), JobStatePending, nil
+	}
+	if job, ok := fsm.waitAckJobs[id]; ok {
+		return fsm.withFailures(jobHolder{MasterMetaExt: job}), JobStateWaitAck, nil
+	}
+	if holder, ok := fsm.onlineJobs[id]; ok {
+		return fsm.withFailures(*holder), JobStateOnline, nil
+	}
+	if holder, ok := fsm.cancelingJobs[id]; ok {
+		return fsm.withFailures(*holder), JobStateCanceling, nil
+	}
+	if job, ok := fsm.terminatedJobs[id]; ok {
+		return job.holder(), job.state, nil
+	}
+	for i, job := range fsm.queuedJobs {
+		if job.ID == id {
+			return fsm.queuedHolder(job, i), JobStateQueued, nil
+		}
+	}
+	if blocked, ok := fsm.blockedJobs[id]; ok {
+		return jobHolder{MasterMetaExt: blocked.MasterMetaExt}, JobStateBlocked, nil
+	}
+	return jobHolder{}, 0, errors.ErrJobNotFound.GenWithStackByArgs(id)
+}
+
+// IterJobs calls fn with every job in JobFsm and its state.
+func (fsm *JobFsm) IterJobs(fn func(job jobHolder, state JobState)) {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+
+	for _, job := range fsm.pendingJobs {
+		fn(fsm.withFailures(jobHolder{MasterMetaExt: job}), JobStatePending)
+	}
+	for _, job := range fsm.waitAckJobs {
+		fn(fsm.withFailures(jobHolder{MasterMetaExt: job}), JobStateWaitAck)
+	}
+	for _, holder := range fsm.onlineJobs {
+		fn(fsm.withFailures(*holder), JobStateOnline)
+	}
+	for _, holder := range fsm.cancelingJobs {
+		fn(fsm.withFailures(*holder), JobStateCanceling)
+	}
+	for _, job := range fsm.terminatedJobs {
+		fn(job.holder(), job.state)
+	}
+	for i, job := range fsm.queuedJobs {
+		fn(fsm.queuedHolder(job, i), JobStateQueued)
+	}
+	for _, blocked := range fsm.blockedJobs {
+		fn(jobHolder{MasterMetaExt: blocked.MasterMetaExt}, JobStateBlocked)
+	}
+}
+
+// queuedHolder returns the jobHolder of the queued job at idx of the
+// admission queue, the caller should hold jobsMu.
+func (fsm *JobFsm) queuedHolder(job *lib.MasterMetaExt, idx int) jobHolder {
+	holder := fsm.withFailures(jobHolder{MasterMetaExt: job})
+	holder.queuePosition = idx + 1
+	return holder
+}
+
+func (job *terminatedJob) holder() jobHolder {
+	return jobHolder{
+		MasterMetaExt: job.MasterMetaExt,
+		failures:      job.failures,
+		endTime:       job.endTime,
+		errMsg:        job.errMsg,
+	}
+}
+
+// withFailures fills the failures of the job, the caller should hold jobsMu.
+func (fsm *JobFsm) withFailures(holder jobHolder) jobHolder {
+	if restart, ok := fsm.restarts[holder.MasterMetaExt.ID]; ok {
+		holder.failures = append([]jobFailure(nil), restart.failures...)
+	}
+	return holder
 }
 
 func (fsm *JobFsm) PendingJobCount() int {
@@ -151,3 +917,27 @@ func (fsm *JobFsm) OnlineJobCount() int {
 	defer fsm.jobsMu.RUnlock()
 	return len(fsm.onlineJobs)
 }
+
+func (fsm *JobFsm) CancelingJobCount() int {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	return len(fsm.cancelingJobs)
+}
+
+func (fsm *JobFsm) QueuedJobCount() int {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	return len(fsm.queuedJobs)
+}
+
+func (fsm *JobFsm) BlockedJobCount() int {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	return len(fsm.blockedJobs)
+}
+
+func (fsm *JobFsm) TerminatedJobCount() int {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	return len(fsm.terminatedJobs)
+}
@@ -2,8 +2,11 @@ package servermaster
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/pkg/clock"
+	"github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -11,6 +14,7 @@ func TestJobFsmStateTrans(t *testing.T) {
 	t.Parallel()
 
 	fsm := NewJobFsm()
+	fsm.clock = clock.NewMock()
 
 	id := "fsm-test-job-master-1"
 	job := &lib.MasterMetaExt{
@@ -24,29 +28,435 @@ func TestJobFsmStateTrans(t *testing.T) {
 	require.Equal(t, 1, fsm.WaitAckJobCount())
 
 	// OnWorkerOnline, WaitAck -> Online
-	err := fsm.JobOnline(worker)
+	canceling, err := fsm.JobOnline(worker)
 	require.Nil(t, err)
+	require.False(t, canceling)
 	require.Equal(t, 0, fsm.WaitAckJobCount())
 	require.Equal(t, 1, fsm.OnlineJobCount())
 
 	// OnWorkerOffline, Online -> Pending
-	fsm.JobOffline(worker)
+	canceled, failed := fsm.JobOffline(worker, errors.ErrWorkerTimedOut.GenWithStackByArgs(id))
+	require.False(t, canceled)
+	require.False(t, failed)
 	require.Equal(t, 0, fsm.OnlineJobCount())
 	require.Equal(t, 1, fsm.PendingJobCount())
 
 	// Tick, process pending jobs, Pending -> WaitAck
 	dispatchedJobs := make([]*lib.MasterMetaExt, 0)
-	err = fsm.IterPendingJobs(func(job *lib.MasterMetaExt) (string, error) {
+	dispatchFn := func(job *lib.MasterMetaExt) (string, error) {
 		dispatchedJobs = append(dispatchedJobs, job)
 		return id, nil
-	})
-	require.Nil(t, err)
+	}
+	// the job is not dispatched before backoff
+	require.Empty(t, fsm.IterPendingJobs(dispatchFn))
+	require.Equal(t, 1, fsm.PendingJobCount())
+	fsm.clock.(*clock.Mock).Add(time.Second)
+	require.Empty(t, fsm.IterPendingJobs(dispatchFn))
 	require.Equal(t, 0, fsm.PendingJobCount())
 	require.Equal(t, 1, fsm.WaitAckJobCount())
+	require.Len(t, dispatchedJobs, 1)
 
 	// Dispatch job meets error, WaitAck -> Pending
-	err = fsm.JobDispatchFailed(worker)
+	canceled, failed, err = fsm.JobDispatchFailed(worker, errors.ErrGrpcBuildConn.GenWithStackByArgs())
 	require.Nil(t, err)
+	require.False(t, canceled)
+	require.False(t, failed)
 	require.Equal(t, 1, fsm.PendingJobCount())
 	require.Equal(t, 0, fsm.WaitAckJobCount())
 }
+
+func TestJobFsmCancel(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+
+	id := "fsm-test-job-master-2"
+	job := &lib.MasterMetaExt{
+		ID:     id,
+		Config: []byte("simple config"),
+	}
+	worker := lib.NewTombstoneWorkerHandle(id, lib.WorkerStatus{Code: lib.WorkerStatusNormal})
+
+	_, _, err := fsm.JobCancel(id)
+	require.True(t, errors.ErrJobNotFound.Equal(err))
+
+	// cancel an online job, Online -> Canceling
+	fsm.JobDispatched(job)
+	_, err = fsm.JobOnline(worker)
+	require.Nil(t, err)
+	handle, canceled, err := fsm.JobCancel(id)
+	require.Nil(t, err)
+	require.False(t, canceled)
+	require.Equal(t, id, handle.ID())
+	require.Equal(t, 0, fsm.OnlineJobCount())
+	require.Equal(t, 1, fsm.CancelingJobCount())
+
+	// job master offline, the job is removed from fsm
+	canceled, _ = fsm.JobOffline(worker, nil)
+	require.True(t, canceled)
+	require.Equal(t, 0, fsm.CancelingJobCount())
+	require.Equal(t, 0, fsm.PendingJobCount())
+
+	// cancel a job waiting for ack, WaitAck -> Canceling
+	fsm.JobDispatched(job)
+	handle, canceled, err = fsm.JobCancel(id)
+	require.Nil(t, err)
+	require.False(t, canceled)
+	require.Nil(t, handle)
+	require.Equal(t, 0, fsm.WaitAckJobCount())
+
+	// job master comes online after the job is canceled, it should be stopped
+	canceling, err := fsm.JobOnline(worker)
+	require.Nil(t, err)
+	require.True(t, canceling)
+	require.Equal(t, 0, fsm.OnlineJobCount())
+	require.Equal(t, 1, fsm.CancelingJobCount())
+	canceled, _ = fsm.JobOffline(worker, nil)
+	require.True(t, canceled)
+
+	// cancel a pending job, the job is removed from fsm at once
+	fsm.JobDispatched(job)
+	_, _, err = fsm.JobDispatchFailed(worker, nil)
+	require.Nil(t, err)
+	require.Equal(t, 1, fsm.PendingJobCount())
+	handle, canceled, err = fsm.JobCancel(id)
+	require.Nil(t, err)
+	require.True(t, canceled)
+	require.Nil(t, handle)
+	require.Equal(t, 0, fsm.PendingJobCount())
+}
+
+func TestJobFsmRecover(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+
+	newJob := func(id string) *lib.MasterMetaExt {
+		return &lib.MasterMetaExt{ID: id, Config: []byte("simple config")}
+	}
+	adoptedID := "fsm-test-job-master-3"
+	unadoptedID := "fsm-test-job-master-4"
+	canceledID := "fsm-test-job-master-5"
+	fsm.JobRecovered(newJob(adoptedID))
+	fsm.JobRecovered(newJob(unadoptedID))
+	fsm.JobRecovered(newJob(canceledID))
+	require.Equal(t, 3, fsm.WaitAckJobCount())
+
+	// the job master of a recovered job is taken over
+	canceling, err := fsm.JobOnline(
+		lib.NewTombstoneWorkerHandle(adoptedID, lib.WorkerStatus{Code: lib.WorkerStatusNormal}))
+	require.Nil(t, err)
+	require.False(t, canceling)
+	_, _, err = fsm.JobCancel(canceledID)
+	require.Nil(t, err)
+
+	// unadopted jobs are dispatched again, canceled ones are removed
+	canceled := fsm.PendUnadoptedJobs()
+	require.Equal(t, []lib.MasterID{canceledID}, canceled)
+	require.Equal(t, 1, fsm.OnlineJobCount())
+	require.Equal(t, 0, fsm.WaitAckJobCount())
+	require.Equal(t, 0, fsm.CancelingJobCount())
+	_, state, err := fsm.QueryJob(unadoptedID)
+	require.Nil(t, err)
+	require.Equal(t, JobStatePending, state)
+
+	require.Empty(t, fsm.PendUnadoptedJobs())
+}
+
+func TestJobFsmRestartPolicy(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+	fsm.clock = clock.NewMock()
+
+	id := "fsm-test-job-master-6"
+	job := &lib.MasterMetaExt{
+		ID: id,
+		RestartPolicy: &lib.RestartPolicy{
+			MaxAttempts:      3,
+			InitialBackoffMs: 1000,
+			MaxBackoffMs:     1500,
+		},
+	}
+	worker := lib.NewTombstoneWorkerHandle(id, lib.WorkerStatus{Code: lib.WorkerStatusError})
+	dispatchErr := errors.ErrBuildJobFailed.GenWithStackByArgs()
+	dispatchCount := 0
+	dispatchFn := func(job *lib.MasterMetaExt) (string, error) {
+		dispatchCount++
+		return "", dispatchErr
+	}
+
+	// the first failure is reported asynchronously
+	fsm.JobDispatched(job)
+	_, failed, err := fsm.JobDispatchFailed(worker, dispatchErr)
+	require.Nil(t, err)
+	require.False(t, failed)
+
+	// the second failure happens after 1s backoff
+	require.Empty(t, fsm.IterPendingJobs(dispatchFn))
+	require.Equal(t, 0, dispatchCount)
+	fsm.clock.(*clock.Mock).Add(time.Second)
+	require.Empty(t, fsm.IterPendingJobs(dispatchFn))
+	require.Equal(t, 1, dispatchCount)
+	require.Equal(t, 1, fsm.PendingJobCount())
+
+	// the backoff is doubled and capped by max backoff
+	fsm.clock.(*clock.Mock).Add(time.Second)
+	require.Empty(t, fsm.IterPendingJobs(dispatchFn))
+	require.Equal(t, 1, dispatchCount)
+	fsm.clock.(*clock.Mock).Add(time.Millisecond * 500)
+	failedJobs := fsm.IterPendingJobs(dispatchFn)
+	require.Equal(t, 2, dispatchCount)
+	require.Equal(t, []lib.MasterID{id}, failedJobs)
+	require.Equal(t, 0, fsm.PendingJobCount())
+	require.Equal(t, 1, fsm.TerminatedJobCount())
+
+	holder, state, err := fsm.QueryJob(id)
+	require.Nil(t, err)
+	require.Equal(t, JobStateFailed, state)
+	require.Len(t, holder.failures, 3)
+	require.Equal(t, dispatchErr.Error(), holder.failures[2].Reason)
+}
+
+func TestJobFsmPendingJobQueued(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+	fsm.clock = clock.NewMock()
+
+	id := "fsm-test-job-master-restarting"
+	job := &lib.MasterMetaExt{ID: id, RestartPolicy: &lib.RestartPolicy{MaxAttempts: 2}}
+	worker := lib.NewTombstoneWorkerHandle(id, lib.WorkerStatus{Code: lib.WorkerStatusError})
+	fsm.JobDispatched(job)
+	_, err := fsm.JobOnline(worker)
+	require.Nil(t, err)
+	canceled, failed := fsm.JobOffline(worker, errors.ErrWorkerOffline.GenWithStackByArgs(id))
+	require.False(t, canceled)
+	require.False(t, failed)
+	require.Equal(t, 1, fsm.PendingJobCount())
+
+	// the restarting job master is rejected for lack of resource, it is
+	// queued and the rejection is not counted as a failure
+	fsm.clock.(*clock.Mock).Add(time.Minute)
+	dispatchFn := func(job *lib.MasterMetaExt) (string, error) {
+		return job.ID, nil
+	}
+	require.Empty(t, fsm.IterPendingJobs(dispatchFn))
+	require.Equal(t, 0, fsm.PendingJobCount())
+	require.Equal(t, 1, fsm.WaitAckJobCount())
+	canceled, failed, err = fsm.JobDispatchFailed(worker, errors.ErrClusterResourceNotEnough.GenWithStackByArgs())
+	require.Nil(t, err)
+	require.False(t, canceled)
+	require.False(t, failed)
+	require.Equal(t, 1, fsm.QueuedJobCount())
+	require.True(t, fsm.AdmissionBlocked())
+	holder, state, err := fsm.QueryJob(id)
+	require.Nil(t, err)
+	require.Equal(t, JobStateQueued, state)
+	require.Len(t, holder.failures, 1)
+}
+
+func TestJobFsmMigrated(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+	fsm.clock = clock.NewMock()
+
+	id := "fsm-test-job-master-migrated"
+	job := &lib.MasterMetaExt{ID: id}
+	worker := lib.NewTombstoneWorkerHandle(id, lib.WorkerStatus{Code: lib.WorkerStatusNormal})
+	fsm.JobDispatched(job)
+	_, err := fsm.JobOnline(worker)
+	require.Nil(t, err)
+
+	// the job master moved out of a drained executor is created again at
+	// once, and it's not a failure
+	canceled, failed := fsm.JobOffline(worker, errors.ErrWorkerMigrated.GenWithStackByArgs(id, "drained"))
+	require.False(t, canceled)
+	require.False(t, failed)
+	dispatchFn := func(job *lib.MasterMetaExt) (string, error) {
+		return id, nil
+	}
+	require.Empty(t, fsm.IterPendingJobs(dispatchFn))
+	require.Equal(t, 1, fsm.WaitAckJobCount())
+	holder, _, err := fsm.QueryJob(id)
+	require.Nil(t, err)
+	require.Empty(t, holder.failures)
+}
+
+func TestJobFsmCompleted(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+
+	finishedID := "fsm-test-job-master-7"
+	failedID := "fsm-test-job-master-8"
+	fsm.JobDispatched(&lib.MasterMetaExt{ID: finishedID})
+	fsm.JobDispatched(&lib.MasterMetaExt{ID: failedID})
+	_, err := fsm.JobOnline(lib.NewTombstoneWorkerHandle(finishedID,
+		lib.WorkerStatus{Code: lib.WorkerStatusFinished}))
+	require.Nil(t, err)
+	_, err = fsm.JobOnline(lib.NewTombstoneWorkerHandle(failedID,
+		lib.WorkerStatus{Code: lib.WorkerStatusError, ErrorMessage: "source is gone"}))
+	require.Nil(t, err)
+
+	finished, failed := fsm.CheckCompletedJobs()
+	require.Len(t, finished, 1)
+	require.Equal(t, finishedID, finished[0].ID())
+	require.Len(t, failed, 1)
+	require.Equal(t, failedID, failed[0].ID())
+	require.Equal(t, 0, fsm.OnlineJobCount())
+	require.Equal(t, 2, fsm.TerminatedJobCount())
+
+	holder, state, err := fsm.QueryJob(failedID)
+	require.Nil(t, err)
+	require.Equal(t, JobStateFailed, state)
+	require.Equal(t, "source is gone", holder.errMsg)
+	require.False(t, holder.endTime.IsZero())
+
+	// the job master goes offline after it is asked to stop
+	canceled, jobFailed := fsm.JobOffline(finished[0], nil)
+	require.False(t, canceled)
+	require.False(t, jobFailed)
+
+	fsm.JobArchived(finishedID)
+	fsm.JobArchived(failedID)
+	require.Equal(t, 0, fsm.TerminatedJobCount())
+}
+
+func TestJobFsmAdmissionQueue(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+	fsm.clock = clock.NewMock()
+
+	submitTime := fsm.clock.Now()
+	nightly := &lib.MasterMetaExt{ID: "nightly-job", SubmitTime: submitTime}
+	urgent := &lib.MasterMetaExt{ID: "urgent-job", SubmitTime: submitTime.Add(time.Second), Priority: 10}
+	later := &lib.MasterMetaExt{ID: "later-job", SubmitTime: submitTime.Add(time.Second)}
+	require.False(t, fsm.AdmissionBlocked())
+
+	// the job master is rejected for lack of resource, WaitAck -> Queued
+	fsm.JobDispatched(nightly)
+	canceled, failed, err := fsm.JobDispatchFailed(
+		lib.NewTombstoneWorkerHandle(nightly.ID, lib.WorkerStatus{Code: lib.WorkerStatusError}),
+		errors.ErrClusterResourceNotEnough.GenWithStackByArgs())
+	require.Nil(t, err)
+	require.False(t, canceled)
+	require.False(t, failed)
+	require.Equal(t, 0, fsm.WaitAckJobCount())
+	require.Equal(t, 1, fsm.QueuedJobCount())
+	require.True(t, fsm.AdmissionBlocked())
+
+	// the queue is ordered by priority and then by submit time
+	fsm.JobQueued(later)
+	fsm.JobQueued(urgent)
+	for i, id := range []string{urgent.ID, nightly.ID, later.ID} {
+		holder, state, err := fsm.QueryJob(id)
+		require.Nil(t, err)
+		require.Equal(t, JobStateQueued, state)
+		require.Equal(t, i+1, holder.queuePosition)
+		// lack of resource is not counted as a failure
+		require.Empty(t, holder.failures)
+	}
+
+	// the admission is held after a rejection
+	var admitted []string
+	dispatchFn := func(job *lib.MasterMetaExt) (string, error) {
+		admitted = append(admitted, job.ID)
+		return job.ID, nil
+	}
+	require.Empty(t, fsm.AdmitQueuedJobs(dispatchFn))
+	require.Empty(t, admitted)
+	fsm.clock.(*clock.Mock).Add(admissionRetryInterval)
+
+	// only the first queued job is admitted until it is dispatched
+	require.Empty(t, fsm.AdmitQueuedJobs(dispatchFn))
+	require.Empty(t, fsm.AdmitQueuedJobs(dispatchFn))
+	require.Equal(t, []string{urgent.ID}, admitted)
+	require.True(t, fsm.AdmissionBlocked())
+
+	// it is rejected again, and stays ahead of the others
+	_, _, err = fsm.JobDispatchFailed(
+		lib.NewTombstoneWorkerHandle(urgent.ID, lib.WorkerStatus{Code: lib.WorkerStatusError}),
+		errors.ErrClusterResourceNotEnough.GenWithStackByArgs())
+	require.Nil(t, err)
+	require.Empty(t, fsm.AdmitQueuedJobs(dispatchFn))
+	fsm.clock.(*clock.Mock).Add(admissionRetryInterval)
+	require.Empty(t, fsm.AdmitQueuedJobs(dispatchFn))
+	require.Equal(t, []string{urgent.ID, urgent.ID}, admitted)
+
+	fsm.JobDispatchSucceeded(urgent.ID)
+	require.Empty(t, fsm.AdmitQueuedJobs(dispatchFn))
+	require.Equal(t, []string{urgent.ID, urgent.ID, nightly.ID}, admitted)
+	require.Equal(t, 2, fsm.WaitAckJobCount())
+	require.Equal(t, 1, fsm.QueuedJobCount())
+
+	// a queued job is canceled at once
+	_, canceled, err = fsm.JobCancel(later.ID)
+	require.Nil(t, err)
+	require.True(t, canceled)
+	require.Equal(t, 0, fsm.QueuedJobCount())
+	_, state, err := fsm.QueryJob(later.ID)
+	require.Nil(t, err)
+	require.Equal(t, JobStateCanceled, state)
+}
+
+func TestJobFsmDependencies(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+
+	upstream := &lib.MasterMetaExt{ID: "import-a"}
+	other := &lib.MasterMetaExt{ID: "import-b"}
+	downstream := &lib.MasterMetaExt{ID: "merge", DependsOn: []string{upstream.ID, other.ID, "archived"}}
+	chained := &lib.MasterMetaExt{ID: "report", DependsOn: []string{downstream.ID}}
+	fsm.JobDispatched(upstream)
+	fsm.JobDispatched(other)
+
+	blocked, terminated := fsm.JobBlocked(downstream, map[string]JobState{"archived": JobStateFinished})
+	require.True(t, blocked)
+	require.False(t, terminated)
+	blocked, terminated = fsm.JobBlocked(chained, nil)
+	require.True(t, blocked)
+	require.False(t, terminated)
+	require.Equal(t, 2, fsm.BlockedJobCount())
+	_, state, err := fsm.QueryJob(downstream.ID)
+	require.Nil(t, err)
+	require.Equal(t, JobStateBlocked, state)
+
+	// the downstream job is queued after all upstream jobs are finished
+	for _, job := range []*lib.MasterMetaExt{upstream, other} {
+		_, err := fsm.JobOnline(lib.NewTombstoneWorkerHandle(job.ID,
+			lib.WorkerStatus{Code: lib.WorkerStatusFinished}))
+		require.Nil(t, err)
+	}
+	finished, _ := fsm.CheckCompletedJobs()
+	require.Len(t, finished, 2)
+	require.Equal(t, 1, fsm.BlockedJobCount())
+	require.Equal(t, 1, fsm.QueuedJobCount())
+
+	// the failure is cascaded to the blocked downstream job
+	fsm.JobDispatched(downstream)
+	_, failed, err := fsm.JobDispatchFailed(
+		lib.NewTombstoneWorkerHandle(downstream.ID, lib.WorkerStatus{Code: lib.WorkerStatusError}),
+		errors.ErrGrpcBuildConn.GenWithStackByArgs())
+	require.Nil(t, err)
+	require.False(t, failed)
+	_, _, err = fsm.JobCancel(downstream.ID)
+	require.Nil(t, err)
+	require.Equal(t, 0, fsm.BlockedJobCount())
+	holder, state, err := fsm.QueryJob(chained.ID)
+	require.Nil(t, err)
+	require.Equal(t, JobStateCanceled, state)
+	require.Equal(t, "upstream job merge is canceled", holder.errMsg)
+
+	// a job depending on a failed job is failed at once
+	blocked, terminated = fsm.JobBlocked(&lib.MasterMetaExt{ID: "retry", DependsOn: []string{"failed"}},
+		map[string]JobState{"failed": JobStateFailed})
+	require.False(t, blocked)
+	require.True(t, terminated)
+	_, state, err = fsm.QueryJob("retry")
+	require.Nil(t, err)
+	require.Equal(t, JobStateFailed, state)
+}
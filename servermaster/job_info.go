@@ -0,0 +1,91 @@
+package servermaster
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/pb"
+)
+
+var jobStateToPB = map[JobState]pb.JobInfo_State{
+	JobStatePending:   pb.JobInfo_Pending,
+	JobStateWaitAck:   pb.JobInfo_WaitAck,
+	JobStateOnline:    pb.JobInfo_Online,
+	JobStateCanceling: pb.JobInfo_Canceling,
+	JobStateFinished:  pb.JobInfo_Finished,
+	JobStateFailed:    pb.JobInfo_Failed,
+	JobStateCanceled:  pb.JobInfo_Canceled,
+	JobStateQueued:    pb.JobInfo_Queued,
+	JobStateBlocked:   pb.JobInfo_Blocked,
+}
+
+// buildJobInfo converts a job in JobFsm to pb.JobInfo. The statuses of the
+// workers are taken from the status reported by the job master.
+func buildJobInfo(job jobHolder, state JobState) *pb.JobInfo {
+	info := &pb.JobInfo{
+		JobIdStr:      job.MasterMetaExt.ID,
+		State:         jobStateToPB[state],
+		Tp:            int64(job.MasterMetaExt.Tp),
+		Config:        job.MasterMetaExt.Config,
+		Error:         job.errMsg,
+		Priority:      job.MasterMetaExt.Priority,
+		QueuePosition: int64(job.queuePosition),
+		DependsOn:     job.MasterMetaExt.DependsOn,
+		ConfigVersion: job.MasterMetaExt.ConfigVersion,
+	}
+	if !job.MasterMetaExt.SubmitTime.IsZero() {
+		info.StartTime = job.MasterMetaExt.SubmitTime.Format(time.RFC3339)
+	}
+	if !job.endTime.IsZero() {
+		info.EndTime = job.endTime.Format(time.RFC3339)
+	}
+	for _, failure := range job.failures {
+		info.Failures = append(info.Failures, &pb.JobFailure{
+			Time:   failure.Time.Format(time.RFC3339),
+			Reason: failure.Reason,
+		})
+	}
+	if job.WorkerHandle == nil {
+		return info
+	}
+	status := job.WorkerHandle.Status()
+	if status == nil {
+		return info
+	}
+	info.Status = &pb.WorkerStatusInfo{
+		WorkerId:     job.WorkerHandle.ID(),
+		Code:         int32(status.Code),
+		ErrorMessage: status.ErrorMessage,
+	}
+	ext, ok := status.Ext.(*lib.JobMasterStatusExt)
+	if !ok {
+		return info
+	}
+	info.Status.Ext = ext.ExtBytes
+	if ext.RejectedConfigVersion > 0 && ext.RejectedConfigVersion == job.MasterMetaExt.ConfigVersion {
+		info.ConfigError = ext.ConfigError
+	}
+	for workerID, workerStatus := range ext.Workers {
+		info.Workers = append(info.Workers, &pb.WorkerStatusInfo{
+			WorkerId:     workerID,
+			Code:         int32(workerStatus.Code),
+			ErrorMessage: workerStatus.ErrorMessage,
+			Ext:          workerStatus.ExtBytes,
+		})
+	}
+	sort.Slice(info.Workers, func(i, j int) bool {
+		return info.Workers[i].WorkerId < info.Workers[j].WorkerId
+	})
+	return info
+}
+
+// buildArchivedJobInfo converts an archived job to pb.JobInfo.
+func buildArchivedJobInfo(archive *jobArchive) *pb.JobInfo {
+	return buildJobInfo(jobHolder{
+		MasterMetaExt: archive.Job,
+		failures:      archive.Failures,
+		endTime:       archive.EndTime,
+		errMsg:        archive.Error,
+	}, archive.State)
+}
@@ -0,0 +1,202 @@
+package servermaster
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/pkg/adapter"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/hanfei1991/microcosm/pkg/metadata"
+)
+
+// storeJob persists a submitted job under JobKeyAdapter, the job must be
+// persisted before it is dispatched, so that it can be recovered after
+// failover even if its job master has never come online. The job ID is
+// reserved by a compare-and-swap txn, ErrJobNameExists is returned if a job
+// with the same ID has been submitted.
+func storeJob(ctx context.Context, metaKV metadata.MetaKV, job *lib.MasterMetaExt) error {
+	value, err := job.Marshal()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "marshal job")
+	}
+	key := adapter.JobKeyAdapter.Encode(job.ID)
+	txn := metaKV.Txn(ctx).(clientv3.Txn)
+	resp, err := txn.If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "store job")
+	}
+	if !resp.Succeeded {
+		return errors.ErrJobNameExists.GenWithStackByArgs(job.ID)
+	}
+	return nil
+}
+
+// updateJob overwrites a job persisted by storeJob with the one carrying the
+// next config version. The job is overwritten by a compare-and-swap txn only
+// if the persisted config version is the previous one, otherwise
+// ErrJobConfigConflict is returned, so that concurrent updates are not lost.
+// ErrJobNotFound is returned if the job has been archived.
+func updateJob(ctx context.Context, metaKV metadata.MetaKV, job *lib.MasterMetaExt) error {
+	value, err := job.Marshal()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "marshal job")
+	}
+	key := adapter.JobKeyAdapter.Encode(job.ID)
+	raw, err := metaKV.Get(ctx, key)
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "load job")
+	}
+	var prevValue []byte
+	for _, kv := range raw.(*clientv3.GetResponse).Kvs {
+		if string(kv.Key) == key {
+			prevValue = kv.Value
+		}
+	}
+	if prevValue == nil {
+		return errors.ErrJobNotFound.GenWithStackByArgs(job.ID)
+	}
+	prev := &lib.MasterMetaExt{}
+	if err := prev.Unmarshal(prevValue); err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "unmarshal job")
+	}
+	if prev.ConfigVersion != job.ConfigVersion-1 {
+		return errors.ErrJobConfigConflict.GenWithStackByArgs(job.ID)
+	}
+
+	txn := metaKV.Txn(ctx).(clientv3.Txn)
+	resp, err := txn.If(clientv3.Compare(clientv3.Value(key), "=", string(prevValue))).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "update job")
+	}
+	if !resp.Succeeded {
+		return errors.ErrJobConfigConflict.GenWithStackByArgs(job.ID)
+	}
+	return nil
+}
+
+// loadAllJobs loads all the jobs persisted by storeJob.
+func loadAllJobs(ctx context.Context, metaKV metadata.MetaKV) ([]*lib.MasterMetaExt, error) {
+	raw, err := metaKV.Get(ctx, adapter.JobKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrMetaOpFail, err, "load jobs")
+	}
+	resp := raw.(*clientv3.GetResponse)
+	jobs := make([]*lib.MasterMetaExt, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		job := &lib.MasterMetaExt{}
+		if err := job.Unmarshal(kv.Value); err != nil {
+			return nil, errors.Wrap(errors.ErrMetaOpFail, err, "unmarshal job")
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// storeJobFailures persists the failures of the job master of a running job
+// under JobFailureKeyAdapter, so that its restart policy is not reset by
+// failover.
+func storeJobFailures(ctx context.Context, metaKV metadata.MetaKV, id lib.MasterID, failures []jobFailure) error {
+	value, err := json.Marshal(failures)
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "marshal job failures")
+	}
+	if _, err := metaKV.Put(ctx, adapter.JobFailureKeyAdapter.Encode(id), string(value)); err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "store job failures")
+	}
+	return nil
+}
+
+// loadJobFailures loads the failures persisted by storeJobFailures, nil is
+// returned if the job master has never failed.
+func loadJobFailures(ctx context.Context, metaKV metadata.MetaKV, id lib.MasterID) ([]jobFailure, error) {
+	key := adapter.JobFailureKeyAdapter.Encode(id)
+	raw, err := metaKV.Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrMetaOpFail, err, "load job failures")
+	}
+	var failures []jobFailure
+	for _, kv := range raw.(*clientv3.GetResponse).Kvs {
+		if string(kv.Key) != key {
+			continue
+		}
+		if err := json.Unmarshal(kv.Value, &failures); err != nil {
+			return nil, errors.Wrap(errors.ErrMetaOpFail, err, "unmarshal job failures")
+		}
+	}
+	return failures, nil
+}
+
+// jobArchive is the record of a terminated job under JobArchiveKeyAdapter.
+type jobArchive struct {
+	Job       *lib.MasterMetaExt `json:"job"`
+	State     JobState           `json:"state"`
+	StartTime time.Time          `json:"start-time"`
+	EndTime   time.Time          `json:"end-time"`
+	Error     string             `json:"error"`
+	Failures  []jobFailure       `json:"failures"`
+}
+
+// archiveJob moves a terminated job from JobKeyAdapter to JobArchiveKeyAdapter,
+// the metadata and the failures of its job master are removed as well.
+func archiveJob(ctx context.Context, metaKV metadata.MetaKV, archive *jobArchive) error {
+	value, err := json.Marshal(archive)
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "marshal job archive")
+	}
+	id := archive.Job.ID
+	txn := metaKV.Txn(ctx).(clientv3.Txn)
+	_, err = txn.Then(
+		clientv3.OpPut(adapter.JobArchiveKeyAdapter.Encode(id), string(value)),
+		clientv3.OpDelete(adapter.JobKeyAdapter.Encode(id)),
+		clientv3.OpDelete(adapter.JobFailureKeyAdapter.Encode(id)),
+		clientv3.OpDelete(adapter.MasterMetaKey.Encode(id)),
+	).Commit()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "archive job")
+	}
+	return nil
+}
+
+// loadArchivedJob loads an archived job, nil is returned if it is not found.
+func loadArchivedJob(ctx context.Context, metaKV metadata.MetaKV, id lib.MasterID) (*jobArchive, error) {
+	archives, err := loadArchivedJobs(ctx, metaKV, adapter.JobArchiveKeyAdapter.Encode(id))
+	if err != nil {
+		return nil, err
+	}
+	for _, archive := range archives {
+		if archive.Job.ID == id {
+			return archive, nil
+		}
+	}
+	return nil, nil
+}
+
+// loadAllArchivedJobs loads all the archived jobs.
+func loadAllArchivedJobs(ctx context.Context, metaKV metadata.MetaKV) ([]*jobArchive, error) {
+	return loadArchivedJobs(ctx, metaKV, adapter.JobArchiveKeyAdapter.Path())
+}
+
+func loadArchivedJobs(ctx context.Context, metaKV metadata.MetaKV, prefix string) ([]*jobArchive, error) {
+	raw, err := metaKV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrMetaOpFail, err, "load job archives")
+	}
+	resp := raw.(*clientv3.GetResponse)
+	archives := make([]*jobArchive, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		archive := &jobArchive{}
+		if err := json.Unmarshal(kv.Value, archive); err != nil {
+			return nil, errors.Wrap(errors.ErrMetaOpFail, err, "unmarshal job archive")
+		}
+		archives = append(archives, archive)
+	}
+	return archives, nil
+}
@@ -0,0 +1,398 @@
+package servermaster
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.uber.org/zap"
+
+	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/adapter"
+	"github.com/hanfei1991/microcosm/pkg/cron"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/hanfei1991/microcosm/pkg/metadata"
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+)
+
+// maxScheduleHistory is the number of the latest submitted jobs kept in the
+// history of a schedule.
+const maxScheduleHistory = 10
+
+// jobSchedule is a job template submitted periodically according to a cron
+// expression, it is persisted under ScheduleKeyAdapter.
+type jobSchedule struct {
+	Name     string               `json:"name"`
+	Cron     string               `json:"cron"`
+	Tp       pb.JobType           `json:"type"`
+	Config   []byte               `json:"config"`
+	Priority int32                `json:"priority,omitempty"`
+	Policy   pb.ConcurrencyPolicy `json:"policy"`
+	// LastTime is the last scheduled time that has been handled, the next
+	// job is submitted at the first scheduled time after it.
+	LastTime time.Time      `json:"last-time"`
+	History  []scheduledJob `json:"history"`
+
+	schedule *cron.Schedule
+}
+
+// scheduledJob is a job submitted by a schedule.
+type scheduledJob struct {
+	JobID        lib.MasterID `json:"job-id"`
+	ScheduleTime time.Time    `json:"schedule-time"`
+}
+
+func (s *jobSchedule) nextTime() time.Time {
+	return s.schedule.Next(s.LastTime)
+}
+
+// due returns the latest scheduled time that is not after now, the missed
+// scheduled times before it are skipped.
+func (s *jobSchedule) due(now time.Time) (time.Time, bool) {
+	next := s.nextTime()
+	if next.IsZero() || next.After(now) {
+		return time.Time{}, false
+	}
+	for {
+		later := s.schedule.Next(next)
+		if later.IsZero() || later.After(now) {
+			return next, true
+		}
+		next = later
+	}
+}
+
+// jobID returns the ID of the job submitted at the given scheduled time, the
+// ID is deterministic so that a job is not submitted twice after failover.
+func (s *jobSchedule) jobID(scheduleTime time.Time) lib.MasterID {
+	return s.Name + "-" + scheduleTime.UTC().Format("200601021504")
+}
+
+// storeSchedule persists a new schedule, ErrScheduleNameExists is returned if
+// a schedule with the same name exists.
+func storeSchedule(ctx context.Context, metaKV metadata.MetaKV, s *jobSchedule) error {
+	value, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "marshal schedule")
+	}
+	key := adapter.ScheduleKeyAdapter.Encode(s.Name)
+	txn := metaKV.Txn(ctx).(clientv3.Txn)
+	resp, err := txn.If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "store schedule")
+	}
+	if !resp.Succeeded {
+		return errors.ErrScheduleNameExists.GenWithStackByArgs(s.Name)
+	}
+	return nil
+}
+
+// updateSchedule overwrites a schedule persisted by storeSchedule,
+// ErrScheduleNotFound is returned if the schedule has been deleted.
+func updateSchedule(ctx context.Context, metaKV metadata.MetaKV, s *jobSchedule) error {
+	value, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "marshal schedule")
+	}
+	key := adapter.ScheduleKeyAdapter.Encode(s.Name)
+	txn := metaKV.Txn(ctx).(clientv3.Txn)
+	resp, err := txn.If(clientv3.Compare(clientv3.CreateRevision(key), ">", 0)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "update schedule")
+	}
+	if !resp.Succeeded {
+		return errors.ErrScheduleNotFound.GenWithStackByArgs(s.Name)
+	}
+	return nil
+}
+
+// deleteSchedule deletes a schedule, ErrScheduleNotFound is returned if the
+// schedule does not exist.
+func deleteSchedule(ctx context.Context, metaKV metadata.MetaKV, name string) error {
+	key := adapter.ScheduleKeyAdapter.Encode(name)
+	txn := metaKV.Txn(ctx).(clientv3.Txn)
+	resp, err := txn.If(clientv3.Compare(clientv3.CreateRevision(key), ">", 0)).
+		Then(clientv3.OpDelete(key)).
+		Commit()
+	if err != nil {
+		return errors.Wrap(errors.ErrMetaOpFail, err, "delete schedule")
+	}
+	if !resp.Succeeded {
+		return errors.ErrScheduleNotFound.GenWithStackByArgs(name)
+	}
+	return nil
+}
+
+// loadAllSchedules loads all the schedules persisted by storeSchedule.
+func loadAllSchedules(ctx context.Context, metaKV metadata.MetaKV) (map[string]*jobSchedule, error) {
+	raw, err := metaKV.Get(ctx, adapter.ScheduleKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrMetaOpFail, err, "load schedules")
+	}
+	resp := raw.(*clientv3.GetResponse)
+	schedules := make(map[string]*jobSchedule, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		s := &jobSchedule{}
+		if err := json.Unmarshal(kv.Value, s); err != nil {
+			return nil, errors.Wrap(errors.ErrMetaOpFail, err, "unmarshal schedule")
+		}
+		s.schedule, err = cron.Parse(s.Cron)
+		if err != nil {
+			return nil, err
+		}
+		schedules[s.Name] = s
+	}
+	return schedules, nil
+}
+
+// CreateSchedule processes "CreateScheduleRequest", the first job is
+// submitted at the first scheduled time after the schedule is created.
+func (jm *JobManagerImplV2) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) *pb.CreateScheduleResponse {
+	log.L().Info("create schedule", zap.String("name", req.Name), zap.String("cron", req.Cron))
+	resp := &pb.CreateScheduleResponse{}
+	s := &jobSchedule{
+		Name:     req.Name,
+		Cron:     req.Cron,
+		Tp:       req.Tp,
+		Config:   req.Config,
+		Priority: req.Priority,
+		Policy:   req.Policy,
+		LastTime: jm.jobFsm.clock.Now().UTC(),
+	}
+	if err := validateSchedule(s); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	var err error
+	s.schedule, err = cron.Parse(s.Cron)
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+
+	jm.schedulesMu.Lock()
+	defer jm.schedulesMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, metaOpTimeout)
+	defer cancel()
+	if err := jm.loadSchedules(ctx); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	if err := storeSchedule(ctx, jm.BaseMaster.MetaKVClient(), s); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	jm.schedules[s.Name] = s
+	if next := s.nextTime(); !next.IsZero() {
+		resp.NextTime = next.Format(time.RFC3339)
+	}
+	return resp
+}
+
+// validateSchedule checks the job template of a schedule, the same way as
+// SubmitJob does.
+func validateSchedule(s *jobSchedule) error {
+	if s.Name == "" {
+		return errors.ErrBuildJobFailed.GenWithStack("schedule name is empty")
+	}
+	switch s.Tp {
+	case pb.JobType_CVSDemo:
+		if err := validateJobConfig(lib.CvsJobMaster, s.Config); err != nil {
+			return err
+		}
+	case pb.JobType_FakeJob:
+		if len(s.Config) == 0 {
+			return nil
+		}
+	default:
+		return errors.ErrBuildJobFailed.GenWithStack("unknown job type: %s", s.Tp)
+	}
+	_, err := parseRestartPolicy(s.Config)
+	return err
+}
+
+// DeleteSchedule processes "DeleteScheduleRequest".
+func (jm *JobManagerImplV2) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) *pb.DeleteScheduleResponse {
+	log.L().Info("delete schedule", zap.String("name", req.Name))
+	resp := &pb.DeleteScheduleResponse{}
+
+	jm.schedulesMu.Lock()
+	defer jm.schedulesMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, metaOpTimeout)
+	defer cancel()
+	if err := deleteSchedule(ctx, jm.BaseMaster.MetaKVClient(), req.Name); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	delete(jm.schedules, req.Name)
+	return resp
+}
+
+// ListSchedules processes "ListSchedulesRequest", the schedules are returned
+// with the states of the jobs they have submitted.
+func (jm *JobManagerImplV2) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) *pb.ListSchedulesResponse {
+	resp := &pb.ListSchedulesResponse{}
+
+	jm.schedulesMu.Lock()
+	defer jm.schedulesMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, metaOpTimeout)
+	defer cancel()
+	if err := jm.loadSchedules(ctx); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	for _, s := range jm.schedules {
+		states, err := jm.jobStates(ctx, s.historyJobIDs())
+		if err != nil {
+			resp.Err = errors.ToPBError(err)
+			return resp
+		}
+		info := &pb.ScheduleInfo{
+			Name:     s.Name,
+			Cron:     s.Cron,
+			Tp:       s.Tp,
+			Config:   s.Config,
+			Priority: s.Priority,
+			Policy:   s.Policy,
+		}
+		if next := s.nextTime(); !next.IsZero() {
+			info.NextTime = next.Format(time.RFC3339)
+		}
+		for _, job := range s.History {
+			info.History = append(info.History, &pb.ScheduledJob{
+				JobIdStr:     job.JobID,
+				ScheduleTime: job.ScheduleTime.Format(time.RFC3339),
+				State:        jobStateToPB[states[job.JobID]],
+			})
+		}
+		resp.Schedules = append(resp.Schedules, info)
+	}
+	sort.Slice(resp.Schedules, func(i, j int) bool {
+		return resp.Schedules[i].Name < resp.Schedules[j].Name
+	})
+	return resp
+}
+
+func (s *jobSchedule) historyJobIDs() []lib.MasterID {
+	ids := make([]lib.MasterID, 0, len(s.History))
+	for _, job := range s.History {
+		ids = append(ids, job.JobID)
+	}
+	return ids
+}
+
+// loadSchedules loads the schedules from metastore if they have not been
+// loaded since the JobManager is created, the caller should hold schedulesMu.
+func (jm *JobManagerImplV2) loadSchedules(ctx context.Context) error {
+	if jm.schedules != nil {
+		return nil
+	}
+	schedules, err := loadAllSchedules(ctx, jm.BaseMaster.MetaKVClient())
+	if err != nil {
+		return err
+	}
+	jm.schedules = schedules
+	return nil
+}
+
+// runSchedules submits the jobs of the due schedules, a schedule failed to
+// submit its job is retried in the next tick.
+func (jm *JobManagerImplV2) runSchedules(ctx context.Context) {
+	jm.schedulesMu.Lock()
+	defer jm.schedulesMu.Unlock()
+
+	if err := jm.loadSchedules(ctx); err != nil {
+		log.L().Warn("load schedules failed", zap.Error(err))
+		return
+	}
+	now := jm.jobFsm.clock.Now().UTC()
+	for _, s := range jm.schedules {
+		scheduleTime, ok := s.due(now)
+		if !ok {
+			continue
+		}
+		if err := jm.runSchedule(ctx, s, scheduleTime); err != nil {
+			log.L().Warn("run schedule failed", zap.String("name", s.Name), zap.Error(err))
+		}
+	}
+}
+
+// runSchedule submits the job of a schedule at the given scheduled time
+// according to its concurrency policy.
+func (jm *JobManagerImplV2) runSchedule(ctx context.Context, s *jobSchedule, scheduleTime time.Time) error {
+	states, err := jm.jobStates(ctx, s.historyJobIDs())
+	if err != nil {
+		return err
+	}
+	var running []lib.MasterID
+	for _, job := range s.History {
+		switch states[job.JobID] {
+		case 0, JobStateFinished, JobStateFailed, JobStateCanceled:
+		default:
+			running = append(running, job.JobID)
+		}
+	}
+
+	submit := true
+	if len(running) > 0 {
+		switch s.Policy {
+		case pb.ConcurrencyPolicy_Forbid:
+			log.L().Info("schedule is skipped because its jobs are running",
+				zap.String("name", s.Name), zap.Strings("running", running))
+			submit = false
+		case pb.ConcurrencyPolicy_Replace:
+			for _, id := range running {
+				resp := jm.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: id})
+				if resp.Err != nil {
+					// the job may have been terminated after its state is
+					// checked, the new job is submitted anyway
+					log.L().Warn("cancel scheduled job failed", zap.String("name", s.Name),
+						zap.String("job-id", id), zap.String("error", resp.Err.Message))
+				}
+			}
+		}
+	}
+	updated := *s
+	if submit {
+		id := s.jobID(scheduleTime)
+		resp := jm.SubmitJob(ctx, &pb.SubmitJobRequest{
+			Tp:       s.Tp,
+			Config:   s.Config,
+			JobName:  id,
+			Priority: s.Priority,
+		})
+		// The job has been submitted if its ID exists, for example the
+		// schedule failed to be updated before failover.
+		if resp.Err != nil && resp.Err.Code != pb.ErrorCode_JobNameExists {
+			return perrors.Errorf("submit job %s: %s", id, resp.Err.Message)
+		}
+		log.L().Info("scheduled job is submitted", zap.String("name", s.Name),
+			zap.String("job-id", id), zap.Time("schedule-time", scheduleTime))
+		history := make([]scheduledJob, 0, len(s.History)+1)
+		history = append(history, s.History...)
+		history = append(history, scheduledJob{JobID: id, ScheduleTime: scheduleTime})
+		if len(history) > maxScheduleHistory {
+			history = history[len(history)-maxScheduleHistory:]
+		}
+		updated.History = history
+	}
+	updated.LastTime = scheduleTime
+	// The schedule in memory is kept unchanged if it fails to be persisted,
+	// so that it's run again in the next tick.
+	if err := updateSchedule(ctx, jm.BaseMaster.MetaKVClient(), &updated); err != nil {
+		return err
+	}
+	*s = updated
+	return nil
+}
@@ -0,0 +1,103 @@
+package servermaster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/adapter"
+	"github.com/hanfei1991/microcosm/pkg/clock"
+)
+
+func TestJobManagerSchedules(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "schedule-test")
+	mockClock := clock.NewMock()
+	mockClock.Set(time.Date(2022, 1, 14, 10, 1, 0, 0, time.UTC))
+	mgr.jobFsm.clock = mockClock
+
+	createResp := mgr.CreateSchedule(ctx, &pb.CreateScheduleRequest{
+		Name: "invalid", Cron: "* * *", Tp: pb.JobType_FakeJob,
+	})
+	require.NotNil(t, createResp.Err)
+
+	req := &pb.CreateScheduleRequest{
+		Name:   "report",
+		Cron:   "*/10 * * * *",
+		Tp:     pb.JobType_FakeJob,
+		Policy: pb.ConcurrencyPolicy_Forbid,
+	}
+	createResp = mgr.CreateSchedule(ctx, req)
+	require.Nil(t, createResp.Err)
+	require.Equal(t, "2022-01-14T10:10:00Z", createResp.NextTime)
+	createResp = mgr.CreateSchedule(ctx, req)
+	require.NotNil(t, createResp.Err)
+
+	// not due yet
+	mgr.runSchedules(ctx)
+	require.Equal(t, 0, mgr.jobFsm.QueuedJobCount())
+
+	// the missed scheduled times are skipped
+	mockClock.Add(25 * time.Minute)
+	mgr.runSchedules(ctx)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+	_, state, err := mgr.jobFsm.QueryJob("report-202201141020")
+	require.Nil(t, err)
+	require.Equal(t, JobStateQueued, state)
+
+	// the job is still running, the next one is forbidden
+	mockClock.Add(10 * time.Minute)
+	mgr.runSchedules(ctx)
+	listResp := mgr.ListSchedules(ctx, &pb.ListSchedulesRequest{})
+	require.Nil(t, listResp.Err)
+	require.Len(t, listResp.Schedules, 1)
+	require.Equal(t, "2022-01-14T10:40:00Z", listResp.Schedules[0].NextTime)
+	require.Equal(t, []*pb.ScheduledJob{{
+		JobIdStr:     "report-202201141020",
+		ScheduleTime: "2022-01-14T10:20:00Z",
+		State:        pb.JobInfo_Queued,
+	}}, listResp.Schedules[0].History)
+
+	// the schedules are loaded from metastore after failover, and the
+	// running job is replaced
+	mgr.schedules, err = loadAllSchedules(ctx, mgr.MetaKVClient())
+	require.Nil(t, err)
+	mgr.schedules["report"].Policy = pb.ConcurrencyPolicy_Replace
+	mockClock.Add(10 * time.Minute)
+	mgr.runSchedules(ctx)
+	_, state, err = mgr.jobFsm.QueryJob("report-202201141020")
+	require.Nil(t, err)
+	require.Equal(t, JobStateCanceled, state)
+	_, _, err = mgr.jobFsm.QueryJob("report-202201141040")
+	require.Nil(t, err)
+	schedules, err := loadAllSchedules(ctx, mgr.MetaKVClient())
+	require.Nil(t, err)
+	require.Len(t, schedules["report"].History, 2)
+	require.Equal(t, time.Date(2022, 1, 14, 10, 40, 0, 0, time.UTC), schedules["report"].LastTime)
+
+	// the schedule in memory is unchanged if it fails to be persisted
+	_, err = mgr.MetaKVClient().Delete(ctx, adapter.ScheduleKeyAdapter.Encode("report"))
+	require.Nil(t, err)
+	mockClock.Add(10 * time.Minute)
+	mgr.runSchedules(ctx)
+	require.Len(t, mgr.schedules["report"].History, 2)
+	require.Equal(t, time.Date(2022, 1, 14, 10, 40, 0, 0, time.UTC), mgr.schedules["report"].LastTime)
+	require.Nil(t, storeSchedule(ctx, mgr.MetaKVClient(), mgr.schedules["report"]))
+
+	deleteResp := mgr.DeleteSchedule(ctx, &pb.DeleteScheduleRequest{Name: "report"})
+	require.Nil(t, deleteResp.Err)
+	deleteResp = mgr.DeleteSchedule(ctx, &pb.DeleteScheduleRequest{Name: "report"})
+	require.NotNil(t, deleteResp.Err)
+	listResp = mgr.ListSchedules(ctx, &pb.ListSchedulesRequest{})
+	require.Nil(t, listResp.Err)
+	require.Empty(t, listResp.Schedules)
+}
@@ -3,6 +3,9 @@ package servermaster
 import (
 	"context"
 	"encoding/json"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/hanfei1991/microcosm/client"
 	cvs "github.com/hanfei1991/microcosm/jobmaster/cvsJob"
@@ -24,9 +27,20 @@ type JobManager interface {
 	SubmitJob(ctx context.Context, req *pb.SubmitJobRequest) *pb.SubmitJobResponse
 	CancelJob(ctx context.Context, req *pb.CancelJobRequest) *pb.CancelJobResponse
 	PauseJob(ctx context.Context, req *pb.PauseJobRequest) *pb.PauseJobResponse
+	ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) *pb.ResumeJobResponse
+	QueryJob(ctx context.Context, req *pb.QueryJobRequest) *pb.QueryJobResponse
+	ListJobs(ctx context.Context, req *pb.ListJobsRequest) *pb.ListJobsResponse
+	UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) *pb.UpdateJobConfigResponse
+	CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) *pb.CreateScheduleResponse
+	DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) *pb.DeleteScheduleResponse
+	ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) *pb.ListSchedulesResponse
+	EvictWorker(ctx context.Context, workerID lib.WorkerID, reason error) error
 }
 
-const defaultJobMasterCost = 1
+const (
+	defaultJobMasterCost = 1
+	metaOpTimeout        = 3 * time.Second
+)
 
 // JobManagerImplV2 is a special job master that manages all the job masters, and notify the offline executor to them.
 // worker state transition
@@ -34,6 +48,13 @@ const defaultJobMasterCost = 1
 // - receive worker online, move job from `waitAckJobs` to `onlineJobs`.
 // - receive worker offline, move job from `onlineJobs` to `pendingJobs`.
 // - Tick checks `pendingJobs` periodically	and reschedules the jobs.
+// - a job is failed when its job master has failed too many times according
+// to the restart policy in the job config, the failures are persisted so
+// that they are counted across failover.
+// - a job whose job master is rejected for lack of cluster resource waits in
+// the admission queue, Tick admits the queued jobs by priority.
+// - a job that depends on other jobs is blocked until they are finished.
+// - Tick submits the jobs of the schedules whose cron expressions are due.
 type JobManagerImplV2 struct {
 	lib.BaseMaster
 
@@ -44,14 +65,235 @@ type JobManagerImplV2 struct {
 	serverMasterClient    client.MasterClient
 	jobFsm                *JobFsm
 	uuidGen               uuid.Generator
+
+	// configMu serializes the config updates, so that the config versions
+	// are increased one by one.
+	configMu sync.Mutex
+
+	// schedules are loaded from metastore when they are accessed for the
+	// first time, they are nil before that.
+	schedulesMu sync.Mutex
+	schedules   map[string]*jobSchedule
 }
 
+// PauseJob processes "PauseJobRequest". The paused state is persisted in the
+// metadata of the job master first, so that a job master which is not online
+// yet or is failed over starts paused, then the online job master is asked to
+// pause itself and all of its workers.
 func (jm *JobManagerImplV2) PauseJob(ctx context.Context, req *pb.PauseJobRequest) *pb.PauseJobResponse {
-	panic("not implemented")
+	log.L().Info("pause job", zap.String("job-id", req.JobIdStr))
+	resp := &pb.PauseJobResponse{}
+	handle, err := jm.jobFsm.JobHandle(req.JobIdStr)
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	if err := jm.updateJobStatus(ctx, req.JobIdStr, lib.MasterStatusPaused); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	if handle != nil {
+		if err := jm.BaseMaster.PauseWorker(ctx, handle.ID()); err != nil {
+			resp.Err = errors.ToPBError(err)
+		}
+	}
+	return resp
 }
 
+// ResumeJob processes "ResumeJobRequest", it is the reverse of PauseJob.
+func (jm *JobManagerImplV2) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) *pb.ResumeJobResponse {
+	log.L().Info("resume job", zap.String("job-id", req.JobIdStr))
+	resp := &pb.ResumeJobResponse{}
+	handle, err := jm.jobFsm.JobHandle(req.JobIdStr)
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	if err := jm.updateJobStatus(ctx, req.JobIdStr, lib.MasterStatusNormal); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	if handle != nil {
+		if err := jm.BaseMaster.ResumeWorker(ctx, handle.ID()); err != nil {
+			resp.Err = errors.ToPBError(err)
+		}
+	}
+	return resp
+}
+
+// CancelJob processes "CancelJobRequest". The job master is asked to stop all
+// of its workers and then exit, the job is removed from JobFsm and marked as
+// canceled in metastore after the job master goes offline. If the stop message
+// is not delivered, the job stays in Canceling state and the error is returned,
+// the job can be canceled again to resend the stop message.
 func (jm *JobManagerImplV2) CancelJob(ctx context.Context, req *pb.CancelJobRequest) *pb.CancelJobResponse {
-	panic("not implemented")
+	log.L().Info("cancel job", zap.String("job-id", req.JobIdStr))
+	resp := &pb.CancelJobResponse{}
+	handle, canceled, err := jm.jobFsm.JobCancel(req.JobIdStr)
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	if canceled {
+		// the job has no running job master, mark it canceled directly
+		if err := jm.updateJobStatus(ctx, req.JobIdStr, lib.MasterStatusCanceled); err != nil {
+			resp.Err = errors.ToPBError(err)
+		}
+		return resp
+	}
+	// handle is nil if the job master is not online yet, it will be asked to
+	// stop in OnWorkerOnline.
+	if handle != nil {
+		if err := jm.BaseMaster.StopWorker(ctx, handle.ID()); err != nil {
+			resp.Err = errors.ToPBError(err)
+		}
+	}
+	return resp
+}
+
+// QueryJob processes "QueryJobRequest", it returns the state and config of the
+// job, and the statuses of the job master and all of its workers. The archive
+// is looked up if the job is not in JobFsm.
+func (jm *JobManagerImplV2) QueryJob(ctx context.Context, req *pb.QueryJobRequest) *pb.QueryJobResponse {
+	resp := &pb.QueryJobResponse{}
+	job, state, err := jm.jobFsm.QueryJob(req.JobIdStr)
+	if err == nil {
+		resp.Job = buildJobInfo(job, state)
+		return resp
+	}
+	if !errors.ErrJobNotFound.Equal(err) {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	archive, err2 := loadArchivedJob(ctx, jm.BaseMaster.MetaKVClient(), req.JobIdStr)
+	if err2 != nil {
+		resp.Err = errors.ToPBError(err2)
+		return resp
+	}
+	if archive == nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	resp.Job = buildArchivedJobInfo(archive)
+	return resp
+}
+
+// ListJobs processes "ListJobsRequest", the jobs are filtered by the states
+// in the request, the archived jobs are included.
+func (jm *JobManagerImplV2) ListJobs(ctx context.Context, req *pb.ListJobsRequest) *pb.ListJobsResponse {
+	resp := &pb.ListJobsResponse{}
+	states := make(map[pb.JobInfo_State]struct{}, len(req.States))
+	for _, state := range req.States {
+		states[state] = struct{}{}
+	}
+	jobs := make(map[lib.MasterID]*pb.JobInfo)
+	jm.jobFsm.IterJobs(func(job jobHolder, state JobState) {
+		jobs[job.MasterMetaExt.ID] = buildJobInfo(job, state)
+	})
+	archives, err := loadAllArchivedJobs(ctx, jm.BaseMaster.MetaKVClient())
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	for _, archive := range archives {
+		if _, ok := jobs[archive.Job.ID]; !ok {
+			jobs[archive.Job.ID] = buildArchivedJobInfo(archive)
+		}
+	}
+	for _, info := range jobs {
+		if _, ok := states[info.State]; len(states) > 0 && !ok {
+			continue
+		}
+		resp.Jobs = append(resp.Jobs, info)
+	}
+	sort.Slice(resp.Jobs, func(i, j int) bool {
+		return resp.Jobs[i].JobIdStr < resp.Jobs[j].JobIdStr
+	})
+	return resp
+}
+
+// UpdateJobConfig processes "UpdateJobConfigRequest". The updated config is
+// persisted with an increased config version, then it is sent to the job
+// master if the job master is online. The job master applies the config
+// asynchronously, and the rejection is reported in the status of the job
+// master. A job master that is not online yet receives the config when it
+// comes online.
+func (jm *JobManagerImplV2) UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) *pb.UpdateJobConfigResponse {
+	log.L().Info("update job config", zap.String("job-id", req.JobIdStr),
+		zap.String("config", string(req.Config)))
+	resp := &pb.UpdateJobConfigResponse{}
+
+	jm.configMu.Lock()
+	defer jm.configMu.Unlock()
+
+	// The job being canceled or terminated is treated as not found.
+	holder, state, err := jm.jobFsm.QueryJob(req.JobIdStr)
+	if err == nil {
+		switch state {
+		case JobStateCanceling, JobStateFinished, JobStateFailed, JobStateCanceled:
+			err = errors.ErrJobNotFound.GenWithStackByArgs(req.JobIdStr)
+		}
+	}
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	job := *holder.MasterMetaExt
+	config := req.Config
+	if job.Tp == lib.FakeJobMaster && len(config) == 0 {
+		// FakeJob is submitted with an empty config as well.
+		config = []byte("{}")
+	}
+	if err := validateJobConfig(job.Tp, config); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	job.RestartPolicy, err = parseRestartPolicy(config)
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	job.Config = config
+	job.ConfigVersion++
+
+	ctx, cancel := context.WithTimeout(ctx, metaOpTimeout)
+	defer cancel()
+	if err := updateJob(ctx, jm.BaseMaster.MetaKVClient(), &job); err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	handle, err := jm.jobFsm.JobConfigUpdated(&job)
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	if handle != nil {
+		err := jm.BaseMaster.UpdateWorkerConfig(ctx, handle.ID(), job.Config, job.ConfigVersion)
+		if err != nil {
+			resp.Err = errors.ToPBError(err)
+			return resp
+		}
+	}
+	resp.ConfigVersion = job.ConfigVersion
+	return resp
+}
+
+// validateJobConfig checks whether the raw config can be decoded to the
+// config of the given job type.
+func validateJobConfig(tp lib.WorkerType, config []byte) error {
+	var err error
+	switch tp {
+	case lib.CvsJobMaster:
+		err = json.Unmarshal(config, &cvs.Config{})
+	default:
+		if !json.Valid(config) {
+			err = errors.ErrBuildJobFailed.GenWithStack("invalid json")
+		}
+	}
+	if err != nil {
+		return errors.ErrBuildJobFailed.GenWithStack("failed to decode config: %s", config)
+	}
+	return nil
 }
 
 // SubmitJob processes "SubmitJobRequest".
@@ -62,10 +304,20 @@ func (jm *JobManagerImplV2) SubmitJob(ctx context.Context, req *pb.SubmitJobRequ
 		id  lib.WorkerID
 		err error
 	)
+	// The job name provided by user is used as the job ID, its uniqueness is
+	// checked when the job is persisted.
 	job := &lib.MasterMetaExt{
-		// TODO: we can use job name provided from user, but we must check the
-		// job name is unique before using it.
-		ID: jm.uuidGen.NewString(),
+		ID:         req.JobName,
+		SubmitTime: time.Now(),
+		Priority:   req.Priority,
+		DependsOn:  req.DependsOn,
+	}
+	if job.ID == "" {
+		job.ID = jm.uuidGen.NewString()
+	} else if job.ID == jm.BaseMaster.MasterID() {
+		err := errors.ErrJobNameExists.GenWithStackByArgs(job.ID)
+		resp.Err = errors.ToPBError(err)
+		return resp
 	}
 	switch req.Tp {
 	case pb.JobType_CVSDemo:
@@ -79,15 +331,74 @@ func (jm *JobManagerImplV2) SubmitJob(ctx context.Context, req *pb.SubmitJobRequ
 		}
 		job.Tp = lib.CvsJobMaster
 		job.Config = req.Config
+		job.RestartPolicy, err = parseRestartPolicy(req.Config)
+		if err != nil {
+			resp.Err = errors.ToPBError(err)
+			return resp
+		}
 	case pb.JobType_FakeJob:
 		job.Tp = lib.FakeJobMaster
+		job.Config = []byte("{}")
+		if len(req.Config) > 0 {
+			job.RestartPolicy, err = parseRestartPolicy(req.Config)
+			if err != nil {
+				resp.Err = errors.ToPBError(err)
+				return resp
+			}
+		}
 	default:
 		err := errors.ErrBuildJobFailed.GenWithStack("unknown job type: %s", req.Tp)
 		resp.Err = errors.ToPBError(err)
 		return resp
 	}
 
-	// TODO: data persistence for masterConfig
+	ctx, cancel := context.WithTimeout(ctx, metaOpTimeout)
+	defer cancel()
+	upstreamStates, err := jm.jobStates(ctx, job.DependsOn)
+	if err != nil {
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+	for _, upstream := range job.DependsOn {
+		if upstream == job.ID {
+			err := errors.ErrBuildJobFailed.GenWithStack("job %s depends on itself", job.ID)
+			resp.Err = errors.ToPBError(err)
+			return resp
+		}
+		if _, ok := upstreamStates[upstream]; !ok {
+			err := errors.ErrJobNotFound.GenWithStackByArgs(upstream)
+			resp.Err = errors.ToPBError(err)
+			return resp
+		}
+	}
+
+	// The job is persisted before it is dispatched, the job ID is returned
+	// only if the job can be recovered after failover.
+	if err := storeJob(ctx, jm.BaseMaster.MetaKVClient(), job); err != nil {
+		log.L().Error("persist job met error", zap.Error(err))
+		resp.Err = errors.ToPBError(err)
+		return resp
+	}
+
+	if len(job.DependsOn) > 0 {
+		// The job is started in Tick after its upstream jobs are finished, or
+		// it is terminated at once if any of them has failed.
+		blocked, terminated := jm.jobFsm.JobBlocked(job, upstreamStates)
+		if blocked || terminated {
+			log.L().Info("job is blocked by upstream jobs", zap.String("job-id", job.ID),
+				zap.Strings("depends-on", job.DependsOn), zap.Bool("terminated", terminated))
+			resp.JobIdStr = job.ID
+			return resp
+		}
+	}
+
+	// The job waits behind the queued jobs, it is admitted in Tick.
+	if jm.jobFsm.AdmissionBlocked() {
+		log.L().Info("job is queued", zap.String("job-id", job.ID))
+		jm.jobFsm.JobQueued(job)
+		resp.JobIdStr = job.ID
+		return resp
+	}
 
 	// CreateWorker here is to create job master actually
 	// TODO: use correct worker type and worker cost
@@ -105,6 +416,55 @@ func (jm *JobManagerImplV2) SubmitJob(ctx context.Context, req *pb.SubmitJobRequ
 	return resp
 }
 
+// jobStates returns the states of the given jobs, which are looked up in
+// JobFsm and then in the archive. The jobs that are not found are omitted.
+func (jm *JobManagerImplV2) jobStates(ctx context.Context, ids []lib.MasterID) (map[lib.MasterID]JobState, error) {
+	states := make(map[lib.MasterID]JobState, len(ids))
+	for _, id := range ids {
+		_, state, err := jm.jobFsm.QueryJob(id)
+		if err == nil {
+			states[id] = state
+			continue
+		}
+		archive, err := loadArchivedJob(ctx, jm.BaseMaster.MetaKVClient(), id)
+		if err != nil {
+			return nil, err
+		}
+		if archive != nil {
+			states[id] = archive.State
+		}
+	}
+	return states, nil
+}
+
+// parseRestartPolicy parses the "restart-policy" field of the raw job config,
+// the omitted fields are filled by lib.DefaultRestartPolicy.
+func parseRestartPolicy(config []byte) (*lib.RestartPolicy, error) {
+	var jobConfig struct {
+		RestartPolicy *lib.RestartPolicy `json:"restart-policy"`
+	}
+	if err := json.Unmarshal(config, &jobConfig); err != nil {
+		return nil, errors.ErrBuildJobFailed.GenWithStack("failed to decode config: %s", config)
+	}
+	policy := jobConfig.RestartPolicy
+	if policy == nil {
+		return nil, nil
+	}
+	if policy.MaxAttempts < 0 || policy.InitialBackoffMs < 0 || policy.MaxBackoffMs < 0 {
+		return nil, errors.ErrBuildJobFailed.GenWithStack("invalid restart policy: %+v", *policy)
+	}
+	if policy.InitialBackoffMs == 0 {
+		policy.InitialBackoffMs = lib.DefaultRestartPolicy.InitialBackoffMs
+	}
+	if policy.MaxBackoffMs == 0 {
+		policy.MaxBackoffMs = lib.DefaultRestartPolicy.MaxBackoffMs
+	}
+	if policy.MaxBackoffMs < policy.InitialBackoffMs {
+		policy.MaxBackoffMs = policy.InitialBackoffMs
+	}
+	return policy, nil
+}
+
 // NewJobManagerImplV2 creates a new JobManagerImplV2 instance
 func NewJobManagerImplV2(
 	dctx *dcontext.Context,
@@ -143,29 +503,180 @@ func NewJobManagerImplV2(
 
 // InitImpl implements lib.MasterImpl.InitImpl
 func (jm *JobManagerImplV2) InitImpl(ctx context.Context) error {
-	// TODO: recover existing job masters from metastore
 	return nil
 }
 
 // Tick implements lib.MasterImpl.Tick
 func (jm *JobManagerImplV2) Tick(ctx context.Context) error {
-	return jm.jobFsm.IterPendingJobs(
-		func(job *lib.MasterMetaExt) (string, error) {
-			return jm.BaseMaster.CreateWorker(
-				job.Tp, job, defaultJobMasterCost)
-		})
+	// Don't dispatch any job before the running job masters are taken over,
+	// otherwise a job could have two job masters.
+	if !jm.BaseMaster.IsMasterReady() {
+		return nil
+	}
+	for _, id := range jm.jobFsm.PendUnadoptedJobs() {
+		if err := jm.onJobCanceled(id); err != nil {
+			return err
+		}
+	}
+	if err := jm.checkCompletedJobs(ctx); err != nil {
+		return err
+	}
+	failed := jm.jobFsm.IterPendingJobs(jm.createJobMaster)
+	failed = append(failed, jm.jobFsm.AdmitQueuedJobs(jm.createJobMaster)...)
+	for _, id := range failed {
+		if err := jm.onJobFailed(id); err != nil {
+			return err
+		}
+	}
+	jm.saveFailures(ctx)
+	jm.archiveJobs(ctx)
+	jm.runSchedules(ctx)
+	return nil
+}
+
+// saveFailures persists the failures of the job masters recorded since the
+// last tick, the ones failed to be persisted are retried in the next tick.
+func (jm *JobManagerImplV2) saveFailures(ctx context.Context) {
+	for id, failures := range jm.jobFsm.UnsavedFailures() {
+		if err := storeJobFailures(ctx, jm.BaseMaster.MetaKVClient(), id, failures); err != nil {
+			log.L().Warn("save job failures failed", zap.String("job-id", id), zap.Error(err))
+			continue
+		}
+		jm.jobFsm.FailuresSaved(id, len(failures))
+	}
+}
+
+// createJobMaster creates the job master of a pending or queued job.
+func (jm *JobManagerImplV2) createJobMaster(job *lib.MasterMetaExt) (string, error) {
+	return jm.BaseMaster.CreateWorker(job.Tp, job, defaultJobMasterCost)
+}
+
+// checkCompletedJobs persists the final status of the jobs whose job masters
+// have reported completion, and asks the job masters to exit.
+func (jm *JobManagerImplV2) checkCompletedJobs(ctx context.Context) error {
+	finished, failed := jm.jobFsm.CheckCompletedJobs()
+	for _, handle := range finished {
+		log.L().Info("job is finished", zap.String("job-id", handle.ID()))
+		if err := jm.updateJobStatus(ctx, handle.ID(), lib.MasterStatusFinished); err != nil {
+			return err
+		}
+		if err := jm.stopJobMaster(ctx, handle.ID()); err != nil {
+			return err
+		}
+	}
+	for _, handle := range failed {
+		if err := jm.onJobFailed(handle.ID()); err != nil {
+			return err
+		}
+		if err := jm.stopJobMaster(ctx, handle.ID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveJobs moves the terminated jobs to the archive, the ones failed to be
+// archived are retried in the next tick.
+func (jm *JobManagerImplV2) archiveJobs(ctx context.Context) {
+	for _, job := range jm.jobFsm.TerminatedJobs() {
+		archive := &jobArchive{
+			Job:       job.MasterMetaExt,
+			State:     job.state,
+			StartTime: job.MasterMetaExt.SubmitTime,
+			EndTime:   job.endTime,
+			Error:     job.errMsg,
+			Failures:  job.failures,
+		}
+		if err := archiveJob(ctx, jm.BaseMaster.MetaKVClient(), archive); err != nil {
+			log.L().Warn("archive job failed", zap.String("job-id", job.ID), zap.Error(err))
+			continue
+		}
+		jm.jobFsm.JobArchived(job.ID)
+	}
 }
 
 // OnMasterRecovered implements lib.MasterImpl.OnMasterRecovered
 func (jm *JobManagerImplV2) OnMasterRecovered(ctx context.Context) error {
+	return jm.recoverJobs(ctx)
+}
+
+var masterStatusToJobState = map[lib.MasterStatusCode]JobState{
+	lib.MasterStatusFinished: JobStateFinished,
+	lib.MasterStatusFailed:   JobStateFailed,
+	lib.MasterStatusCanceled: JobStateCanceled,
+}
+
+// recoverJobs loads the submitted jobs and the failures of their job masters
+// from metastore after failover, and waits for their job masters to send
+// heartbeats. The jobs that depend on other jobs are recovered after their
+// upstream jobs, and are blocked again if the upstream jobs are not finished.
+// The jobs whose job masters have never started are queued again.
+func (jm *JobManagerImplV2) recoverJobs(ctx context.Context) error {
+	jobs, err := loadAllJobs(ctx, jm.BaseMaster.MetaKVClient())
+	if err != nil {
+		return err
+	}
+	// an upstream job is always submitted before its downstream jobs
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].SubmitTime.Before(jobs[j].SubmitTime)
+	})
+	for _, job := range jobs {
+		masterMeta, err := lib.NewMasterMetadataClient(job.ID, jm.BaseMaster.MetaKVClient()).Load(ctx)
+		if err != nil {
+			return err
+		}
+		failures, err := loadJobFailures(ctx, jm.BaseMaster.MetaKVClient(), job.ID)
+		if err != nil {
+			return err
+		}
+		jm.jobFsm.FailuresRecovered(job, failures)
+		// the job is terminated but not archived before failover
+		if state, ok := masterStatusToJobState[masterMeta.StatusCode]; ok {
+			jm.jobFsm.JobTerminated(job, state)
+			continue
+		}
+		if len(job.DependsOn) > 0 {
+			upstreamStates, err := jm.jobStates(ctx, job.DependsOn)
+			if err != nil {
+				return err
+			}
+			if blocked, terminated := jm.jobFsm.JobBlocked(job, upstreamStates); blocked || terminated {
+				continue
+			}
+		}
+		// The job master has never started, for example the job was waiting
+		// in the admission queue, so the job is queued again instead of
+		// being dispatched ahead of the jobs of higher priority.
+		if masterMeta.NodeID == "" {
+			log.L().Info("recover job to the admission queue", zap.String("job-id", job.ID))
+			jm.jobFsm.JobQueued(job)
+			continue
+		}
+		log.L().Info("recover job", zap.String("job-id", job.ID))
+		if err := jm.BaseMaster.RecoverWorker(ctx, job.ID); err != nil {
+			return err
+		}
+		jm.jobFsm.JobRecovered(job)
+	}
 	return nil
 }
 
 // OnWorkerDispatched implements lib.MasterImpl.OnWorkerDispatched
 func (jm *JobManagerImplV2) OnWorkerDispatched(worker lib.WorkerHandle, result error) error {
-	if result != nil {
-		log.L().Warn("dispatch worker met error", zap.Error(result))
-		return jm.jobFsm.JobDispatchFailed(worker)
+	if result == nil {
+		jm.jobFsm.JobDispatchSucceeded(worker.ID())
+		return nil
+	}
+	log.L().Warn("dispatch worker met error", zap.Error(result))
+	canceled, failed, err := jm.jobFsm.JobDispatchFailed(worker, result)
+	if err != nil {
+		return err
+	}
+	if canceled {
+		return jm.onJobCanceled(worker.ID())
+	}
+	if failed {
+		return jm.onJobFailed(worker.ID())
 	}
 	return nil
 }
@@ -173,22 +684,118 @@ func (jm *JobManagerImplV2) OnWorkerDispatched(worker lib.WorkerHandle, result e
 // OnWorkerOnline implements lib.MasterImpl.OnWorkerOnline
 func (jm *JobManagerImplV2) OnWorkerOnline(worker lib.WorkerHandle) error {
 	log.L().Info("on worker online", zap.Any("id", worker.ID()))
-	return jm.jobFsm.JobOnline(worker)
+	ctx, cancel := context.WithTimeout(context.Background(), metaOpTimeout)
+	defer cancel()
+	canceling, err := jm.jobFsm.JobOnline(worker)
+	if err != nil {
+		if !errors.ErrWorkerNotFound.Equal(err) {
+			return err
+		}
+		// The job master is unknown, for example it has been dispatched
+		// again after failover, ask it to exit.
+		log.L().Warn("unknown job master online, stop it", zap.String("id", worker.ID()))
+		return jm.stopJobMaster(ctx, worker.ID())
+	}
+	if canceling {
+		return jm.stopJobMaster(ctx, worker.ID())
+	}
+	// The job may be paused after the job master has loaded its metadata,
+	// ask it to pause again.
+	masterMeta, err := lib.NewMasterMetadataClient(worker.ID(), jm.BaseMaster.MetaKVClient()).Load(ctx)
+	if err != nil {
+		return err
+	}
+	if masterMeta.StatusCode == lib.MasterStatusPaused {
+		if err := jm.BaseMaster.PauseWorker(ctx, worker.ID()); err != nil {
+			return err
+		}
+	}
+	// The config may be updated before the job master comes online, send
+	// the latest config to it, the job master ignores an unchanged config.
+	job, _, err := jm.jobFsm.QueryJob(worker.ID())
+	if err != nil || job.MasterMetaExt.ConfigVersion == 0 {
+		return nil
+	}
+	return jm.BaseMaster.UpdateWorkerConfig(
+		ctx, worker.ID(), job.MasterMetaExt.Config, job.MasterMetaExt.ConfigVersion)
 }
 
 // OnWorkerOffline implements lib.MasterImpl.OnWorkerOffline
 func (jm *JobManagerImplV2) OnWorkerOffline(worker lib.WorkerHandle, reason error) error {
 	log.L().Info("on worker offline", zap.Any("id", worker.ID()), zap.Any("reason", reason))
-	jm.jobFsm.JobOffline(worker)
+	canceled, failed := jm.jobFsm.JobOffline(worker, reason)
+	if canceled {
+		return jm.onJobCanceled(worker.ID())
+	}
+	if failed {
+		return jm.onJobFailed(worker.ID())
+	}
 	return nil
 }
 
+// stopJobMaster asks a job master to exit when there is no caller to report
+// the error to. A stop message that is not delivered is only logged, a job
+// being canceled can be canceled again to resend it.
+func (jm *JobManagerImplV2) stopJobMaster(ctx context.Context, id lib.MasterID) error {
+	err := jm.BaseMaster.StopWorker(ctx, id)
+	if err != nil && errors.ErrMessageNotDelivered.Equal(err) {
+		log.L().Warn("failed to stop job master", zap.String("job-id", id), zap.Error(err))
+		return nil
+	}
+	return err
+}
+
+func (jm *JobManagerImplV2) onJobCanceled(id lib.MasterID) error {
+	log.L().Info("job is canceled", zap.String("job-id", id))
+	ctx, cancel := context.WithTimeout(context.Background(), metaOpTimeout)
+	defer cancel()
+	return jm.updateJobStatus(ctx, id, lib.MasterStatusCanceled)
+}
+
+func (jm *JobManagerImplV2) onJobFailed(id lib.MasterID) error {
+	log.L().Warn("job is failed", zap.String("job-id", id))
+	ctx, cancel := context.WithTimeout(context.Background(), metaOpTimeout)
+	defer cancel()
+	return jm.updateJobStatus(ctx, id, lib.MasterStatusFailed)
+}
+
+// updateJobStatus updates the status of the job master in metastore.
+func (jm *JobManagerImplV2) updateJobStatus(ctx context.Context, id lib.MasterID, code lib.MasterStatusCode) error {
+	metaClient := lib.NewMasterMetadataClient(id, jm.BaseMaster.MetaKVClient())
+	return metaClient.Update(ctx, func(masterMeta *lib.MasterMetaKVData) error {
+		masterMeta.StatusCode = code
+		return nil
+	})
+}
+
 // OnWorkerMessage implements lib.MasterImpl.OnWorkerMessage
 func (jm *JobManagerImplV2) OnWorkerMessage(worker lib.WorkerHandle, topic p2p.Topic, message interface{}) error {
 	log.L().Info("on worker message", zap.Any("id", worker.ID()), zap.Any("topic", topic), zap.Any("message", message))
 	return nil
 }
 
+// GetWorkerStatusExtTypeInfo implements lib.MasterImpl.GetWorkerStatusExtTypeInfo,
+// job masters report the statuses of their workers in the Ext field.
+func (jm *JobManagerImplV2) GetWorkerStatusExtTypeInfo() interface{} {
+	return &lib.JobMasterStatusExt{}
+}
+
+// OnPause implements lib.MasterImpl.OnPause, JobManager is never paused.
+func (jm *JobManagerImplV2) OnPause(ctx context.Context) error {
+	return nil
+}
+
+// OnResume implements lib.MasterImpl.OnResume
+func (jm *JobManagerImplV2) OnResume(ctx context.Context) error {
+	return nil
+}
+
+// OnConfigUpdated implements lib.MasterImpl.OnConfigUpdated, JobManager has
+// no job config.
+func (jm *JobManagerImplV2) OnConfigUpdated(ctx context.Context, config []byte) error {
+	return nil
+}
+
 // CloseImpl implements lib.MasterImpl.CloseImpl
 func (jm *JobManagerImplV2) CloseImpl(ctx context.Context) error {
 	return nil
@@ -2,25 +2,35 @@ package servermaster
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/hanfei1991/microcosm/lib"
 	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/adapter"
+	"github.com/hanfei1991/microcosm/pkg/clock"
 	"github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/hanfei1991/microcosm/pkg/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
-func TestJobManagerSubmitJob(t *testing.T) {
-	t.Parallel()
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// testExecutorID is the executor in which the job masters are dispatched by
+// newJobManagerForTest.
+const testExecutorID = "executor-1"
 
-	mockMaster := lib.NewMockMasterImpl("", "submit-job-test")
+// newJobManagerForTest creates a JobManagerImplV2 on a mock master. The job
+// masters of dispatchedJobs are dispatched in testExecutorID, the others are
+// rejected for lack of resource, so that their jobs stay queued.
+func newJobManagerForTest(
+	ctx context.Context, t *testing.T, id lib.MasterID, dispatchedJobs ...lib.MasterID,
+) (*JobManagerImplV2, *lib.MockMasterImpl) {
+	mockMaster := lib.NewMockMasterImpl("", id)
 	mockMaster.On("InitImpl", mock.Anything).Return(nil)
+	for _, jobID := range dispatchedJobs {
+		lib.MockBaseMasterDispatchWorker(t, mockMaster.DefaultBaseMaster, jobID, testExecutorID)
+	}
 	mockMaster.MasterClient().On(
 		"ScheduleTask", mock.Anything, mock.Anything, mock.Anything).Return(
 		&pb.TaskSchedulerResponse{}, errors.ErrClusterResourceNotEnough.FastGenByArgs(),
@@ -34,16 +44,678 @@ func TestJobManagerSubmitJob(t *testing.T) {
 	mockMaster.Impl = mgr
 	err := mockMaster.Init(ctx)
 	require.Nil(t, err)
+	return mgr, mockMaster
+}
+
+// waitJobOnline sends heartbeats on behalf of the dispatched job master until
+// the job is online.
+func waitJobOnline(t *testing.T, mgr *JobManagerImplV2, mockMaster *lib.MockMasterImpl, jobID lib.MasterID) {
+	require.Eventually(t, func() bool {
+		lib.MockBaseMasterWorkerHeartbeat(t, mockMaster.DefaultBaseMaster, mgr.MasterID(), jobID, testExecutorID)
+		_, state, err := mgr.jobFsm.QueryJob(jobID)
+		return err == nil && state == JobStateOnline
+	}, time.Second*5, time.Millisecond*100)
+}
+
+func TestJobManagerSubmitJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "submit-job-test")
 	req := &pb.SubmitJobRequest{
 		Tp:     pb.JobType_CVSDemo,
-		Config: []byte("{\"srcHost\":\"0.0.0.0:1234\", \"dstHost\":\"0.0.0.0:1234\", \"srcDir\":\"data\", \"dstDir\":\"data1\"}"),
+		Config: []byte("{\"srcHost\":\"0.0.0.0:1234\", \"dstHost\":\"0.0.0.0:1234\", \"srcDir\":\"data\", \"dstDir\":\"data1\", \"restart-policy\":{\"max-attempts\":2}}"),
 	}
-	resp := mgr.SubmitJob(ctx, req)
+	resp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{
+		Tp:     pb.JobType_CVSDemo,
+		Config: []byte("{\"restart-policy\":{\"max-attempts\":-1}}"),
+	})
+	require.NotNil(t, resp.Err)
+	resp = mgr.SubmitJob(ctx, req)
 	require.Nil(t, resp.Err)
+	jobs, err := loadAllJobs(ctx, mgr.MetaKVClient())
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, resp.JobIdStr, jobs[0].ID)
+	require.Equal(t, lib.CvsJobMaster, jobs[0].Tp)
+	require.Equal(t, req.Config, jobs[0].Config)
+	require.Equal(t, &lib.RestartPolicy{
+		MaxAttempts:      2,
+		InitialBackoffMs: lib.DefaultRestartPolicy.InitialBackoffMs,
+		MaxBackoffMs:     lib.DefaultRestartPolicy.MaxBackoffMs,
+	}, jobs[0].RestartPolicy)
 	time.Sleep(time.Millisecond * 10)
 	require.Eventually(t, func() bool {
 		return mgr.jobFsm.OnlineJobCount() == 0 &&
 			mgr.jobFsm.WaitAckJobCount() == 0 &&
-			mgr.jobFsm.PendingJobCount() == 1
+			mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+}
+
+func TestJobManagerCancelJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "cancel-job-test")
+
+	resp := mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: "non-existing-job"})
+	require.NotNil(t, resp.Err)
+
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob})
+	require.Nil(t, submitResp.Err)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+
+	// a queued job is canceled at once
+	resp = mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: submitResp.JobIdStr})
+	require.Nil(t, resp.Err)
+	require.Equal(t, 0, mgr.jobFsm.QueuedJobCount())
+	meta, err := lib.NewMasterMetadataClient(submitResp.JobIdStr, mgr.MetaKVClient()).Load(ctx)
+	require.Nil(t, err)
+	require.Equal(t, lib.MasterStatusCanceled, meta.StatusCode)
+}
+
+func TestJobManagerCancelOnlineJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, mockMaster := newJobManagerForTest(ctx, t, "cancel-online-job-test", "online-job")
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "online-job"})
+	require.Nil(t, submitResp.Err)
+	waitJobOnline(t, mgr, mockMaster, "online-job")
+
+	// the online job master is asked to stop, and the job waits for it to
+	// go offline
+	resp := mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: "online-job"})
+	require.Nil(t, resp.Err)
+	require.Equal(t, 1, mgr.jobFsm.CancelingJobCount())
+	msg, ok := lib.MockBaseMasterPopWorkerMessage(
+		mockMaster.DefaultBaseMaster, "online-job", testExecutorID, lib.StopWorkerTopic)
+	require.True(t, ok)
+	require.Equal(t, "online-job", msg.(*lib.StopWorkerMessage).WorkerID)
+
+	// the stop message is sent again if the job is canceled again
+	resp = mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: "online-job"})
+	require.Nil(t, resp.Err)
+	_, ok = lib.MockBaseMasterPopWorkerMessage(
+		mockMaster.DefaultBaseMaster, "online-job", testExecutorID, lib.StopWorkerTopic)
+	require.True(t, ok)
+
+	err := mgr.OnWorkerOffline(lib.NewTombstoneWorkerHandle("online-job", lib.WorkerStatus{
+		Code: lib.WorkerStatusError,
+	}), errors.ErrWorkerOffline.GenWithStackByArgs("online-job"))
+	require.Nil(t, err)
+	_, state, err := mgr.jobFsm.QueryJob("online-job")
+	require.Nil(t, err)
+	require.Equal(t, JobStateCanceled, state)
+	meta, err := lib.NewMasterMetadataClient("online-job", mgr.MetaKVClient()).Load(ctx)
+	require.Nil(t, err)
+	require.Equal(t, lib.MasterStatusCanceled, meta.StatusCode)
+}
+
+func TestJobManagerPauseAndResumeJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "pause-job-test")
+
+	pauseResp := mgr.PauseJob(ctx, &pb.PauseJobRequest{JobIdStr: "non-existing-job"})
+	require.NotNil(t, pauseResp.Err)
+	resumeResp := mgr.ResumeJob(ctx, &pb.ResumeJobRequest{JobIdStr: "non-existing-job"})
+	require.NotNil(t, resumeResp.Err)
+
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob})
+	require.Nil(t, submitResp.Err)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
 	}, time.Second*2, time.Millisecond*20)
+
+	metaClient := lib.NewMasterMetadataClient(submitResp.JobIdStr, mgr.MetaKVClient())
+	pauseResp = mgr.PauseJob(ctx, &pb.PauseJobRequest{JobIdStr: submitResp.JobIdStr})
+	require.Nil(t, pauseResp.Err)
+	meta, err := metaClient.Load(ctx)
+	require.Nil(t, err)
+	require.Equal(t, lib.MasterStatusPaused, meta.StatusCode)
+
+	resumeResp = mgr.ResumeJob(ctx, &pb.ResumeJobRequest{JobIdStr: submitResp.JobIdStr})
+	require.Nil(t, resumeResp.Err)
+	meta, err = metaClient.Load(ctx)
+	require.Nil(t, err)
+	require.Equal(t, lib.MasterStatusNormal, meta.StatusCode)
+}
+
+func TestJobManagerPauseAndResumeOnlineJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, mockMaster := newJobManagerForTest(ctx, t, "pause-online-job-test", "online-job")
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "online-job"})
+	require.Nil(t, submitResp.Err)
+	waitJobOnline(t, mgr, mockMaster, "online-job")
+
+	metaClient := lib.NewMasterMetadataClient("online-job", mgr.MetaKVClient())
+	pauseResp := mgr.PauseJob(ctx, &pb.PauseJobRequest{JobIdStr: "online-job"})
+	require.Nil(t, pauseResp.Err)
+	meta, err := metaClient.Load(ctx)
+	require.Nil(t, err)
+	require.Equal(t, lib.MasterStatusPaused, meta.StatusCode)
+	msg, ok := lib.MockBaseMasterPopWorkerMessage(
+		mockMaster.DefaultBaseMaster, "online-job", testExecutorID, lib.PauseWorkerTopic)
+	require.True(t, ok)
+	require.Equal(t, "online-job", msg.(*lib.PauseWorkerMessage).WorkerID)
+
+	resumeResp := mgr.ResumeJob(ctx, &pb.ResumeJobRequest{JobIdStr: "online-job"})
+	require.Nil(t, resumeResp.Err)
+	meta, err = metaClient.Load(ctx)
+	require.Nil(t, err)
+	require.Equal(t, lib.MasterStatusNormal, meta.StatusCode)
+	msg, ok = lib.MockBaseMasterPopWorkerMessage(
+		mockMaster.DefaultBaseMaster, "online-job", testExecutorID, lib.ResumeWorkerTopic)
+	require.True(t, ok)
+	require.Equal(t, "online-job", msg.(*lib.ResumeWorkerMessage).WorkerID)
+
+	// a job being canceled can't be paused
+	cancelResp := mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: "online-job"})
+	require.Nil(t, cancelResp.Err)
+	pauseResp = mgr.PauseJob(ctx, &pb.PauseJobRequest{JobIdStr: "online-job"})
+	require.NotNil(t, pauseResp.Err)
+}
+
+func TestJobManagerUpdateJobConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "update-job-config-test")
+
+	updateResp := mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		JobIdStr: "non-existing-job",
+		Config:   []byte("{}"),
+	})
+	require.NotNil(t, updateResp.Err)
+
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{
+		Tp:     pb.JobType_CVSDemo,
+		Config: []byte(`{"srcHost":"0.0.0.0:1234", "dstHost":"0.0.0.0:1235", "srcDir":"data", "dstDir":"data1"}`),
+	})
+	require.Nil(t, submitResp.Err)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+
+	updateResp = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		JobIdStr: submitResp.JobIdStr,
+		Config:   []byte("invalid config"),
+	})
+	require.NotNil(t, updateResp.Err)
+
+	config := []byte(`{"srcHost":"0.0.0.0:1234", "dstHost":"0.0.0.0:1236", "srcDir":"data", "dstDir":"data1", "restart-policy":{"max-attempts":3}}`)
+	updateResp = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		JobIdStr: submitResp.JobIdStr,
+		Config:   config,
+	})
+	require.Nil(t, updateResp.Err)
+	require.Equal(t, int64(1), updateResp.ConfigVersion)
+
+	jobs, err := loadAllJobs(ctx, mgr.MetaKVClient())
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, config, jobs[0].Config)
+	require.Equal(t, int64(1), jobs[0].ConfigVersion)
+	require.Equal(t, 3, jobs[0].RestartPolicy.MaxAttempts)
+
+	queryResp := mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: submitResp.JobIdStr})
+	require.Nil(t, queryResp.Err)
+	require.Equal(t, config, queryResp.Job.Config)
+	require.Equal(t, int64(1), queryResp.Job.ConfigVersion)
+
+	// the config of a canceled job can't be updated
+	cancelResp := mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: submitResp.JobIdStr})
+	require.Nil(t, cancelResp.Err)
+	updateResp = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		JobIdStr: submitResp.JobIdStr,
+		Config:   config,
+	})
+	require.NotNil(t, updateResp.Err)
+}
+
+func TestJobManagerUpdateOnlineJobConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, mockMaster := newJobManagerForTest(ctx, t, "update-online-job-test", "online-job")
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "online-job"})
+	require.Nil(t, submitResp.Err)
+	waitJobOnline(t, mgr, mockMaster, "online-job")
+
+	// the config is sent to the online job master, an empty config is
+	// accepted by FakeJob as in SubmitJob
+	updateResp := mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{JobIdStr: "online-job"})
+	require.Nil(t, updateResp.Err)
+	require.Equal(t, int64(1), updateResp.ConfigVersion)
+	msg, ok := lib.MockBaseMasterPopWorkerMessage(
+		mockMaster.DefaultBaseMaster, "online-job", testExecutorID, lib.UpdateConfigTopic)
+	require.True(t, ok)
+	require.Equal(t, "online-job", msg.(*lib.UpdateConfigMessage).WorkerID)
+	require.Equal(t, []byte("{}"), msg.(*lib.UpdateConfigMessage).Config)
+	require.Equal(t, int64(1), msg.(*lib.UpdateConfigMessage).ConfigVersion)
+
+	// the update is rejected if the persisted job has been updated by others
+	jobs, err := loadAllJobs(ctx, mgr.MetaKVClient())
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	jobs[0].ConfigVersion++
+	value, err := jobs[0].Marshal()
+	require.Nil(t, err)
+	_, err = mgr.MetaKVClient().Put(ctx, adapter.JobKeyAdapter.Encode("online-job"), string(value))
+	require.Nil(t, err)
+	updateResp = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		JobIdStr: "online-job",
+		Config:   []byte(`{"restart-policy":{"max-attempts":3}}`),
+	})
+	require.NotNil(t, updateResp.Err)
+	require.Contains(t, updateResp.Err.Message, "updated concurrently")
+	_, ok = lib.MockBaseMasterPopWorkerMessage(
+		mockMaster.DefaultBaseMaster, "online-job", testExecutorID, lib.UpdateConfigTopic)
+	require.False(t, ok)
+}
+
+func TestJobManagerQueryAndListJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "query-job-test")
+
+	queryResp := mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: "non-existing-job"})
+	require.NotNil(t, queryResp.Err)
+
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob})
+	require.Nil(t, submitResp.Err)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+
+	queryResp = mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: submitResp.JobIdStr})
+	require.Nil(t, queryResp.Err)
+	// the job master is rejected for lack of resource, the job is queued
+	require.NotEmpty(t, queryResp.Job.StartTime)
+	require.Equal(t, &pb.JobInfo{
+		JobIdStr:      submitResp.JobIdStr,
+		State:         pb.JobInfo_Queued,
+		Tp:            int64(lib.FakeJobMaster),
+		Config:        []byte("{}"),
+		StartTime:     queryResp.Job.StartTime,
+		QueuePosition: 1,
+	}, queryResp.Job)
+
+	listResp := mgr.ListJobs(ctx, &pb.ListJobsRequest{})
+	require.Nil(t, listResp.Err)
+	require.Equal(t, []*pb.JobInfo{queryResp.Job}, listResp.Jobs)
+
+	listResp = mgr.ListJobs(ctx, &pb.ListJobsRequest{States: []pb.JobInfo_State{pb.JobInfo_Online}})
+	require.Nil(t, listResp.Err)
+	require.Empty(t, listResp.Jobs)
+}
+
+func TestJobManagerQueryOnlineJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, mockMaster := newJobManagerForTest(ctx, t, "query-online-job-test", "online-job")
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "online-job"})
+	require.Nil(t, submitResp.Err)
+	waitJobOnline(t, mgr, mockMaster, "online-job")
+
+	// the job master reports the statuses of its workers
+	ext, err := json.Marshal(&lib.JobMasterStatusExt{
+		ExtBytes: []byte(`{"tick":10}`),
+		Workers: map[lib.WorkerID]lib.WorkerStatus{
+			"worker-2": {Code: lib.WorkerStatusError, ErrorMessage: "disk full"},
+			"worker-1": {Code: lib.WorkerStatusNormal, ExtBytes: []byte(`{"tick":5}`)},
+		},
+	})
+	require.Nil(t, err)
+	lib.MockBaseMasterWorkerUpdateStatus(t, mockMaster.DefaultBaseMaster, mgr.MasterID(),
+		"online-job", testExecutorID, &lib.WorkerStatus{Code: lib.WorkerStatusNormal, ExtBytes: ext})
+
+	queryResp := mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: "online-job"})
+	require.Nil(t, queryResp.Err)
+	require.Equal(t, pb.JobInfo_Online, queryResp.Job.State)
+	require.Equal(t, &pb.WorkerStatusInfo{
+		WorkerId: "online-job",
+		Code:     int32(lib.WorkerStatusNormal),
+		Ext:      []byte(`{"tick":10}`),
+	}, queryResp.Job.Status)
+	require.Equal(t, []*pb.WorkerStatusInfo{
+		{WorkerId: "worker-1", Code: int32(lib.WorkerStatusNormal), Ext: []byte(`{"tick":5}`)},
+		{WorkerId: "worker-2", Code: int32(lib.WorkerStatusError), ErrorMessage: "disk full"},
+	}, queryResp.Job.Workers)
+
+	listResp := mgr.ListJobs(ctx, &pb.ListJobsRequest{States: []pb.JobInfo_State{pb.JobInfo_Online}})
+	require.Nil(t, listResp.Err)
+	require.Equal(t, []*pb.JobInfo{queryResp.Job}, listResp.Jobs)
+}
+
+func TestJobManagerRecoverJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockMaster := lib.NewMockMasterImpl("", "recover-job-test")
+	mgr := &JobManagerImplV2{
+		BaseMaster: mockMaster.DefaultBaseMaster,
+		jobFsm:     NewJobFsm(),
+		uuidGen:    uuid.NewGenerator(),
+	}
+	mockMaster.Impl = mgr
+
+	// prepare metadata as if the job manager has run before failover
+	metaKV := mockMaster.MetaKVClient()
+	err := lib.NewMasterMetadataClient("recover-job-test", metaKV).Store(ctx,
+		&lib.MasterMetaKVData{ID: "recover-job-test", Initialized: true})
+	require.Nil(t, err)
+	jobs := map[string]lib.MasterStatusCode{
+		"running-job":  lib.MasterStatusNormal,
+		"paused-job":   lib.MasterStatusPaused,
+		"canceled-job": lib.MasterStatusCanceled,
+	}
+	for id, code := range jobs {
+		job := &lib.MasterMetaExt{ID: id, Tp: lib.FakeJobMaster}
+		err := storeJob(ctx, metaKV, job)
+		require.Nil(t, err)
+		err = lib.NewMasterMetadataClient(id, metaKV).Store(ctx, &lib.MasterMetaKVData{
+			ID:            id,
+			NodeID:        testExecutorID,
+			StatusCode:    code,
+			MasterMetaExt: job,
+		})
+		require.Nil(t, err)
+	}
+	// a job persisted without its job master ever coming online
+	err = storeJob(ctx, metaKV, &lib.MasterMetaExt{ID: "dispatching-job", Tp: lib.FakeJobMaster})
+	require.Nil(t, err)
+
+	err = mockMaster.Init(ctx)
+	require.Nil(t, err)
+	require.False(t, mgr.IsMasterReady())
+	require.Equal(t, 2, mgr.jobFsm.WaitAckJobCount())
+	for _, id := range []string{"running-job", "paused-job"} {
+		_, state, err := mgr.jobFsm.QueryJob(id)
+		require.Nil(t, err)
+		require.Equal(t, JobStateWaitAck, state)
+	}
+	// the job master that has never started is queued again
+	_, state, err := mgr.jobFsm.QueryJob("dispatching-job")
+	require.Nil(t, err)
+	require.Equal(t, JobStateQueued, state)
+	// the canceled job is not archived before failover
+	_, state, err = mgr.jobFsm.QueryJob("canceled-job")
+	require.Nil(t, err)
+	require.Equal(t, JobStateCanceled, state)
+
+	// no job is dispatched before the job masters are taken over
+	err = mgr.Tick(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 2, mgr.jobFsm.WaitAckJobCount())
+	require.Equal(t, 1, mgr.jobFsm.QueuedJobCount())
+}
+
+func TestJobManagerRecoverJobFailures(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "recover-failure-test")
+	job := &lib.MasterMetaExt{
+		ID:            "crash-loop-job",
+		Tp:            lib.FakeJobMaster,
+		RestartPolicy: &lib.RestartPolicy{MaxAttempts: 2, InitialBackoffMs: 1000, MaxBackoffMs: 1000},
+	}
+	require.Nil(t, storeJob(ctx, mgr.MetaKVClient(), job))
+	err := lib.NewMasterMetadataClient(job.ID, mgr.MetaKVClient()).Store(ctx, &lib.MasterMetaKVData{
+		ID:            job.ID,
+		NodeID:        testExecutorID,
+		MasterMetaExt: job,
+	})
+	require.Nil(t, err)
+	worker := lib.NewTombstoneWorkerHandle(job.ID, lib.WorkerStatus{Code: lib.WorkerStatusNormal})
+	mgr.jobFsm.JobDispatched(job)
+	_, failed, err := mgr.jobFsm.JobDispatchFailed(worker, errors.ErrGrpcBuildConn.GenWithStackByArgs())
+	require.Nil(t, err)
+	require.False(t, failed)
+
+	// the failure is persisted in Tick
+	mgr.saveFailures(ctx)
+	require.Empty(t, mgr.jobFsm.UnsavedFailures())
+	failures, err := loadJobFailures(ctx, mgr.MetaKVClient(), job.ID)
+	require.Nil(t, err)
+	require.Len(t, failures, 1)
+
+	// the failure is restored after failover, so the job fails at the next
+	// failure instead of restarting with a fresh restart policy
+	mockClock := clock.NewMock()
+	mockClock.Set(failures[0].Time)
+	recovered := &JobManagerImplV2{BaseMaster: mgr.BaseMaster, jobFsm: NewJobFsm()}
+	recovered.jobFsm.clock = mockClock
+	require.Nil(t, recovered.recoverJobs(ctx))
+	holder, state, err := recovered.jobFsm.QueryJob(job.ID)
+	require.Nil(t, err)
+	require.Equal(t, JobStateWaitAck, state)
+	require.Equal(t, failures, holder.failures)
+
+	recovered.jobFsm.PendUnadoptedJobs()
+	dispatchFn := func(job *lib.MasterMetaExt) (string, error) {
+		return "", errors.ErrGrpcBuildConn.GenWithStackByArgs()
+	}
+	// the backoff is restored as well
+	require.Empty(t, recovered.jobFsm.IterPendingJobs(dispatchFn))
+	mockClock.Add(time.Second)
+	require.Equal(t, []lib.MasterID{job.ID}, recovered.jobFsm.IterPendingJobs(dispatchFn))
+}
+
+func TestJobManagerAdmitQueuedJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, mockMaster := newJobManagerForTest(ctx, t, "admission-test", "urgent-job")
+	mockClock := clock.NewMock()
+	mgr.jobFsm.clock = mockClock
+	admitting := func() lib.MasterID {
+		mgr.jobFsm.jobsMu.RLock()
+		defer mgr.jobFsm.jobsMu.RUnlock()
+		return mgr.jobFsm.admittingJob
+	}
+	scheduled := func() []string {
+		var ids []string
+		for _, call := range mockMaster.MasterClient().Calls {
+			if call.Method == "ScheduleTask" {
+				ids = append(ids, call.Arguments.Get(1).(*pb.TaskSchedulerRequest).Tasks[0].WorkerId)
+			}
+		}
+		return ids
+	}
+
+	// the job master is rejected for lack of resource asynchronously
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "nightly-job"})
+	require.Nil(t, submitResp.Err)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+	for _, req := range []*pb.SubmitJobRequest{
+		{Tp: pb.JobType_FakeJob, JobName: "backfill-job"},
+		{Tp: pb.JobType_FakeJob, JobName: "urgent-job", Priority: 10},
+	} {
+		submitResp = mgr.SubmitJob(ctx, req)
+		require.Nil(t, submitResp.Err)
+	}
+	require.Equal(t, 3, mgr.jobFsm.QueuedJobCount())
+
+	// only the first queued job is admitted, the next one is admitted after
+	// its job master is dispatched
+	mockClock.Add(admissionRetryInterval)
+	require.Nil(t, mgr.Tick(ctx))
+	require.Equal(t, 2, mgr.jobFsm.QueuedJobCount())
+	require.Eventually(t, func() bool {
+		return admitting() == ""
+	}, time.Second*2, time.Millisecond*20)
+	_, state, err := mgr.jobFsm.QueryJob("urgent-job")
+	require.Nil(t, err)
+	require.Equal(t, JobStateWaitAck, state)
+
+	// the next job is rejected and queued again ahead of the later one
+	require.Nil(t, mgr.Tick(ctx))
+	require.Eventually(t, func() bool {
+		return admitting() == "" && mgr.jobFsm.QueuedJobCount() == 2
+	}, time.Second*2, time.Millisecond*20)
+	holder, state, err := mgr.jobFsm.QueryJob("nightly-job")
+	require.Nil(t, err)
+	require.Equal(t, JobStateQueued, state)
+	require.Equal(t, 1, holder.queuePosition)
+	require.Nil(t, mgr.Tick(ctx))
+	require.Equal(t, 2, mgr.jobFsm.QueuedJobCount())
+	require.Equal(t, []string{"nightly-job", "urgent-job", "nightly-job"}, scheduled())
+}
+
+func TestJobManagerSubmitJobWithName(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "job-name-test")
+
+	req := &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "my-job"}
+	submitResp := mgr.SubmitJob(ctx, req)
+	require.Nil(t, submitResp.Err)
+	require.Equal(t, "my-job", submitResp.JobIdStr)
+
+	// a retried submission is rejected
+	submitResp = mgr.SubmitJob(ctx, req)
+	require.NotNil(t, submitResp.Err)
+	require.Equal(t, pb.ErrorCode_JobNameExists, submitResp.Err.Code)
+	submitResp = mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "job-name-test"})
+	require.NotNil(t, submitResp.Err)
+	jobs, err := loadAllJobs(ctx, mgr.MetaKVClient())
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+
+	// the job name can be used as the job id
+	queryResp := mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: "my-job"})
+	require.Nil(t, queryResp.Err)
+	require.Equal(t, "my-job", queryResp.Job.JobIdStr)
+	cancelResp := mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: "my-job"})
+	require.Nil(t, cancelResp.Err)
+}
+
+func TestJobManagerArchiveJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "archive-job-test")
+
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "nightly-sync"})
+	require.Nil(t, submitResp.Err)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+	cancelResp := mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: "nightly-sync"})
+	require.Nil(t, cancelResp.Err)
+	require.Equal(t, 1, mgr.jobFsm.TerminatedJobCount())
+
+	// the canceled job is archived in Tick
+	err := mgr.Tick(ctx)
+	require.Nil(t, err)
+	require.Equal(t, 0, mgr.jobFsm.TerminatedJobCount())
+	jobs, err := loadAllJobs(ctx, mgr.MetaKVClient())
+	require.Nil(t, err)
+	require.Empty(t, jobs)
+
+	queryResp := mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: "nightly-sync"})
+	require.Nil(t, queryResp.Err)
+	require.Equal(t, pb.JobInfo_Canceled, queryResp.Job.State)
+	require.NotEmpty(t, queryResp.Job.StartTime)
+	require.NotEmpty(t, queryResp.Job.EndTime)
+	listResp := mgr.ListJobs(ctx, &pb.ListJobsRequest{States: []pb.JobInfo_State{pb.JobInfo_Canceled}})
+	require.Nil(t, listResp.Err)
+	require.Equal(t, []*pb.JobInfo{queryResp.Job}, listResp.Jobs)
+
+	// the job name can be used again after the job is archived
+	submitResp = mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "nightly-sync"})
+	require.Nil(t, submitResp.Err)
+}
+
+func TestJobManagerSubmitJobWithDependencies(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr, _ := newJobManagerForTest(ctx, t, "depends-on-test")
+
+	// the upstream job must exist
+	submitResp := mgr.SubmitJob(ctx, &pb.SubmitJobRequest{
+		Tp: pb.JobType_FakeJob, JobName: "merge", DependsOn: []string{"import"},
+	})
+	require.NotNil(t, submitResp.Err)
+	submitResp = mgr.SubmitJob(ctx, &pb.SubmitJobRequest{
+		Tp: pb.JobType_FakeJob, JobName: "merge", DependsOn: []string{"merge"},
+	})
+	require.NotNil(t, submitResp.Err)
+
+	submitResp = mgr.SubmitJob(ctx, &pb.SubmitJobRequest{Tp: pb.JobType_FakeJob, JobName: "import"})
+	require.Nil(t, submitResp.Err)
+	require.Eventually(t, func() bool {
+		return mgr.jobFsm.QueuedJobCount() == 1
+	}, time.Second*2, time.Millisecond*20)
+	submitResp = mgr.SubmitJob(ctx, &pb.SubmitJobRequest{
+		Tp: pb.JobType_FakeJob, JobName: "merge", DependsOn: []string{"import"},
+	})
+	require.Nil(t, submitResp.Err)
+	queryResp := mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: "merge"})
+	require.Nil(t, queryResp.Err)
+	require.Equal(t, pb.JobInfo_Blocked, queryResp.Job.State)
+	require.Equal(t, []string{"import"}, queryResp.Job.DependsOn)
+
+	// canceling the upstream job cancels the downstream job
+	cancelResp := mgr.CancelJob(ctx, &pb.CancelJobRequest{JobIdStr: "import"})
+	require.Nil(t, cancelResp.Err)
+	err := mgr.Tick(ctx)
+	require.Nil(t, err)
+	queryResp = mgr.QueryJob(ctx, &pb.QueryJobRequest{JobIdStr: "merge"})
+	require.Nil(t, queryResp.Err)
+	require.Equal(t, pb.JobInfo_Canceled, queryResp.Job.State)
+	require.Equal(t, "upstream job import is canceled", queryResp.Job.Error)
+
+	// a job depending on an archived canceled job is canceled at once
+	submitResp = mgr.SubmitJob(ctx, &pb.SubmitJobRequest{
+		Tp: pb.JobType_FakeJob, JobName: "report", DependsOn: []string{"import"},
+	})
+	require.Nil(t, submitResp.Err)
+	_, state, err := mgr.jobFsm.QueryJob("report")
+	require.Nil(t, err)
+	require.Equal(t, JobStateCanceled, state)
 }
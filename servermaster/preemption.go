@@ -0,0 +1,122 @@
+package servermaster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/hanfei1991/microcosm/pkg/metadata"
+	"github.com/hanfei1991/microcosm/pkg/p2p"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// preemptionWaitTimeout limits how long the tasks wait for the preempted
+	// workers to exit. It's well below the timeout of ScheduleTask in the
+	// masters, so that they get NotEnoughResource and retry rather than
+	// time out.
+	preemptionWaitTimeout = 5 * time.Second
+	// preemptionWaitMargin is left before the deadline of the request to
+	// send the response.
+	preemptionWaitMargin = time.Second
+)
+
+// preemptionWait returns how long the tasks wait for the preempted workers
+// to exit, the response is sent before the deadline of the request.
+func preemptionWait(ctx context.Context) time.Duration {
+	wait := preemptionWaitTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if left := time.Until(deadline) - preemptionWaitMargin; left < wait {
+			wait = left
+		}
+	}
+	return wait
+}
+
+// preempt preempts the running workers of lower priority for the tasks that
+// are not allocated, and waits until the tasks are allocated after the
+// preempted workers exit. resp carries the tasks that have been allocated.
+func (s *Server) preempt(
+	ctx context.Context, tasks []*pb.ScheduleTask, resp *pb.TaskSchedulerResponse,
+) (bool, *pb.TaskSchedulerResponse) {
+	allocated := resp.GetSchedule()
+	remaining := make([]*pb.ScheduleTask, 0, len(tasks))
+	for _, task := range tasks {
+		if _, ok := allocated[task.GetTask().Id]; !ok {
+			remaining = append(remaining, task)
+		}
+	}
+	victims, ok := s.executorManager.Preempt(remaining)
+	if !ok {
+		return false, resp
+	}
+	for _, victim := range victims {
+		if err := s.notifyPreemption(ctx, victim); err != nil {
+			log.L().Warn("failed to notify preemption",
+				zap.String("worker-id", victim.WorkerID),
+				zap.String("master-id", victim.MasterID), zap.Error(err))
+		}
+	}
+
+	// The tasks are allocated right away if the request is about to time
+	// out, they are likely to fit when the master retries.
+	success, preemptResp := s.executorManager.AllocateGang(ctx, remaining, preemptionWait(ctx))
+	if !success {
+		return false, resp
+	}
+	if resp == nil || resp.Schedule == nil {
+		return true, preemptResp
+	}
+	for id, result := range preemptResp.GetSchedule() {
+		resp.Schedule[id] = result
+	}
+	return true, resp
+}
+
+// notifyPreemption asks the master of the victim to stop it.
+func (s *Server) notifyPreemption(ctx context.Context, victim *resource.Victim) error {
+	reason := fmt.Sprintf("preempted by worker %s", victim.PreemptedBy)
+	if victim.MasterID == lib.JobManagerUUID {
+		return s.jobManager.EvictWorker(ctx, victim.WorkerID,
+			errors.ErrWorkerPreempted.GenWithStackByArgs(victim.WorkerID, reason))
+	}
+	return s.sendToMaster(ctx, victim.MasterID, lib.PreemptWorkerTopic(victim.MasterID),
+		&lib.PreemptWorkerMessage{WorkerID: victim.WorkerID, Reason: reason})
+}
+
+// notifyMigration asks the master of the victim to move it out of the
+// executor being drained.
+func (s *Server) notifyMigration(ctx context.Context, victim *resource.Victim) error {
+	reason := fmt.Sprintf("executor %s is drained", victim.ExecutorID)
+	// The job manager runs in the server master, the job masters are
+	// migrated directly.
+	if victim.MasterID == lib.JobManagerUUID {
+		return s.jobManager.EvictWorker(ctx, victim.WorkerID,
+			errors.ErrWorkerMigrated.GenWithStackByArgs(victim.WorkerID, reason))
+	}
+	return s.sendToMaster(ctx, victim.MasterID, lib.MigrateWorkerTopic(victim.MasterID),
+		&lib.MigrateWorkerMessage{WorkerID: victim.WorkerID, Reason: reason})
+}
+
+// sendToMaster sends a message to the node of a master, the node is found by
+// the metadata of the master.
+func (s *Server) sendToMaster(ctx context.Context, masterID string, topic p2p.Topic, msg interface{}) error {
+	metaClient := lib.NewMasterMetadataClient(masterID, metadata.NewMetaEtcd(s.etcdClient))
+	masterMeta, err := metaClient.Load(ctx)
+	if err != nil {
+		return err
+	}
+	ok, err := p2p.NewMessageSender(s.p2pMsgRouter).SendToNode(ctx, masterMeta.NodeID, topic, msg)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.ErrMessageNotDelivered.GenWithStackByArgs(topic, masterMeta.NodeID)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package servermaster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hanfei1991/microcosm/lib"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// rebalancer moves the workers from the executors of high cpu utilization to
+// the ones of low utilization periodically, so that the executors joining
+// the cluster share the load of the hot ones.
+type rebalancer struct {
+	cfg             *RebalanceConfig
+	executorManager ExecutorManager
+	// migrate asks the master of a worker to move it.
+	migrate func(ctx context.Context, migration *resource.Migration) error
+	limiter *rate.Limiter
+}
+
+func newRebalancer(
+	cfg *RebalanceConfig,
+	executorManager ExecutorManager,
+	migrate func(ctx context.Context, migration *resource.Migration) error,
+) *rebalancer {
+	return &rebalancer{
+		cfg:             cfg,
+		executorManager: executorManager,
+		migrate:         migrate,
+		limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(cfg.MaxMigrationsPerMinute)),
+			cfg.MaxMigrationsPerRound),
+	}
+}
+
+// Start starts rebalancing in background until ctx is done.
+func (r *rebalancer) Start(ctx context.Context) {
+	go r.run(ctx)
+}
+
+func (r *rebalancer) run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.L().Info("rebalancer exited")
+			return
+		case <-ticker.C:
+			r.rebalanceOnce(ctx)
+		}
+	}
+}
+
+// rebalanceOnce runs a round of rebalancing, and returns the planned
+// migrations. The workers are not moved in dry-run mode.
+func (r *rebalancer) rebalanceOnce(ctx context.Context) []*resource.Migration {
+	// The migrations are limited by the rate limiter, the quota that is not
+	// used in this round is given back.
+	reservations := make([]*rate.Reservation, 0, r.cfg.MaxMigrationsPerRound)
+	for len(reservations) < r.cfg.MaxMigrationsPerRound {
+		reservation := r.limiter.Reserve()
+		if !reservation.OK() || reservation.Delay() > 0 {
+			reservation.Cancel()
+			break
+		}
+		reservations = append(reservations, reservation)
+	}
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	migrations := r.executorManager.Rebalance(resource.RebalanceOptions{
+		Threshold:     r.cfg.Threshold,
+		MaxMigrations: len(reservations),
+		DryRun:        r.cfg.DryRun,
+	})
+	for _, reservation := range reservations[len(migrations):] {
+		reservation.Cancel()
+	}
+	for _, migration := range migrations {
+		log.L().Info("worker is chosen to be moved for rebalancing",
+			zap.String("worker-id", migration.WorkerID),
+			zap.String("master-id", migration.MasterID),
+			zap.String("from", string(migration.From)),
+			zap.String("to", string(migration.To)),
+			zap.Bool("dry-run", r.cfg.DryRun))
+		if r.cfg.DryRun {
+			continue
+		}
+		if err := r.migrate(ctx, migration); err != nil {
+			log.L().Warn("failed to notify migration",
+				zap.String("worker-id", migration.WorkerID),
+				zap.String("master-id", migration.MasterID), zap.Error(err))
+		}
+	}
+	return migrations
+}
+
+// notifyRebalance asks the master of a worker to move it to the target
+// executor.
+func (s *Server) notifyRebalance(ctx context.Context, migration *resource.Migration) error {
+	reason := fmt.Sprintf("rebalanced from executor %s to %s", migration.From, migration.To)
+	return s.sendToMaster(ctx, migration.MasterID, lib.MigrateWorkerTopic(migration.MasterID),
+		&lib.MigrateWorkerMessage{WorkerID: migration.WorkerID, Reason: reason, Target: migration.To})
+}
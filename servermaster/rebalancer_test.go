@@ -0,0 +1,83 @@
+package servermaster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/version"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebalancer(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := resource.NewSchedulingStrategy(resource.DefaultStrategy)
+	require.NoError(t, err)
+	mgr := NewExecutorManagerImpl(time.Minute, time.Second, strategy, nil)
+	register := func(addr string) model.ExecutorID {
+		info, err := mgr.AllocateNewExec(&pb.RegisterExecutorRequest{Address: addr, Capability: 100, ProtocolVersion: version.ProtocolVersion})
+		require.NoError(t, err)
+		return info.ID
+	}
+	heartbeat := func(id model.ExecutorID, usage int32, workers ...string) {
+		resp, err := mgr.HandleHeartbeat(&pb.HeartbeatRequest{
+			ExecutorId:    string(id),
+			ResourceUsage: usage,
+			Status:        int32(model.Running),
+			Ttl:           uint64(time.Minute.Milliseconds()),
+			WorkerIds:     workers,
+		})
+		require.NoError(t, err)
+		require.Nil(t, resp.Err)
+	}
+
+	hot := register("127.0.0.1:10001")
+	heartbeat(hot, 0)
+	workers := []string{"worker-1", "worker-2"}
+	for _, workerID := range workers {
+		ok, _ := mgr.Allocate([]*pb.ScheduleTask{{
+			Task: &pb.TaskRequest{Id: 1}, Cost: 40, WorkerId: workerID, MasterId: "master",
+		}})
+		require.True(t, ok)
+	}
+	heartbeat(hot, 80, workers...)
+	cold := register("127.0.0.1:10002")
+	heartbeat(cold, 0)
+
+	var migrated []*resource.Migration
+	cfg := &RebalanceConfig{Threshold: 0.2, MaxMigrationsPerRound: 1, MaxMigrationsPerMinute: 1, DryRun: true}
+	r := newRebalancer(cfg, mgr, func(ctx context.Context, migration *resource.Migration) error {
+		migrated = append(migrated, migration)
+		return nil
+	})
+	expected := []*resource.Migration{{WorkerID: "worker-1", MasterID: "master", From: hot, To: cold}}
+
+	// the workers are not moved in dry-run mode
+	require.Equal(t, expected, r.rebalanceOnce(context.Background()))
+	require.Empty(t, migrated)
+
+	cfg.DryRun = false
+	r = newRebalancer(cfg, mgr, r.migrate)
+	require.Equal(t, expected, r.rebalanceOnce(context.Background()))
+	require.Equal(t, expected, migrated)
+	// the migrations are rate limited
+	require.Empty(t, r.rebalanceOnce(context.Background()))
+	require.Len(t, migrated, 1)
+}
+
+func TestRebalanceConfigAdjust(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range []string{"", "0s", "-1m"} {
+		cfg := &RebalanceConfig{IntervalStr: interval}
+		require.NoError(t, cfg.adjust())
+		require.Equal(t, defaultRebalanceInterval, cfg.IntervalStr)
+		require.Greater(t, cfg.Interval, time.Duration(0))
+	}
+	cfg := &RebalanceConfig{IntervalStr: "invalid"}
+	require.Error(t, cfg.adjust())
+}
@@ -1,39 +1,117 @@
 package resource
 
 import (
+	"context"
+	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/hanfei1991/microcosm/model"
 	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/clock"
 	"github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"go.uber.org/zap"
 )
 
+// defaultReservationTTL is how long the resource reserved for a worker is kept
+// before the executor reports the worker in heartbeats. The worker is regarded
+// as failed to be dispatched after that.
+const defaultReservationTTL = 30 * time.Second
+
+// reservation is the resource reserved for a worker.
+type reservation struct {
+	// key is the worker ID, or a generated key if the worker ID is unknown.
+	key        string
+	masterID   string
+	executorID model.ExecutorID
+	resource   model.Resource
+	createdAt  time.Time
+	// confirmed is set after the executor reports the worker in heartbeats.
+	confirmed bool
+	// anonymous is set if the key is generated.
+	anonymous bool
+
+	priority       int32
+	nonPreemptible bool
+	workerType     int64
+	// pinned is set if the worker is required to run in specific executors,
+	// it is not moved for rebalancing.
+	pinned bool
+	// evictedAt is when the worker is asked to exit, for preemption, for
+	// draining the executor or for rebalancing. The worker can be asked
+	// again if it is still running after reservationTTL.
+	evictedAt time.Time
+}
+
+// evictable returns whether the worker can be asked to exit now. The workers
+// reported by executors of older versions can't, since their masters are
+// unknown.
+func (r *reservation) evictable(now time.Time, ttl time.Duration) bool {
+	return r.confirmed && r.masterID != "" &&
+		(r.evictedAt.IsZero() || now.Sub(r.evictedAt) > ttl)
+}
+
 // CapRescMgr implements ResourceMgr interface, and it uses node capacity as
 // alloction algorithm
 type CapRescMgr struct {
 	mu        sync.Mutex
 	executors map[model.ExecutorID]*ExecutorResource
+	// strategy is the default scheduling strategy of the cluster, which is
+	// used if the task doesn't specify one.
+	strategy SchedulingStrategy
+
+	// reservations records the resource reserved for the workers, the sum of
+	// the reservations of an executor is its Reserved.
+	reservations   map[string]*reservation
+	reservationTTL time.Duration
+	// anonymousSeq generates the keys of the reservations for the tasks
+	// without worker IDs, these reservations expire after reservationTTL.
+	anonymousSeq int64
+	clock        clock.Clock
+
+	// waiters are the gang requests waiting for resource, ordered by priority
+	// and then by arrival.
+	waiters []*gangWaiter
 }
 
-func NewCapRescMgr() *CapRescMgr {
+// gangWaiter is a gang request waiting for resource, resp is set and done is
+// closed after all of the tasks are allocated.
+type gangWaiter struct {
+	tasks    []*pb.ScheduleTask
+	priority int32
+	resp     *pb.TaskSchedulerResponse
+	done     chan struct{}
+}
+
+func NewCapRescMgr(strategy SchedulingStrategy) *CapRescMgr {
 	return &CapRescMgr{
-		executors: make(map[model.ExecutorID]*ExecutorResource),
+		executors:      make(map[model.ExecutorID]*ExecutorResource),
+		strategy:       strategy,
+		reservations:   make(map[string]*reservation),
+		reservationTTL: defaultReservationTTL,
+		clock:          clock.New(),
 	}
 }
 
 // Register implements RescMgr.Register
-func (m *CapRescMgr) Register(id model.ExecutorID, addr string, capacity model.RescUnit) {
+func (m *CapRescMgr) Register(
+	id model.ExecutorID, addr string, capacity model.Resource,
+	labels map[string]string, workerTypes []int64,
+) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.executors[id] = &ExecutorResource{
-		ID:       id,
-		Capacity: capacity,
-		Addr:     addr,
+		ID:          id,
+		Capacity:    capacity,
+		Addr:        addr,
+		Labels:      labels,
+		WorkerTypes: workerTypes,
 	}
 	log.L().Info("executor resource is registered",
-		zap.String("executor-id", string(id)), zap.Int("capacity", int(capacity)))
+		zap.String("executor-id", string(id)), zap.Any("capacity", capacity),
+		zap.Any("labels", labels), zap.Int64s("worker-types", workerTypes))
 }
 
 // Unregister implements RescMgr.Unregister
@@ -41,17 +119,102 @@ func (m *CapRescMgr) Unregister(id model.ExecutorID) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	delete(m.executors, id)
+	for key, r := range m.reservations {
+		if r.executorID == id {
+			delete(m.reservations, key)
+		}
+	}
 	log.L().Info("executor resource is unregistered",
 		zap.String("executor-id", string(id)))
 }
 
 // Allocate implements RescMgr.Allocate
 func (m *CapRescMgr) Allocate(tasks []*pb.ScheduleTask) (bool, *pb.TaskSchedulerResponse) {
-	return m.allocateTasksWithNaiveStrategy(tasks)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.allocateTasksLocked(tasks, false /* gang */)
+}
+
+// AllocateGang implements RescMgr.AllocateGang
+func (m *CapRescMgr) AllocateGang(
+	ctx context.Context, tasks []*pb.ScheduleTask, wait time.Duration,
+) (bool, *pb.TaskSchedulerResponse) {
+	w := &gangWaiter{tasks: tasks, priority: tasksPriority(tasks), done: make(chan struct{})}
+	m.mu.Lock()
+	// The request doesn't overtake the waiting ones of higher or equal
+	// priority, otherwise a large gang might wait forever.
+	idx := sort.Search(len(m.waiters), func(i int) bool {
+		return m.waiters[i].priority < w.priority
+	})
+	if idx == 0 {
+		if ok, resp := m.allocateTasksLocked(tasks, true /* gang */); ok {
+			m.mu.Unlock()
+			return true, resp
+		}
+	}
+	if wait <= 0 {
+		m.mu.Unlock()
+		return false, nil
+	}
+	m.waiters = append(m.waiters, nil)
+	copy(m.waiters[idx+1:], m.waiters[idx:])
+	m.waiters[idx] = w
+	m.mu.Unlock()
+
+	select {
+	case <-w.done:
+		return true, w.resp
+	case <-ctx.Done():
+	case <-m.clock.After(wait):
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if w.resp != nil {
+		// The request has been allocated right before it times out.
+		return true, w.resp
+	}
+	for i, waiter := range m.waiters {
+		if waiter == w {
+			m.waiters = append(m.waiters[:i], m.waiters[i+1:]...)
+			break
+		}
+	}
+	// The requests behind it might be allocated now.
+	m.allocateWaitersLocked()
+	return false, nil
+}
+
+// tasksPriority returns the highest priority of the tasks.
+func tasksPriority(tasks []*pb.ScheduleTask) int32 {
+	var ret int32
+	for i, task := range tasks {
+		if i == 0 || task.GetPriority() > ret {
+			ret = task.GetPriority()
+		}
+	}
+	return ret
+}
+
+// allocateWaitersLocked allocates the waiting gang requests in order, it's
+// called after resource is freed.
+func (m *CapRescMgr) allocateWaitersLocked() {
+	for len(m.waiters) > 0 {
+		w := m.waiters[0]
+		ok, resp := m.allocateTasksLocked(w.tasks, true /* gang */)
+		if !ok {
+			return
+		}
+		w.resp = resp
+		close(w.done)
+		m.waiters = m.waiters[1:]
+	}
 }
 
 // Update implements RescMgr.Update
-func (m *CapRescMgr) Update(id model.ExecutorID, used, reserved model.RescUnit, status model.ExecutorStatus) error {
+func (m *CapRescMgr) Update(
+	id model.ExecutorID, used model.Resource, workers []*pb.RunningWorker, status model.ExecutorStatus,
+) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	exec, ok := m.executors[id]
@@ -59,56 +222,354 @@ func (m *CapRescMgr) Update(id model.ExecutorID, used, reserved model.RescUnit,
 		return errors.ErrUnknownExecutorID.GenWithStackByArgs(id)
 	}
 	exec.Used = used
-	exec.Reserved = reserved
 	exec.Status = status
+
+	exec.Workers = make([]string, 0, len(workers))
+	running := make(map[string]*pb.RunningWorker, len(workers))
+	for _, worker := range workers {
+		exec.Workers = append(exec.Workers, worker.GetWorkerId())
+		running[worker.GetWorkerId()] = worker
+	}
+	now := m.clock.Now()
+	for key, r := range m.reservations {
+		if r.executorID != id {
+			continue
+		}
+		if _, ok := running[key]; ok {
+			r.confirmed = true
+			delete(running, key)
+			continue
+		}
+		// The worker has exited, or it has never been dispatched.
+		if r.confirmed || now.Sub(r.createdAt) > m.reservationTTL {
+			m.releaseLocked(key)
+		}
+	}
+	// The workers without reservations were scheduled before the server
+	// master failed over, or by another executor before they were moved.
+	for _, worker := range running {
+		m.recoverLocked(id, worker)
+	}
+	m.allocateWaitersLocked()
+	return nil
+}
+
+// recoverLocked rebuilds the reservation of a running worker from what the
+// executor reports.
+func (m *CapRescMgr) recoverLocked(id model.ExecutorID, worker *pb.RunningWorker) {
+	r := &reservation{
+		key:            worker.GetWorkerId(),
+		masterID:       worker.GetMasterId(),
+		executorID:     id,
+		resource:       model.NewResourceFromPB(worker.GetResource(), 0),
+		confirmed:      true,
+		priority:       worker.GetPriority(),
+		nonPreemptible: worker.GetNonPreemptible(),
+		workerType:     worker.GetWorkerType(),
+		pinned:         worker.GetPinned(),
+	}
+	m.reserveLocked(r)
+	log.L().Info("reservation is recovered from heartbeat",
+		zap.String("worker-id", r.key), zap.String("master-id", r.masterID),
+		zap.String("executor-id", string(id)), zap.Any("resource", r.resource))
+}
+
+// UpdateWorkload implements RescMgr.UpdateWorkload
+func (m *CapRescMgr) UpdateWorkload(id model.ExecutorID, workloads map[model.WorkloadType]model.RescUnit) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	exec, ok := m.executors[id]
+	if !ok {
+		return errors.ErrUnknownExecutorID.GenWithStackByArgs(id)
+	}
+	exec.Workloads = workloads
+	// The waiters may fit if the workloads have shrunk.
+	m.allocateWaitersLocked()
+	return nil
+}
+
+// Release implements RescMgr.Release
+func (m *CapRescMgr) Release(workerIDs []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, workerID := range workerIDs {
+		m.releaseLocked(workerID)
+	}
+	m.allocateWaitersLocked()
+}
+
+func (m *CapRescMgr) reserveLocked(r *reservation) {
+	if r.key == "" {
+		m.anonymousSeq++
+		r.key = fmt.Sprintf("anonymous-%d", m.anonymousSeq)
+		r.anonymous = true
+	}
+	// The worker might be scheduled again after it failed to be dispatched.
+	m.releaseLocked(r.key)
+	r.createdAt = m.clock.Now()
+	m.reservations[r.key] = r
+	if exec, ok := m.executors[r.executorID]; ok {
+		exec.Reserved = exec.Reserved.Add(r.resource)
+	}
+}
+
+func (m *CapRescMgr) releaseLocked(key string) {
+	r, ok := m.reservations[key]
+	if !ok {
+		return
+	}
+	delete(m.reservations, key)
+	if exec, ok := m.executors[r.executorID]; ok {
+		exec.Reserved = exec.Reserved.Sub(r.resource)
+	}
+	log.L().Debug("resource is released", zap.String("key", key),
+		zap.String("executor-id", string(r.executorID)), zap.Any("resource", r.resource))
+}
+
+// Cordon implements RescMgr.Cordon
+func (m *CapRescMgr) Cordon(id model.ExecutorID, cordoned bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	exec, ok := m.executors[id]
+	if !ok {
+		return errors.ErrUnknownExecutorID.GenWithStackByArgs(id)
+	}
+	exec.Cordoned = cordoned
+	log.L().Info("executor cordon is updated",
+		zap.String("executor-id", string(id)), zap.Bool("cordoned", cordoned))
+	if !cordoned {
+		m.allocateWaitersLocked()
+	}
 	return nil
 }
 
-// getAvailableResource returns resources that are available
+// Drain implements RescMgr.Drain
+func (m *CapRescMgr) Drain(id model.ExecutorID) ([]string, []*Victim, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	exec, ok := m.executors[id]
+	if !ok {
+		return nil, nil, errors.ErrUnknownExecutorID.GenWithStackByArgs(id)
+	}
+	exec.Cordoned = true
+
+	now := m.clock.Now()
+	remaining := make([]string, 0, len(exec.Workers))
+	reported := make(map[string]struct{}, len(exec.Workers))
+	for _, workerID := range exec.Workers {
+		remaining = append(remaining, workerID)
+		reported[workerID] = struct{}{}
+	}
+	victims := make([]*Victim, 0)
+	for key, r := range m.reservations {
+		// The anonymous reservations are not workers, they just expire.
+		if r.executorID != id || r.anonymous {
+			continue
+		}
+		// The worker is being dispatched.
+		if _, ok := reported[key]; !ok {
+			remaining = append(remaining, key)
+		}
+		if !r.evictable(now, m.reservationTTL) {
+			continue
+		}
+		r.evictedAt = now
+		victims = append(victims, &Victim{
+			WorkerID:   key,
+			MasterID:   r.masterID,
+			ExecutorID: id,
+			Priority:   r.priority,
+		})
+	}
+	sort.Strings(remaining)
+	sort.Slice(victims, func(i, j int) bool {
+		return victims[i].WorkerID < victims[j].WorkerID
+	})
+	return remaining, victims, nil
+}
+
+// Executors implements RescMgr.Executors
+func (m *CapRescMgr) Executors(ids []model.ExecutorID) ([]*ExecutorResource, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(ids) == 0 {
+		ids = make([]model.ExecutorID, 0, len(m.executors))
+		for id := range m.executors {
+			ids = append(ids, id)
+		}
+	}
+	ret := make([]*ExecutorResource, 0, len(ids))
+	for _, id := range ids {
+		exec, ok := m.executors[id]
+		if !ok {
+			return nil, errors.ErrUnknownExecutorID.GenWithStackByArgs(id)
+		}
+		ret = append(ret, exec.clone())
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret, nil
+}
+
+// getAvailableResource returns resources that are available, ordered by
+// executor ID. The cordoned executors are excluded. Whether an executor has
+// enough resource in every dimension is checked against each task.
 func (m *CapRescMgr) getAvailableResource() []*ExecutorResource {
 	res := make([]*ExecutorResource, 0)
 	for _, exec := range m.executors {
-		if exec.Status == model.Running &&
-			exec.Capacity > exec.Reserved && exec.Capacity > exec.Used {
+		if exec.Status == model.Running && !exec.Cordoned {
 			res = append(res, exec)
 		}
 	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
 	return res
 }
 
-func (m *CapRescMgr) allocateTasksWithNaiveStrategy(
-	tasks []*pb.ScheduleTask,
+// allocateTasksLocked allocates the tasks and reserves the resource for the
+// allocated ones. If gang is set, either all of the tasks are allocated or
+// none of them, otherwise the tasks are allocated independently, and false is
+// returned if any of them is not allocated.
+func (m *CapRescMgr) allocateTasksLocked(
+	tasks []*pb.ScheduleTask, gang bool,
 ) (bool, *pb.TaskSchedulerResponse) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
 	result := make(map[int64]*pb.ScheduleResult)
 	resources := m.getAvailableResource()
 	if len(resources) == 0 {
 		// No resources in this cluster
 		return false, nil
 	}
-	var idx int = 0
+	// pending are the reservations of the tasks in this request, so that the
+	// tasks don't overcommit an executor together.
+	pending := make([]*reservation, 0, len(tasks))
+	allPlaced := true
 	for _, task := range tasks {
-		originalIdx := idx
-		for {
-			exec := resources[idx]
-			used := exec.Used
-			if exec.Reserved > used {
-				used = exec.Reserved
-			}
-			rest := exec.Capacity - used
-			if rest >= model.RescUnit(task.Cost) {
-				result[task.GetTask().Id] = &pb.ScheduleResult{
-					ExecutorId: string(exec.ID),
-					Addr:       exec.Addr,
-				}
-				break
-			}
-			idx = (idx + 1) % len(resources)
-			if idx == originalIdx {
+		r := m.placeTaskLocked(task, resources, pending)
+		if r == nil {
+			if gang {
 				return false, nil
 			}
+			allPlaced = false
+			continue
+		}
+		result[task.GetTask().Id] = &pb.ScheduleResult{
+			ExecutorId: string(r.executorID),
+			Addr:       m.executors[r.executorID].Addr,
+		}
+		pending = append(pending, r)
+	}
+	for _, r := range pending {
+		m.reserveLocked(r)
+	}
+	return allPlaced, &pb.TaskSchedulerResponse{Schedule: result}
+}
+
+// placeTaskLocked picks an executor for the task, the reservations of the
+// previous tasks in the same request are pending. nil is returned if no
+// executor fits.
+func (m *CapRescMgr) placeTaskLocked(
+	task *pb.ScheduleTask, resources []*ExecutorResource, pending []*reservation,
+) *reservation {
+	strategy := m.strategy
+	if name := task.GetStrategy(); name != "" {
+		var err error
+		strategy, err = NewSchedulingStrategy(name)
+		if err != nil {
+			log.L().Warn("failed to allocate task", zap.Error(err))
+			return nil
+		}
+	}
+	allocated := make(map[model.ExecutorID]model.Resource)
+	for _, r := range pending {
+		allocated[r.executorID] = allocated[r.executorID].Add(r.resource)
+	}
+	cost := model.NewResourceFromPB(task.Resource, task.Cost)
+	candidates := make([]*Candidate, 0, len(resources))
+	for _, exec := range resources {
+		if !exec.SupportsWorkerType(task.GetWorkerType()) {
+			continue
+		}
+		available := exec.Available().Sub(allocated[exec.ID])
+		if cost.Fits(available) {
+			candidates = append(candidates, &Candidate{ExecutorResource: exec, Available: available})
+		}
+	}
+	if selector := task.GetLabelSelector(); len(selector) > 0 {
+		candidates = filterCandidates(candidates, func(c *Candidate) bool {
+			return c.MatchLabels(selector)
+		}, true)
+	}
+	if location := task.GetPreferredLocation(); location != "" {
+		candidates = filterCandidates(candidates, func(c *Candidate) bool {
+			return c.MatchLocation(location)
+		}, task.GetLocationRequired())
+	}
+	if affinity := task.GetAffinity(); affinity != pb.WorkerAffinity_NoAffinity {
+		colocated := m.masterHostsLocked(task.GetMasterId(), pending)
+		candidates = filterCandidates(candidates, func(c *Candidate) bool {
+			_, ok := colocated[c.Host()]
+			return ok == (affinity == pb.WorkerAffinity_Affinity)
+		}, task.GetAffinityRequired())
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	exec := strategy.Pick(task, candidates)
+	return newReservation(task, exec.ID, cost)
+}
+
+func newReservation(task *pb.ScheduleTask, executorID model.ExecutorID, cost model.Resource) *reservation {
+	return &reservation{
+		key:            task.GetWorkerId(),
+		masterID:       task.GetMasterId(),
+		executorID:     executorID,
+		resource:       cost,
+		priority:       task.GetPriority(),
+		nonPreemptible: task.GetNonPreemptible(),
+		workerType:     task.GetWorkerType(),
+		pinned: task.GetLocationRequired() || task.GetAffinityRequired() ||
+			len(task.GetLabelSelector()) > 0,
+	}
+}
+
+// filterCandidates returns the candidates that match. If none of them match,
+// all the candidates are returned unless required is set.
+func filterCandidates(candidates []*Candidate, match func(*Candidate) bool, required bool) []*Candidate {
+	matched := make([]*Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if match(c) {
+			matched = append(matched, c)
 		}
 	}
-	return true, &pb.TaskSchedulerResponse{Schedule: result}
+	if len(matched) > 0 || required {
+		return matched
+	}
+	return candidates
+}
+
+// masterHostsLocked returns the hosts of the workers of the master, including
+// the ones being allocated. The executors in the same host are taken as
+// co-located, since they go down together if the host crashes.
+func (m *CapRescMgr) masterHostsLocked(masterID string, pending []*reservation) map[string]struct{} {
+	ret := make(map[string]struct{})
+	if masterID == "" {
+		return ret
+	}
+	add := func(r *reservation) {
+		if r.masterID != masterID {
+			return
+		}
+		if exec, ok := m.executors[r.executorID]; ok {
+			ret[exec.Host()] = struct{}{}
+		}
+	}
+	for _, r := range m.reservations {
+		add(r)
+	}
+	for _, r := range pending {
+		add(r)
+	}
+	return ret
 }
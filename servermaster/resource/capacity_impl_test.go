@@ -0,0 +1,502 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/clock"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// runningWorkers returns the workers reported in heartbeats by their IDs.
+func runningWorkers(workerIDs ...string) []*pb.RunningWorker {
+	ret := make([]*pb.RunningWorker, 0, len(workerIDs))
+	for _, workerID := range workerIDs {
+		ret = append(ret, &pb.RunningWorker{WorkerId: workerID})
+	}
+	return ret
+}
+
+func newTaskWithResource(workerID string, cost int64, res *pb.Resource) *pb.ScheduleTask {
+	return &pb.ScheduleTask{Task: &pb.TaskRequest{Id: 1}, Cost: cost, Resource: res, WorkerId: workerID}
+}
+
+func TestCapRescMgrAllocateMultiDimensions(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	// executor-1 has plenty of cpu but no memory declared.
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 1000}, nil, nil)
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100, Memory: 1024, Disk: 1024}, nil, nil)
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+
+	// a task requiring memory is only allocated to the executor with memory
+	for _, workerID := range []string{"worker-1", "worker-2"} {
+		ok, resp := mgr.Allocate([]*pb.ScheduleTask{
+			newTaskWithResource(workerID, 0, &pb.Resource{Cpu: 10, Memory: 512}),
+		})
+		require.True(t, ok)
+		require.Equal(t, "executor-2", resp.Schedule[1].ExecutorId)
+	}
+	ok, _ := mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-3", 0, &pb.Resource{Cpu: 10, Memory: 512})})
+	require.False(t, ok)
+
+	// the legacy cost is taken as cpu
+	ok, resp := mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-3", 500, nil)})
+	require.True(t, ok)
+	require.Equal(t, "executor-1", resp.Schedule[1].ExecutorId)
+
+	// the tasks in one request don't overcommit an executor together
+	mgr.Release([]string{"worker-1", "worker-2"})
+	ok, _ = mgr.Allocate([]*pb.ScheduleTask{
+		newTaskWithResource("worker-4", 0, &pb.Resource{Cpu: 10, Memory: 768}),
+		newTaskWithResource("worker-5", 0, &pb.Resource{Cpu: 10, Memory: 768}),
+	})
+	require.False(t, ok)
+
+	// the used resource is deducted
+	require.NoError(t, mgr.Update("executor-2", model.Resource{CPU: 10, Memory: 768}, nil, model.Running))
+	ok, _ = mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-4", 0, &pb.Resource{Cpu: 10, Memory: 512})})
+	require.False(t, ok)
+	ok, resp = mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-4", 0, &pb.Resource{Cpu: 10, Memory: 256, Disk: 1024})})
+	require.True(t, ok)
+	require.Equal(t, "executor-2", resp.Schedule[1].ExecutorId)
+
+	// an executor without free cpu still takes a task requiring no cpu
+	mgr.Release([]string{"worker-4"})
+	require.NoError(t, mgr.Update("executor-2", model.Resource{CPU: 100}, nil, model.Running))
+	ok, resp = mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-5", 0, &pb.Resource{Memory: 256})})
+	require.True(t, ok)
+	require.Equal(t, "executor-2", resp.Schedule[1].ExecutorId)
+}
+
+func TestCapRescMgrAllocatePreferredLocation(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "10.0.0.1:10001", model.Resource{CPU: 100}, map[string]string{"rack": "r1"}, nil)
+	mgr.Register("executor-2", "10.0.0.2:10001", model.Resource{CPU: 100}, map[string]string{"rack": "r2"}, nil)
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+
+	newTask := func(location string, required bool) *pb.ScheduleTask {
+		return &pb.ScheduleTask{
+			Task:              &pb.TaskRequest{Id: 1},
+			Cost:              60,
+			PreferredLocation: location,
+			LocationRequired:  required,
+			WorkerId:          "worker-1",
+		}
+	}
+
+	for _, location := range []string{"executor-2", "10.0.0.2:10001", "10.0.0.2", "rack=r2"} {
+		ok, resp := mgr.Allocate([]*pb.ScheduleTask{newTask(location, true)})
+		require.True(t, ok)
+		require.Equal(t, "executor-2", resp.Schedule[1].ExecutorId, location)
+		mgr.Release([]string{"worker-1"})
+	}
+	ok, _ := mgr.Allocate([]*pb.ScheduleTask{newTask("rack=r3", true)})
+	require.False(t, ok)
+
+	// executor-2 is full, a soft preference falls back to executor-1 while a
+	// hard one can't be placed.
+	require.NoError(t, mgr.Update("executor-2", model.Resource{CPU: 50}, nil, model.Running))
+	ok, _ = mgr.Allocate([]*pb.ScheduleTask{newTask("rack=r2", true)})
+	require.False(t, ok)
+	ok, resp := mgr.Allocate([]*pb.ScheduleTask{newTask("rack=r2", false)})
+	require.True(t, ok)
+	require.Equal(t, "executor-1", resp.Schedule[1].ExecutorId)
+}
+
+func TestCapRescMgrReservation(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mockClock := clock.NewMock()
+	mgr.clock = mockClock
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, nil, model.Running))
+
+	allocate := func(workerIDs ...string) bool {
+		tasks := make([]*pb.ScheduleTask, 0, len(workerIDs))
+		for i, workerID := range workerIDs {
+			tasks = append(tasks, &pb.ScheduleTask{
+				Task: &pb.TaskRequest{Id: int64(i)}, Cost: 40, WorkerId: workerID,
+			})
+		}
+		ok, _ := mgr.AllocateGang(context.Background(), tasks, 0)
+		return ok
+	}
+	reserved := func() model.RescUnit {
+		mgr.mu.Lock()
+		defer mgr.mu.Unlock()
+		return mgr.executors["executor-1"].Reserved.CPU
+	}
+
+	// the resource is reserved before the next heartbeat
+	require.True(t, allocate("worker-1"))
+	require.True(t, allocate("worker-2"))
+	require.False(t, allocate("worker-3"))
+	require.Equal(t, model.RescUnit(80), reserved())
+
+	// nothing is reserved if not all tasks are allocated
+	mgr.Release([]string{"worker-2"})
+	require.False(t, allocate("worker-3", "worker-4"))
+	require.Equal(t, model.RescUnit(40), reserved())
+
+	// worker-1 is running and worker-3 is being dispatched
+	require.True(t, allocate("worker-3"))
+	require.NoError(t, mgr.Update("executor-1", model.Resource{CPU: 10}, runningWorkers("worker-1"), model.Running))
+	require.Equal(t, model.RescUnit(80), reserved())
+
+	// worker-1 has exited
+	require.NoError(t, mgr.Update("executor-1", model.Resource{CPU: 10}, nil, model.Running))
+	require.Equal(t, model.RescUnit(40), reserved())
+
+	// worker-3 is never reported by the executor
+	mockClock.Add(defaultReservationTTL + time.Second)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{CPU: 10}, nil, model.Running))
+	require.Equal(t, model.RescUnit(0), reserved())
+
+	// the reservations are dropped with the executor
+	require.True(t, allocate("worker-5"))
+	mgr.Unregister("executor-1")
+	require.Empty(t, mgr.reservations)
+}
+
+func TestCapRescMgrRecoverReservations(t *testing.T) {
+	t.Parallel()
+
+	// The server master has failed over, and the new one knows nothing about
+	// the workers scheduled before.
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	workers := []*pb.RunningWorker{
+		{
+			WorkerId: "worker-1", MasterId: "master-1", Resource: &pb.Resource{Cpu: 40, Memory: 256},
+			Priority: 10, NonPreemptible: true, WorkerType: 2, Pinned: true,
+		},
+		// reported by an executor of an older version
+		{WorkerId: "worker-2"},
+	}
+	require.NoError(t, mgr.Update("executor-1", model.Resource{CPU: 10}, workers, model.Running))
+	require.Equal(t, model.Resource{CPU: 40, Memory: 256}, mgr.executors["executor-1"].Reserved)
+	require.Equal(t, &reservation{
+		key:            "worker-1",
+		masterID:       "master-1",
+		executorID:     "executor-1",
+		resource:       model.Resource{CPU: 40, Memory: 256},
+		createdAt:      mgr.reservations["worker-1"].createdAt,
+		confirmed:      true,
+		priority:       10,
+		nonPreemptible: true,
+		workerType:     2,
+		pinned:         true,
+	}, mgr.reservations["worker-1"])
+	require.Contains(t, mgr.reservations, "worker-2")
+
+	// the recovered reservations are taken into account
+	ok, _ := mgr.Allocate([]*pb.ScheduleTask{{Task: &pb.TaskRequest{Id: 1}, Cost: 80, WorkerId: "worker-3"}})
+	require.False(t, ok)
+	victims, ok := mgr.Preempt([]*pb.ScheduleTask{
+		{Task: &pb.TaskRequest{Id: 1}, Cost: 80, WorkerId: "worker-3", Priority: 20},
+	})
+	require.False(t, ok)
+	require.Empty(t, victims)
+
+	// and released after the workers exit
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, nil, model.Running))
+	require.Empty(t, mgr.reservations)
+	require.Equal(t, model.Resource{}, mgr.executors["executor-1"].Reserved)
+}
+
+func TestCapRescMgrAllocateLabelsAndAffinity(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(binPackStrategy{})
+	mgr.Register("executor-1", "10.0.0.1:10001", model.Resource{CPU: 100}, map[string]string{"zone": "z1", "disk": "ssd"}, nil)
+	mgr.Register("executor-2", "10.0.0.2:10001", model.Resource{CPU: 100}, map[string]string{"zone": "z1", "disk": "hdd"}, nil)
+	mgr.Register("executor-3", "10.0.0.3:10001", model.Resource{CPU: 100}, map[string]string{"zone": "z2", "disk": "ssd"}, nil)
+	// executor-4 is in the same host as executor-1
+	mgr.Register("executor-4", "10.0.0.1:10002", model.Resource{CPU: 100}, map[string]string{"zone": "z2", "disk": "hdd"}, nil)
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2", "executor-3", "executor-4"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+
+	newTask := func(id int64, masterID string, affinity pb.WorkerAffinity, required bool) *pb.ScheduleTask {
+		return &pb.ScheduleTask{
+			Task:             &pb.TaskRequest{Id: id},
+			Cost:             30,
+			WorkerId:         fmt.Sprintf("%s-worker-%d", masterID, id),
+			MasterId:         masterID,
+			Affinity:         affinity,
+			AffinityRequired: required,
+		}
+	}
+
+	// the label selector is a hard constraint
+	task := newTask(1, "master-0", pb.WorkerAffinity_NoAffinity, false)
+	task.LabelSelector = map[string]string{"zone": "z1", "disk": "ssd"}
+	ok, resp := mgr.Allocate([]*pb.ScheduleTask{task})
+	require.True(t, ok)
+	require.Equal(t, "executor-1", resp.Schedule[1].ExecutorId)
+	task = newTask(2, "master-0", pb.WorkerAffinity_NoAffinity, false)
+	task.LabelSelector = map[string]string{"zone": "z3"}
+	ok, _ = mgr.Allocate([]*pb.ScheduleTask{task})
+	require.False(t, ok)
+
+	// anti-affinity keeps the workers of a master off a single host even
+	// though bin-pack prefers the most loaded executor
+	ok, resp = mgr.Allocate([]*pb.ScheduleTask{
+		newTask(1, "master-1", pb.WorkerAffinity_AntiAffinity, true),
+		newTask(2, "master-1", pb.WorkerAffinity_AntiAffinity, true),
+		newTask(3, "master-1", pb.WorkerAffinity_AntiAffinity, true),
+	})
+	require.True(t, ok)
+	hosts := make(map[string]struct{})
+	for _, result := range resp.Schedule {
+		hosts[mgr.executors[model.ExecutorID(result.ExecutorId)].Host()] = struct{}{}
+	}
+	require.Len(t, hosts, 3)
+	ok, _ = mgr.Allocate([]*pb.ScheduleTask{newTask(4, "master-1", pb.WorkerAffinity_AntiAffinity, true)})
+	require.False(t, ok)
+	ok, _ = mgr.Allocate([]*pb.ScheduleTask{newTask(4, "master-1", pb.WorkerAffinity_AntiAffinity, false)})
+	require.True(t, ok)
+
+	// affinity schedules the workers together
+	mgr.Release([]string{"master-0-worker-1", "master-1-worker-1", "master-1-worker-2", "master-1-worker-3", "master-1-worker-4"})
+	ok, resp = mgr.Allocate([]*pb.ScheduleTask{newTask(1, "master-2", pb.WorkerAffinity_NoAffinity, false)})
+	require.True(t, ok)
+	first := resp.Schedule[1].ExecutorId
+	require.NoError(t, mgr.Update(model.ExecutorID(first), model.Resource{}, runningWorkers("master-2-worker-1"), model.Running))
+	ok, resp = mgr.Allocate([]*pb.ScheduleTask{newTask(2, "master-2", pb.WorkerAffinity_Affinity, true)})
+	require.True(t, ok)
+	require.Equal(t, mgr.executors[model.ExecutorID(first)].Host(),
+		mgr.executors[model.ExecutorID(resp.Schedule[2].ExecutorId)].Host())
+}
+
+func TestCapRescMgrAllocateGang(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, nil)
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+	newTasks := func(prefix string, costs ...int64) []*pb.ScheduleTask {
+		tasks := make([]*pb.ScheduleTask, 0, len(costs))
+		for i, cost := range costs {
+			tasks = append(tasks, &pb.ScheduleTask{
+				Task: &pb.TaskRequest{Id: int64(i)}, Cost: cost, WorkerId: fmt.Sprintf("%s-%d", prefix, i),
+			})
+		}
+		return tasks
+	}
+	waiters := func() int {
+		mgr.mu.Lock()
+		defer mgr.mu.Unlock()
+		return len(mgr.waiters)
+	}
+	ctx := context.Background()
+
+	// the tasks of a non-gang request are allocated independently
+	ok, resp := mgr.Allocate(newTasks("a", 80, 80, 80))
+	require.False(t, ok)
+	require.Len(t, resp.Schedule, 2)
+
+	// nothing is allocated for a gang request that doesn't fit
+	ok, _ = mgr.AllocateGang(ctx, newTasks("b", 20, 20, 20), 0)
+	require.False(t, ok)
+	ok, resp = mgr.AllocateGang(ctx, newTasks("b", 20, 20), 0)
+	require.True(t, ok)
+	require.Len(t, resp.Schedule, 2)
+
+	// the gang requests wait in order until the resource is released
+	results := make(chan bool, 2)
+	go func() {
+		ok, _ := mgr.AllocateGang(ctx, newTasks("c", 50, 50), time.Minute)
+		results <- ok
+	}()
+	require.Eventually(t, func() bool { return waiters() == 1 }, time.Second, 10*time.Millisecond)
+	// "d" fits but doesn't overtake "c"
+	mgr.Release([]string{"b-0"})
+	go func() {
+		ok, _ := mgr.AllocateGang(ctx, newTasks("d", 10), time.Minute)
+		results <- ok
+	}()
+	require.Eventually(t, func() bool { return waiters() == 2 }, time.Second, 10*time.Millisecond)
+	mgr.Release([]string{"a-0", "a-1"})
+	require.True(t, <-results)
+	require.True(t, <-results)
+	require.Equal(t, 0, waiters())
+
+	// the waiting request gives up after the context is canceled
+	cctx, cancel := context.WithCancel(ctx)
+	done := make(chan bool)
+	go func() {
+		ok, _ := mgr.AllocateGang(cctx, newTasks("e", 60, 60), time.Minute)
+		done <- ok
+	}()
+	require.Eventually(t, func() bool { return waiters() == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+	require.False(t, <-done)
+	require.Equal(t, 0, waiters())
+}
+
+func TestCapRescMgrCordonAndDrain(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mockClock := clock.NewMock()
+	mgr.clock = mockClock
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, nil)
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+	allocate := func(workerID string) string {
+		ok, resp := mgr.Allocate([]*pb.ScheduleTask{newTaskWithPriority(workerID, 10, 0)})
+		require.True(t, ok)
+		return resp.Schedule[1].ExecutorId
+	}
+
+	// the cordoned executor is not allocated to new tasks
+	require.True(t, errors.ErrUnknownExecutorID.Equal(mgr.Cordon("executor-3", true)))
+	require.NoError(t, mgr.Cordon("executor-1", true))
+	require.Equal(t, "executor-2", allocate("worker-0"))
+	require.Equal(t, "executor-2", allocate("worker-1"))
+	require.NoError(t, mgr.Cordon("executor-1", false))
+	require.Equal(t, "executor-1", allocate("worker-2"))
+	require.Equal(t, "executor-1", allocate("worker-3"))
+
+	// only the running workers are asked to move elsewhere
+	require.NoError(t, mgr.Update("executor-2", model.Resource{}, runningWorkers("worker-0"), model.Running))
+	remaining, victims, err := mgr.Drain("executor-2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-0", "worker-1"}, remaining)
+	require.Equal(t, []*Victim{{WorkerID: "worker-0", MasterID: "master", ExecutorID: "executor-2"}}, victims)
+	require.Equal(t, "executor-1", allocate("worker-4"))
+
+	// the workers are asked only once, until they come online
+	require.NoError(t, mgr.Update("executor-2", model.Resource{}, runningWorkers("worker-0", "worker-1"), model.Running))
+	remaining, victims, err = mgr.Drain("executor-2")
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+	require.Len(t, victims, 1)
+	require.Equal(t, "worker-1", victims[0].WorkerID)
+
+	// the executor is drained after the workers exit
+	require.NoError(t, mgr.Update("executor-2", model.Resource{}, nil, model.Running))
+	remaining, victims, err = mgr.Drain("executor-2")
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+	require.Empty(t, victims)
+}
+
+func TestCapRescMgrDrainReportedWorkers(t *testing.T) {
+	t.Parallel()
+
+	// The workers were scheduled before the server master failed over.
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	workers := []*pb.RunningWorker{
+		{WorkerId: "worker-1", MasterId: "master-1", Resource: &pb.Resource{Cpu: 10}},
+		// reported by an executor of an older version
+		{WorkerId: "worker-2"},
+	}
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, workers, model.Running))
+
+	// every reported worker remains, but only the ones of known masters can
+	// be asked to move
+	remaining, victims, err := mgr.Drain("executor-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-1", "worker-2"}, remaining)
+	require.Equal(t, []*Victim{{WorkerID: "worker-1", MasterID: "master-1", ExecutorID: "executor-1"}}, victims)
+
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, workers[1:], model.Running))
+	remaining, victims, err = mgr.Drain("executor-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-2"}, remaining)
+	require.Empty(t, victims)
+}
+
+func TestCapRescMgrUpdateWorkload(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, nil)
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+	err := mgr.UpdateWorkload("executor-3", nil)
+	require.True(t, errors.ErrUnknownExecutorID.Equal(err))
+
+	// the total usage of the workloads is counted as used cpu
+	require.NoError(t, mgr.UpdateWorkload("executor-1", map[model.WorkloadType]model.RescUnit{
+		model.Benchmark: 30,
+		model.DM:        20,
+	}))
+	require.Equal(t, model.Resource{CPU: 50}, mgr.executors["executor-1"].Usage())
+	ok, resp := mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-1", 10, nil)})
+	require.True(t, ok)
+	require.Equal(t, "executor-2", resp.Schedule[1].ExecutorId)
+	mgr.Release([]string{"worker-1"})
+
+	// the larger usage in heartbeat takes precedence
+	require.NoError(t, mgr.Update("executor-2", model.Resource{CPU: 80}, nil, model.Running))
+	require.Equal(t, model.Resource{CPU: 80}, mgr.executors["executor-2"].Usage())
+	ok, resp = mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-1", 30, nil)})
+	require.True(t, ok)
+	require.Equal(t, "executor-1", resp.Schedule[1].ExecutorId)
+	ok, _ = mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-2", 60, nil)})
+	require.False(t, ok)
+
+	// a worker fits after the workloads shrink
+	require.NoError(t, mgr.UpdateWorkload("executor-1", map[model.WorkloadType]model.RescUnit{
+		model.Benchmark: 10,
+	}))
+	ok, resp = mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-2", 60, nil)})
+	require.True(t, ok)
+	require.Equal(t, "executor-1", resp.Schedule[1].ExecutorId)
+}
+
+func TestCapRescMgrAllocateWorkerType(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	// executor-1 is of an old version that doesn't advertise its worker types.
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, []int64{1, 2})
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+
+	// a typed worker is only placed in the executor supporting the type
+	for i, workerID := range []string{"worker-1", "worker-2"} {
+		task := newTaskWithResource(workerID, 10, nil)
+		task.Task.Id = int64(i + 1)
+		task.WorkerType = 2
+		ok, resp := mgr.Allocate([]*pb.ScheduleTask{task})
+		require.True(t, ok)
+		require.Equal(t, "executor-2", resp.Schedule[task.Task.Id].ExecutorId)
+	}
+	task := newTaskWithResource("worker-3", 10, nil)
+	task.WorkerType = 3
+	ok, _ := mgr.Allocate([]*pb.ScheduleTask{task})
+	require.False(t, ok)
+
+	// an untyped worker is placed in any executor
+	ok, resp := mgr.Allocate([]*pb.ScheduleTask{newTaskWithResource("worker-4", 10, nil)})
+	require.True(t, ok)
+	require.Equal(t, "executor-1", resp.Schedule[1].ExecutorId)
+}
@@ -1,23 +1,73 @@
 package resource
 
 import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
 	"github.com/hanfei1991/microcosm/model"
 	"github.com/hanfei1991/microcosm/pb"
 )
 
 // RescMgr manages the resources of the clusters.
 type RescMgr interface {
-	// Register registers new executor, it is called when an executor joins
-	Register(id model.ExecutorID, addr string, capacity model.RescUnit)
+	// Register registers new executor, it is called when an executor joins.
+	// workerTypes are the types of the workers that the executor can run.
+	Register(
+		id model.ExecutorID, addr string, capacity model.Resource,
+		labels map[string]string, workerTypes []int64,
+	)
 
 	// Unregister is called when an executor exits
 	Unregister(id model.ExecutorID)
 
-	// Allocate allocates executor resources to given tasks
+	// Allocate allocates executor resources to given tasks independently, it
+	// returns false if any of the tasks is not allocated, and the response
+	// carries the allocated ones.
 	Allocate(tasks []*pb.ScheduleTask) (bool, *pb.TaskSchedulerResponse)
 
-	// Update updates executor resource usage and running status
-	Update(id model.ExecutorID, used, reserved model.RescUnit, status model.ExecutorStatus) error
+	// AllocateGang allocates executor resources to either all of the tasks or
+	// none of them. If the resources are not enough, the request waits in a
+	// queue until they are or wait has passed.
+	AllocateGang(ctx context.Context, tasks []*pb.ScheduleTask, wait time.Duration) (bool, *pb.TaskSchedulerResponse)
+
+	// Update updates executor resource usage and running status, and
+	// reconciles the reserved resource with the workers running in the
+	// executor. The reservations of the running workers are rebuilt if they
+	// are missing, e.g. after the server master fails over.
+	Update(id model.ExecutorID, used model.Resource, workers []*pb.RunningWorker, status model.ExecutorStatus) error
+
+	// UpdateWorkload updates the resource usage of each workload type that
+	// the executor reports.
+	UpdateWorkload(id model.ExecutorID, workloads map[model.WorkloadType]model.RescUnit) error
+
+	// Release releases the resource reserved for the workers
+	Release(workerIDs []string)
+
+	// Preempt chooses the running workers of lower priority to be preempted,
+	// so that the tasks can be allocated after the workers exit. It returns
+	// false if the tasks can't be allocated even if the workers are preempted.
+	Preempt(tasks []*pb.ScheduleTask) ([]*Victim, bool)
+
+	// Cordon stops allocating resource in the executor, or resumes it if
+	// cordoned is false.
+	Cordon(id model.ExecutorID, cordoned bool) error
+
+	// Drain cordons the executor, and returns the workers still in it, which
+	// are the ones reported in the last heartbeat and the ones being
+	// dispatched. The running workers that have not been asked to exit are
+	// returned as victims, their masters should be asked to move them
+	// elsewhere.
+	Drain(id model.ExecutorID) (remaining []string, victims []*Victim, err error)
+
+	// Rebalance chooses the running workers to be moved from the executors
+	// of high cpu utilization to the ones of low utilization.
+	Rebalance(opts RebalanceOptions) []*Migration
+
+	// Executors returns a copy of the resource of the executors ordered by
+	// ID, or all of the executors if ids is empty.
+	Executors(ids []model.ExecutorID) ([]*ExecutorResource, error)
 }
 
 type ExecutorResource struct {
@@ -25,12 +75,106 @@ type ExecutorResource struct {
 	Status model.ExecutorStatus
 
 	// Capacity of the resource in this executor.
-	Capacity model.RescUnit
+	Capacity model.Resource
 	// Reserved resource in this node, meaning the max resource possible to use.
-	// It's supposed to be the total cost of running tasks in this executor.
-	Reserved model.RescUnit
+	// It's the total cost of the tasks allocated to this executor, which is
+	// reserved at allocation and released after the tasks exit.
+	Reserved model.Resource
 	// Actually used resource in this node. It's supposed to be less than the reserved resource.
 	// But if the estimated reserved is not accurate, `Used` might be larger than `Reserved`.
-	Used model.RescUnit
-	Addr string
+	Used model.Resource
+	// Workloads is the cpu usage of each workload type reported by the
+	// executor, the total of it is counted as used cpu.
+	Workloads map[model.WorkloadType]model.RescUnit
+	Addr      string
+	Labels    map[string]string
+	// WorkerTypes are the types of the workers that the executor can run.
+	WorkerTypes []int64
+	// Cordoned executors are not allocated to new tasks, for maintenance.
+	Cordoned bool
+	// Workers are the IDs of the workers running in the executor, as the
+	// executor reported in the last heartbeat.
+	Workers []string
+}
+
+// Host returns the host of the executor, which is the host part of its
+// address, or the address itself if it has no port. The executor is taken as
+// a host of its own if the address is unknown.
+func (e *ExecutorResource) Host() string {
+	if e.Addr == "" {
+		return string(e.ID)
+	}
+	if host, _, err := net.SplitHostPort(e.Addr); err == nil {
+		return host
+	}
+	return e.Addr
+}
+
+// MatchLocation returns whether the executor is at the location, which is an
+// executor ID, an address, a host or a label in the form of "key=value".
+func (e *ExecutorResource) MatchLocation(location string) bool {
+	if location == string(e.ID) || location == e.Addr || location == e.Host() {
+		return true
+	}
+	if kv := strings.SplitN(location, "=", 2); len(kv) == 2 {
+		value, ok := e.Labels[kv[0]]
+		return ok && value == kv[1]
+	}
+	return false
+}
+
+// MatchLabels returns whether the executor has all of the labels.
+func (e *ExecutorResource) MatchLabels(labels map[string]string) bool {
+	for k, v := range labels {
+		if value, ok := e.Labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// SupportsWorkerType returns whether the executor can run the workers of the
+// type, any executor supports the tasks that don't specify the type.
+func (e *ExecutorResource) SupportsWorkerType(tp int64) bool {
+	if tp == 0 {
+		return true
+	}
+	for _, supported := range e.WorkerTypes {
+		if supported == tp {
+			return true
+		}
+	}
+	return false
+}
+
+// clone returns a deep copy of the executor resource.
+func (e *ExecutorResource) clone() *ExecutorResource {
+	ret := *e
+	ret.Workloads = make(map[model.WorkloadType]model.RescUnit, len(e.Workloads))
+	for tp, usage := range e.Workloads {
+		ret.Workloads[tp] = usage
+	}
+	ret.Labels = make(map[string]string, len(e.Labels))
+	for k, v := range e.Labels {
+		ret.Labels[k] = v
+	}
+	ret.WorkerTypes = append([]int64(nil), e.WorkerTypes...)
+	ret.Workers = append([]string(nil), e.Workers...)
+	return &ret
+}
+
+// Usage returns the actually used resource, whose cpu is the larger one of
+// the usage in heartbeat and the total usage of the workloads.
+func (e *ExecutorResource) Usage() model.Resource {
+	var workloads model.RescUnit
+	for _, usage := range e.Workloads {
+		workloads += usage
+	}
+	return e.Used.Max(model.Resource{CPU: workloads})
+}
+
+// Available returns the resource that can be allocated in each dimension,
+// which is the capacity minus the larger one of the used and the reserved.
+func (e *ExecutorResource) Available() model.Resource {
+	return e.Capacity.Sub(e.Usage().Max(e.Reserved))
 }
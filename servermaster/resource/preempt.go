@@ -0,0 +1,170 @@
+package resource
+
+import (
+	"sort"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+)
+
+// Victim is a running worker chosen to be preempted or to be moved out of a
+// drained executor, its master is asked to stop it.
+type Victim struct {
+	WorkerID   string
+	MasterID   string
+	ExecutorID model.ExecutorID
+	Priority   int32
+	// PreemptedBy is the worker that the victim is preempted for, it's empty
+	// if the victim is drained.
+	PreemptedBy string
+}
+
+// preemptionPlan is the victims in an executor that make room for a task.
+type preemptionPlan struct {
+	task    *pb.ScheduleTask
+	exec    *ExecutorResource
+	victims []*reservation
+}
+
+// maxPriority returns the highest priority of the victims.
+func (p *preemptionPlan) maxPriority() int32 {
+	var ret int32
+	for i, r := range p.victims {
+		if i == 0 || r.priority > ret {
+			ret = r.priority
+		}
+	}
+	return ret
+}
+
+// betterThan returns whether p preempts workers of lower priority than
+// other, or fewer workers of the same priority.
+func (p *preemptionPlan) betterThan(other *preemptionPlan) bool {
+	if len(p.victims) == 0 || len(other.victims) == 0 {
+		return len(p.victims) < len(other.victims)
+	}
+	if p.maxPriority() != other.maxPriority() {
+		return p.maxPriority() < other.maxPriority()
+	}
+	return len(p.victims) < len(other.victims)
+}
+
+// Preempt implements RescMgr.Preempt
+func (m *CapRescMgr) Preempt(tasks []*pb.ScheduleTask) ([]*Victim, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// freed is the resource of the victims chosen for the previous tasks, and
+	// pending is the reservations of the previous tasks.
+	freed := make(map[model.ExecutorID]model.Resource)
+	pending := make(map[model.ExecutorID]model.Resource)
+	chosen := make(map[string]struct{})
+	plans := make([]*preemptionPlan, 0, len(tasks))
+	for _, task := range tasks {
+		cost := model.NewResourceFromPB(task.Resource, task.Cost)
+		var best *preemptionPlan
+		for _, exec := range m.preemptionCandidatesLocked(task) {
+			available := exec.Available().Add(freed[exec.ID]).Sub(pending[exec.ID])
+			plan := &preemptionPlan{task: task, exec: exec}
+			for _, r := range m.preemptibleLocked(exec.ID, task.GetPriority(), chosen) {
+				if cost.Fits(available) {
+					break
+				}
+				plan.victims = append(plan.victims, r)
+				available = available.Add(r.resource)
+			}
+			if !cost.Fits(available) {
+				continue
+			}
+			if best == nil || plan.betterThan(best) {
+				best = plan
+			}
+		}
+		if best == nil {
+			return nil, false
+		}
+		for _, r := range best.victims {
+			chosen[r.key] = struct{}{}
+			freed[best.exec.ID] = freed[best.exec.ID].Add(r.resource)
+		}
+		pending[best.exec.ID] = pending[best.exec.ID].Add(cost)
+		plans = append(plans, best)
+	}
+
+	now := m.clock.Now()
+	victims := make([]*Victim, 0, len(chosen))
+	for _, plan := range plans {
+		for _, r := range plan.victims {
+			r.evictedAt = now
+			victims = append(victims, &Victim{
+				WorkerID:    r.key,
+				MasterID:    r.masterID,
+				ExecutorID:  r.executorID,
+				Priority:    r.priority,
+				PreemptedBy: plan.task.GetWorkerId(),
+			})
+			log.L().Info("worker is chosen to be preempted",
+				zap.String("worker-id", r.key), zap.String("master-id", r.masterID),
+				zap.String("executor-id", string(r.executorID)), zap.Int32("priority", r.priority))
+		}
+	}
+	return victims, true
+}
+
+// preemptionCandidatesLocked returns the running executors that support the
+// worker type, and meet the label selector and the required location of the
+// task, ordered by executor ID. The preferences of the task are ignored in preemption.
+func (m *CapRescMgr) preemptionCandidatesLocked(task *pb.ScheduleTask) []*ExecutorResource {
+	ret := make([]*ExecutorResource, 0, len(m.executors))
+	for _, exec := range m.executors {
+		if exec.Status != model.Running || exec.Cordoned {
+			continue
+		}
+		if !exec.MatchLabels(task.GetLabelSelector()) || !exec.SupportsWorkerType(task.GetWorkerType()) {
+			continue
+		}
+		if task.GetLocationRequired() && !exec.MatchLocation(task.GetPreferredLocation()) {
+			continue
+		}
+		ret = append(ret, exec)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret
+}
+
+// preemptibleLocked returns the running workers in the executor that can be
+// preempted by a task of the priority, the ones of the lowest priority and
+// then the most recently created ones come first.
+func (m *CapRescMgr) preemptibleLocked(
+	id model.ExecutorID, priority int32, chosen map[string]struct{},
+) []*reservation {
+	now := m.clock.Now()
+	ret := make([]*reservation, 0)
+	for key, r := range m.reservations {
+		if r.executorID != id || r.nonPreemptible || r.priority >= priority {
+			continue
+		}
+		if _, ok := chosen[key]; ok {
+			continue
+		}
+		// It's not running yet, or it's being preempted by another task.
+		if !r.evictable(now, m.reservationTTL) {
+			continue
+		}
+		ret = append(ret, r)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].priority != ret[j].priority {
+			return ret[i].priority < ret[j].priority
+		}
+		if !ret[i].createdAt.Equal(ret[j].createdAt) {
+			return ret[i].createdAt.After(ret[j].createdAt)
+		}
+		return ret[i].key < ret[j].key
+	})
+	return ret
+}
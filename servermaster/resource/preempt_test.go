@@ -0,0 +1,133 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTaskWithPriority(workerID string, cost int64, priority int32) *pb.ScheduleTask {
+	return &pb.ScheduleTask{
+		Task: &pb.TaskRequest{Id: 1}, Cost: cost, WorkerId: workerID, MasterId: "master", Priority: priority,
+	}
+}
+
+func TestCapRescMgrPreempt(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mockClock := clock.NewMock()
+	mgr.clock = mockClock
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, nil)
+	for _, id := range []model.ExecutorID{"executor-1", "executor-2"} {
+		require.NoError(t, mgr.Update(id, model.Resource{}, nil, model.Running))
+	}
+	allocate := func(executorID string, task *pb.ScheduleTask) {
+		task.PreferredLocation = executorID
+		task.LocationRequired = true
+		ok, _ := mgr.Allocate([]*pb.ScheduleTask{task})
+		require.True(t, ok)
+		mockClock.Add(time.Second)
+	}
+	jobMaster := newTaskWithPriority("job-master", 20, -10)
+	jobMaster.NonPreemptible = true
+	allocate("executor-1", jobMaster)
+	allocate("executor-1", newTaskWithPriority("backfill-0", 40, -10))
+	allocate("executor-1", newTaskWithPriority("backfill-1", 40, -10))
+	allocate("executor-2", newTaskWithPriority("normal-0", 60, 0))
+	allocate("executor-2", newTaskWithPriority("normal-1", 40, 0))
+
+	// the workers are not preempted before they're running
+	_, ok := mgr.Preempt([]*pb.ScheduleTask{newTaskWithPriority("task-0", 30, 0)})
+	require.False(t, ok)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{},
+		runningWorkers("job-master", "backfill-0", "backfill-1"), model.Running))
+	require.NoError(t, mgr.Update("executor-2", model.Resource{},
+		runningWorkers("normal-0", "normal-1"), model.Running))
+
+	// the most recently created worker of the lowest priority is preempted
+	victims, ok := mgr.Preempt([]*pb.ScheduleTask{newTaskWithPriority("task-0", 30, 0)})
+	require.True(t, ok)
+	require.Equal(t, []*Victim{{
+		WorkerID: "backfill-1", MasterID: "master", ExecutorID: "executor-1", Priority: -10, PreemptedBy: "task-0",
+	}}, victims)
+	// the worker being preempted is not chosen again
+	victims, ok = mgr.Preempt([]*pb.ScheduleTask{newTaskWithPriority("task-1", 30, 0)})
+	require.True(t, ok)
+	require.Len(t, victims, 1)
+	require.Equal(t, "backfill-0", victims[0].WorkerID)
+	// neither the non-preemptible workers nor the ones of the same priority
+	// are preempted
+	_, ok = mgr.Preempt([]*pb.ScheduleTask{newTaskWithPriority("task-2", 30, 0)})
+	require.False(t, ok)
+	// a task of higher priority preempts as many workers as it needs
+	victims, ok = mgr.Preempt([]*pb.ScheduleTask{newTaskWithPriority("task-3", 70, 10)})
+	require.True(t, ok)
+	require.Len(t, victims, 2)
+	require.Equal(t, "normal-1", victims[0].WorkerID)
+	require.Equal(t, "normal-0", victims[1].WorkerID)
+
+	// the task is allocated after the preempted worker exits
+	done := make(chan *pb.TaskSchedulerResponse)
+	go func() {
+		ok, resp := mgr.AllocateGang(context.Background(),
+			[]*pb.ScheduleTask{newTaskWithPriority("task-0", 30, 0)}, time.Minute)
+		require.True(t, ok)
+		done <- resp
+	}()
+	require.Eventually(t, func() bool {
+		mgr.mu.Lock()
+		defer mgr.mu.Unlock()
+		return len(mgr.waiters) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{},
+		runningWorkers("job-master", "backfill-0"), model.Running))
+	resp := <-done
+	require.Equal(t, "executor-1", resp.Schedule[1].ExecutorId)
+
+	// the worker can be chosen again if it's still running after a while
+	mockClock.Add(defaultReservationTTL * 2)
+	victims, ok = mgr.Preempt([]*pb.ScheduleTask{newTaskWithPriority("task-1", 30, 0)})
+	require.True(t, ok)
+	require.Len(t, victims, 1)
+	require.Equal(t, "backfill-0", victims[0].WorkerID)
+}
+
+func TestCapRescMgrGangPriority(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, nil, model.Running))
+	ok, _ := mgr.Allocate([]*pb.ScheduleTask{newTaskWithPriority("running", 100, 0)})
+	require.True(t, ok)
+
+	waiters := func() int {
+		mgr.mu.Lock()
+		defer mgr.mu.Unlock()
+		return len(mgr.waiters)
+	}
+	results := make(chan string, 2)
+	wait := func(workerID string, priority int32) {
+		ok, _ := mgr.AllocateGang(context.Background(),
+			[]*pb.ScheduleTask{newTaskWithPriority(workerID, 100, priority)}, time.Minute)
+		require.True(t, ok)
+		results <- workerID
+	}
+	go wait("low", -10)
+	require.Eventually(t, func() bool { return waiters() == 1 }, time.Second, 10*time.Millisecond)
+	go wait("high", 10)
+	require.Eventually(t, func() bool { return waiters() == 2 }, time.Second, 10*time.Millisecond)
+
+	// the request of higher priority goes first although it comes later
+	mgr.Release([]string{"running"})
+	require.Equal(t, "high", <-results)
+	mgr.Release([]string{"high"})
+	require.Equal(t, "low", <-results)
+}
@@ -0,0 +1,142 @@
+package resource
+
+import (
+	"sort"
+
+	"github.com/hanfei1991/microcosm/model"
+)
+
+// Migration moves a running worker from a hot executor to a cold one.
+type Migration struct {
+	WorkerID string
+	MasterID string
+	From     model.ExecutorID
+	To       model.ExecutorID
+}
+
+// RebalanceOptions controls a round of rebalancing.
+type RebalanceOptions struct {
+	// Threshold is the difference of cpu utilization between the hottest
+	// and the coldest executors, above which the workers are moved. The
+	// utilization is the larger one of the used and the reserved cpu
+	// divided by the capacity.
+	Threshold float64
+	// MaxMigrations limits how many workers are moved in the round.
+	MaxMigrations int
+	// DryRun plans the migrations without marking the workers as being
+	// moved, so that they can be chosen again in the next round.
+	DryRun bool
+}
+
+// Rebalance implements RescMgr.Rebalance. The workers are moved one by one
+// from the hottest executor to the coldest one, until the difference of their
+// utilization is not above the threshold. The job masters, the workers that
+// are required to run in specific executors and the ones being evicted are
+// not moved.
+func (m *CapRescMgr) Rebalance(opts RebalanceOptions) []*Migration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	execs := make([]*ExecutorResource, 0, len(m.executors))
+	used := make(map[model.ExecutorID]model.RescUnit, len(m.executors))
+	for _, exec := range m.executors {
+		if exec.Status != model.Running || exec.Cordoned || exec.Capacity.CPU <= 0 {
+			continue
+		}
+		execs = append(execs, exec)
+		// The usage of the workers that don't report their workloads is
+		// unknown, the resource reserved for them is counted instead.
+		used[exec.ID] = exec.Usage().Max(exec.Reserved).CPU
+	}
+	if len(execs) < 2 {
+		return nil
+	}
+	sort.Slice(execs, func(i, j int) bool {
+		return execs[i].ID < execs[j].ID
+	})
+	utilization := func(exec *ExecutorResource, delta model.RescUnit) float64 {
+		return float64(used[exec.ID]+delta) / float64(exec.Capacity.CPU)
+	}
+
+	// pending is the resource of the workers moved to the executors.
+	pending := make(map[model.ExecutorID]model.Resource)
+	chosen := make(map[string]struct{})
+	migrations := make([]*Migration, 0)
+	for len(migrations) < opts.MaxMigrations {
+		hot, cold := execs[0], execs[0]
+		for _, exec := range execs[1:] {
+			if utilization(exec, 0) > utilization(hot, 0) {
+				hot = exec
+			}
+			if utilization(exec, 0) < utilization(cold, 0) {
+				cold = exec
+			}
+		}
+		if utilization(hot, 0)-utilization(cold, 0) <= opts.Threshold {
+			break
+		}
+		// The largest worker that lowers the utilization of the hottest
+		// executor without making the coldest one hotter than it is moved.
+		available := cold.Available().Sub(pending[cold.ID])
+		var moved *reservation
+		for _, r := range m.movableLocked(hot.ID, chosen) {
+			if !cold.SupportsWorkerType(r.workerType) {
+				continue
+			}
+			if r.resource.Fits(available) && utilization(cold, r.resource.CPU) < utilization(hot, 0) {
+				moved = r
+				break
+			}
+		}
+		if moved == nil {
+			break
+		}
+		chosen[moved.key] = struct{}{}
+		used[hot.ID] -= moved.resource.CPU
+		if used[hot.ID] < 0 {
+			used[hot.ID] = 0
+		}
+		used[cold.ID] += moved.resource.CPU
+		pending[cold.ID] = pending[cold.ID].Add(moved.resource)
+		migrations = append(migrations, &Migration{
+			WorkerID: moved.key,
+			MasterID: moved.masterID,
+			From:     hot.ID,
+			To:       cold.ID,
+		})
+	}
+
+	if !opts.DryRun {
+		now := m.clock.Now()
+		for key := range chosen {
+			m.reservations[key].evictedAt = now
+		}
+	}
+	return migrations
+}
+
+// movableLocked returns the running workers in the executor that can be moved
+// for rebalancing, the ones of the most resource come first.
+func (m *CapRescMgr) movableLocked(id model.ExecutorID, chosen map[string]struct{}) []*reservation {
+	now := m.clock.Now()
+	ret := make([]*reservation, 0)
+	for key, r := range m.reservations {
+		if r.executorID != id || r.anonymous || r.nonPreemptible || r.pinned {
+			continue
+		}
+		if _, ok := chosen[key]; ok {
+			continue
+		}
+		if !r.evictable(now, m.reservationTTL) || r.resource.CPU <= 0 {
+			continue
+		}
+		ret = append(ret, r)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].resource.CPU != ret[j].resource.CPU {
+			return ret[i].resource.CPU > ret[j].resource.CPU
+		}
+		return ret[i].key < ret[j].key
+	})
+	return ret
+}
@@ -0,0 +1,86 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapRescMgrRebalance(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mockClock := clock.NewMock()
+	mgr.clock = mockClock
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, nil, model.Running))
+	workers := []string{"worker-0", "worker-1", "worker-2", "worker-3"}
+	for _, workerID := range workers {
+		ok, _ := mgr.Allocate([]*pb.ScheduleTask{newTaskWithPriority(workerID, 20, 0)})
+		require.True(t, ok)
+	}
+	jobMaster := newTaskWithPriority("job-master", 10, 0)
+	jobMaster.NonPreemptible = true
+	pinned := newTaskWithPriority("pinned", 10, 0)
+	pinned.PreferredLocation = "executor-1"
+	pinned.LocationRequired = true
+	ok, _ := mgr.Allocate([]*pb.ScheduleTask{jobMaster, pinned})
+	require.True(t, ok)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{CPU: 100},
+		runningWorkers(append([]string{"job-master", "pinned"}, workers...)...), model.Running))
+
+	// nothing to do in a cluster of one executor
+	require.Empty(t, mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 10}))
+
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, nil)
+	require.NoError(t, mgr.Update("executor-2", model.Resource{}, nil, model.Running))
+	expected := []*Migration{
+		{WorkerID: "worker-0", MasterID: "master", From: "executor-1", To: "executor-2"},
+		{WorkerID: "worker-1", MasterID: "master", From: "executor-1", To: "executor-2"},
+	}
+	// the workers are moved until the utilization is balanced
+	require.Equal(t, expected, mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 10, DryRun: true}))
+	require.Equal(t, expected[:1], mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 1, DryRun: true}))
+	require.Empty(t, mgr.Rebalance(RebalanceOptions{Threshold: 1, MaxMigrations: 10}))
+
+	// the workers being moved are not chosen again until they're evictable
+	require.Equal(t, expected, mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 10}))
+	migrations := mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 10})
+	require.Len(t, migrations, 2)
+	require.Equal(t, "worker-2", migrations[0].WorkerID)
+	require.Equal(t, "worker-3", migrations[1].WorkerID)
+	// neither the job master nor the pinned worker is moved
+	require.Empty(t, mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 10}))
+
+	// the utilization is balanced after the workers are moved
+	mockClock.Add(defaultReservationTTL * 2)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{CPU: 60},
+		runningWorkers("job-master", "pinned", "worker-2", "worker-3"), model.Running))
+	require.NoError(t, mgr.Update("executor-2", model.Resource{CPU: 40}, nil, model.Running))
+	require.Empty(t, mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 10}))
+}
+
+func TestCapRescMgrRebalanceReserved(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, nil, model.Running))
+	workers := []string{"worker-0", "worker-1", "worker-2"}
+	for _, workerID := range workers {
+		ok, _ := mgr.Allocate([]*pb.ScheduleTask{newTaskWithPriority(workerID, 30, 0)})
+		require.True(t, ok)
+	}
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, nil)
+
+	// the workers don't report their usage, so the executors are balanced by
+	// the reserved resource
+	require.NoError(t, mgr.Update("executor-1", model.Resource{}, runningWorkers(workers...), model.Running))
+	require.NoError(t, mgr.Update("executor-2", model.Resource{}, nil, model.Running))
+	require.Equal(t, []*Migration{
+		{WorkerID: "worker-0", MasterID: "master", From: "executor-1", To: "executor-2"},
+	}, mgr.Rebalance(RebalanceOptions{Threshold: 0.2, MaxMigrations: 10}))
+}
@@ -0,0 +1,105 @@
+package resource
+
+import (
+	"math/rand"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+)
+
+// Names of the built-in scheduling strategies.
+const (
+	// StrategySpread schedules a task to the least loaded executor, it's
+	// suitable for the latency-sensitive jobs.
+	StrategySpread = "spread"
+	// StrategyBinPack schedules a task to the most loaded executor that still
+	// fits, so that fewer executors are needed.
+	StrategyBinPack = "bin-pack"
+	// StrategyRandom schedules a task to a random executor that fits.
+	StrategyRandom = "random"
+
+	DefaultStrategy = StrategySpread
+)
+
+// Candidate is an executor that has enough resource for a task.
+type Candidate struct {
+	*ExecutorResource
+	// Available is the resource available in the executor, the resource
+	// allocated to the previous tasks of the same request is deducted.
+	Available model.Resource
+}
+
+// load returns the ratio of the resource in use, which is the max ratio of
+// the dimensions.
+func (c *Candidate) load() float64 {
+	inUse := c.Capacity.Sub(c.Available)
+	var ret float64
+	for _, dim := range [][2]int64{
+		{int64(inUse.CPU), int64(c.Capacity.CPU)},
+		{inUse.Memory, c.Capacity.Memory},
+		{inUse.Disk, c.Capacity.Disk},
+	} {
+		if dim[1] <= 0 {
+			continue
+		}
+		if ratio := float64(dim[0]) / float64(dim[1]); ratio > ret {
+			ret = ratio
+		}
+	}
+	return ret
+}
+
+// SchedulingStrategy picks an executor for a task.
+type SchedulingStrategy interface {
+	// Pick picks one of the candidates, the candidates are not empty and
+	// ordered by executor ID.
+	Pick(task *pb.ScheduleTask, candidates []*Candidate) *Candidate
+}
+
+// NewSchedulingStrategy creates a built-in SchedulingStrategy by name.
+func NewSchedulingStrategy(name string) (SchedulingStrategy, error) {
+	switch name {
+	case StrategySpread:
+		return spreadStrategy{}, nil
+	case StrategyBinPack:
+		return binPackStrategy{}, nil
+	case StrategyRandom:
+		return randomStrategy{}, nil
+	default:
+		return nil, errors.ErrUnknownSchedulingStrategy.GenWithStackByArgs(name)
+	}
+}
+
+type spreadStrategy struct{}
+
+// Pick implements SchedulingStrategy.Pick
+func (spreadStrategy) Pick(_ *pb.ScheduleTask, candidates []*Candidate) *Candidate {
+	ret := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.load() < ret.load() {
+			ret = c
+		}
+	}
+	return ret
+}
+
+type binPackStrategy struct{}
+
+// Pick implements SchedulingStrategy.Pick
+func (binPackStrategy) Pick(_ *pb.ScheduleTask, candidates []*Candidate) *Candidate {
+	ret := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.load() > ret.load() {
+			ret = c
+		}
+	}
+	return ret
+}
+
+type randomStrategy struct{}
+
+// Pick implements SchedulingStrategy.Pick
+func (randomStrategy) Pick(_ *pb.ScheduleTask, candidates []*Candidate) *Candidate {
+	return candidates[rand.Intn(len(candidates))]
+}
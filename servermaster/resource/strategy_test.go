@@ -0,0 +1,63 @@
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulingStrategies(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSchedulingStrategy("round-robin")
+	require.True(t, errors.ErrUnknownSchedulingStrategy.Equal(err))
+
+	mgr := NewCapRescMgr(spreadStrategy{})
+	mgr.Register("executor-1", "127.0.0.1:10001", model.Resource{CPU: 100}, nil, nil)
+	mgr.Register("executor-2", "127.0.0.1:10002", model.Resource{CPU: 100}, nil, nil)
+	mgr.Register("executor-3", "127.0.0.1:10003", model.Resource{CPU: 100}, nil, nil)
+	require.NoError(t, mgr.Update("executor-1", model.Resource{CPU: 50}, nil, model.Running))
+	require.NoError(t, mgr.Update("executor-2", model.Resource{CPU: 20}, nil, model.Running))
+	require.NoError(t, mgr.Update("executor-3", model.Resource{CPU: 80}, nil, model.Running))
+
+	// allocate releases the reserved resource after the allocation, so that
+	// every allocation starts from the same state.
+	allocate := func(strategy string, costs ...int64) []string {
+		tasks := make([]*pb.ScheduleTask, 0, len(costs))
+		workerIDs := make([]string, 0, len(costs))
+		for i, cost := range costs {
+			workerID := fmt.Sprintf("worker-%d", i)
+			tasks = append(tasks, &pb.ScheduleTask{
+				Task:     &pb.TaskRequest{Id: int64(i)},
+				Cost:     cost,
+				Strategy: strategy,
+				WorkerId: workerID,
+			})
+			workerIDs = append(workerIDs, workerID)
+		}
+		ok, resp := mgr.Allocate(tasks)
+		require.True(t, ok)
+		mgr.Release(workerIDs)
+		ret := make([]string, 0, len(costs))
+		for i := range costs {
+			ret = append(ret, resp.Schedule[int64(i)].ExecutorId)
+		}
+		return ret
+	}
+
+	// the default strategy of the cluster is spread
+	require.Equal(t, []string{"executor-2", "executor-2"}, allocate("", 20, 20))
+	require.Equal(t, []string{"executor-2", "executor-2", "executor-1"}, allocate(StrategySpread, 20, 20, 10))
+	// bin-pack picks the most loaded executor that still fits
+	require.Equal(t, []string{"executor-3", "executor-1"}, allocate(StrategyBinPack, 20, 30))
+	for i := 0; i < 10; i++ {
+		require.Len(t, allocate(StrategyRandom, 20, 20), 2)
+	}
+
+	ok, _ := mgr.Allocate([]*pb.ScheduleTask{{Task: &pb.TaskRequest{Id: 0}, Cost: 1, Strategy: "round-robin"}})
+	require.False(t, ok)
+}
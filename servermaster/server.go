@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -19,10 +20,12 @@ import (
 	dcontext "github.com/hanfei1991/microcosm/pkg/context"
 	"github.com/hanfei1991/microcosm/pkg/errors"
 	"github.com/hanfei1991/microcosm/pkg/etcdutils"
+	"github.com/hanfei1991/microcosm/pkg/ha"
 	"github.com/hanfei1991/microcosm/pkg/metadata"
 	"github.com/hanfei1991/microcosm/pkg/p2p"
 	"github.com/hanfei1991/microcosm/pkg/serverutils"
 	"github.com/hanfei1991/microcosm/servermaster/cluster"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
 	"github.com/hanfei1991/microcosm/test"
 	"github.com/hanfei1991/microcosm/test/mock"
 	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
@@ -79,9 +82,21 @@ type Server struct {
 	testCtx *test.Context
 }
 
+// maxGangWaitTimeout limits how long a gang request waits for resource, the
+// waiting request holds a gRPC call.
+const maxGangWaitTimeout = time.Minute
+
 // NewServer creates a new master-server.
 func NewServer(cfg *Config, ctx *test.Context) (*Server, error) {
-	executorManager := NewExecutorManagerImpl(cfg.KeepAliveTTL, cfg.KeepAliveInterval, ctx)
+	strategyName := cfg.SchedulingStrategy
+	if strategyName == "" {
+		strategyName = resource.DefaultStrategy
+	}
+	strategy, err := resource.NewSchedulingStrategy(strategyName)
+	if err != nil {
+		return nil, err
+	}
+	executorManager := NewExecutorManagerImpl(cfg.KeepAliveTTL, cfg.KeepAliveInterval, strategy, ctx)
 
 	urls, err := parseURLs(cfg.MasterAddr)
 	if err != nil {
@@ -180,6 +195,108 @@ func (s *Server) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.C
 	return s.jobManager.CancelJob(ctx, req), nil
 }
 
+func (s *Server) QueryJob(ctx context.Context, req *pb.QueryJobRequest) (*pb.QueryJobResponse, error) {
+	var (
+		resp2 *pb.QueryJobResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	err := s.apiPreCheck()
+	if err != nil {
+		return &pb.QueryJobResponse{Err: err}, nil
+	}
+	return s.jobManager.QueryJob(ctx, req), nil
+}
+
+func (s *Server) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	var (
+		resp2 *pb.ListJobsResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	err := s.apiPreCheck()
+	if err != nil {
+		return &pb.ListJobsResponse{Err: err}, nil
+	}
+	return s.jobManager.ListJobs(ctx, req), nil
+}
+
+func (s *Server) UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) (*pb.UpdateJobConfigResponse, error) {
+	var (
+		resp2 *pb.UpdateJobConfigResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	err := s.apiPreCheck()
+	if err != nil {
+		return &pb.UpdateJobConfigResponse{Err: err}, nil
+	}
+	return s.jobManager.UpdateJobConfig(ctx, req), nil
+}
+
+func (s *Server) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.CreateScheduleResponse, error) {
+	var (
+		resp2 *pb.CreateScheduleResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	err := s.apiPreCheck()
+	if err != nil {
+		return &pb.CreateScheduleResponse{Err: err}, nil
+	}
+	return s.jobManager.CreateSchedule(ctx, req), nil
+}
+
+func (s *Server) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*pb.DeleteScheduleResponse, error) {
+	var (
+		resp2 *pb.DeleteScheduleResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	err := s.apiPreCheck()
+	if err != nil {
+		return &pb.DeleteScheduleResponse{Err: err}, nil
+	}
+	return s.jobManager.DeleteSchedule(ctx, req), nil
+}
+
+func (s *Server) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	var (
+		resp2 *pb.ListSchedulesResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	err := s.apiPreCheck()
+	if err != nil {
+		return &pb.ListSchedulesResponse{Err: err}, nil
+	}
+	return s.jobManager.ListSchedules(ctx, req), nil
+}
+
 func (s *Server) PauseJob(ctx context.Context, req *pb.PauseJobRequest) (*pb.PauseJobResponse, error) {
 	var (
 		resp2 *pb.PauseJobResponse
@@ -197,6 +314,23 @@ func (s *Server) PauseJob(ctx context.Context, req *pb.PauseJobRequest) (*pb.Pau
 	return s.jobManager.PauseJob(ctx, req), nil
 }
 
+func (s *Server) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (*pb.ResumeJobResponse, error) {
+	var (
+		resp2 *pb.ResumeJobResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	err := s.apiPreCheck()
+	if err != nil {
+		return &pb.ResumeJobResponse{Err: err}, nil
+	}
+	return s.jobManager.ResumeJob(ctx, req), nil
+}
+
 // RegisterExecutor implements grpc interface, and passes request onto executor manager.
 func (s *Server) RegisterExecutor(ctx context.Context, req *pb.RegisterExecutorRequest) (*pb.RegisterExecutorResponse, error) {
 	var (
@@ -246,9 +380,172 @@ func (s *Server) ScheduleTask(ctx context.Context, req *pb.TaskSchedulerRequest)
 	}
 
 	tasks := req.GetTasks()
-	success, resp := s.executorManager.Allocate(tasks)
+	for _, task := range tasks {
+		if name := task.GetStrategy(); name != "" {
+			if _, err := resource.NewSchedulingStrategy(name); err != nil {
+				return &pb.TaskSchedulerResponse{Err: errors.ToPBError(err)}, nil
+			}
+		}
+	}
+	return s.scheduleTasks(ctx, req), nil
+}
+
+// scheduleTasks allocates resource for the tasks, the workers of lower
+// priority are preempted if the resource is not enough. Nothing is reserved
+// if not all the tasks are allocated.
+func (s *Server) scheduleTasks(ctx context.Context, req *pb.TaskSchedulerRequest) *pb.TaskSchedulerResponse {
+	var (
+		success bool
+		resp    *pb.TaskSchedulerResponse
+	)
+	tasks := req.GetTasks()
+	if req.GetGang() {
+		wait := time.Duration(req.GetWaitTimeoutMs()) * time.Millisecond
+		if wait > maxGangWaitTimeout {
+			wait = maxGangWaitTimeout
+		}
+		success, resp = s.executorManager.AllocateGang(ctx, tasks, wait)
+	} else {
+		success, resp = s.executorManager.Allocate(tasks)
+	}
 	if !success {
-		return nil, errors.ErrClusterResourceNotEnough.GenWithStackByArgs()
+		success, resp = s.preempt(ctx, tasks, resp)
+	}
+	if success {
+		return resp
+	}
+	// The caller takes the whole request as failed, so the tasks allocated
+	// partially are released, otherwise their resource leaks.
+	allocated := resp.GetSchedule()
+	workerIDs := make([]string, 0, len(allocated))
+	for _, task := range tasks {
+		if _, ok := allocated[task.GetTask().Id]; ok && task.GetWorkerId() != "" {
+			workerIDs = append(workerIDs, task.GetWorkerId())
+		}
+	}
+	if len(workerIDs) > 0 {
+		s.executorManager.ReleaseResource(workerIDs)
+	}
+	// The error is returned in the response instead of as a gRPC error,
+	// so that the caller can tell it by the error code.
+	err := errors.ErrClusterResourceNotEnough.GenWithStackByArgs()
+	return &pb.TaskSchedulerResponse{Err: errors.ToPBError(err)}
+}
+
+// ReleaseResource implements pb interface.
+func (s *Server) ReleaseResource(ctx context.Context, req *pb.ReleaseResourceRequest) (*pb.ReleaseResourceResponse, error) {
+	var (
+		resp2 *pb.ReleaseResourceResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	checkErr := s.apiPreCheck()
+	if checkErr != nil {
+		return &pb.ReleaseResourceResponse{Err: checkErr}, nil
+	}
+	s.executorManager.ReleaseResource(req.GetWorkerIds())
+	return &pb.ReleaseResourceResponse{}, nil
+}
+
+// CordonExecutor implements pb interface.
+func (s *Server) CordonExecutor(ctx context.Context, req *pb.CordonExecutorRequest) (*pb.CordonExecutorResponse, error) {
+	var (
+		resp2 *pb.CordonExecutorResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	checkErr := s.apiPreCheck()
+	if checkErr != nil {
+		return &pb.CordonExecutorResponse{Err: checkErr}, nil
+	}
+	err := s.executorManager.CordonExecutor(model.ExecutorID(req.GetExecutorId()), !req.GetUncordon())
+	if err != nil {
+		return &pb.CordonExecutorResponse{Err: errors.ToPBError(err)}, nil
+	}
+	return &pb.CordonExecutorResponse{}, nil
+}
+
+// DrainExecutor implements pb interface. The job masters are asked to move
+// their workers out of the executor, it's called repeatedly to report the
+// progress until the executor is empty.
+func (s *Server) DrainExecutor(ctx context.Context, req *pb.DrainExecutorRequest) (*pb.DrainExecutorResponse, error) {
+	var (
+		resp2 *pb.DrainExecutorResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	checkErr := s.apiPreCheck()
+	if checkErr != nil {
+		return &pb.DrainExecutorResponse{Err: checkErr}, nil
+	}
+	remaining, victims, err := s.executorManager.DrainExecutor(model.ExecutorID(req.GetExecutorId()))
+	if err != nil {
+		return &pb.DrainExecutorResponse{Err: errors.ToPBError(err)}, nil
+	}
+	for _, victim := range victims {
+		if err := s.notifyMigration(ctx, victim); err != nil {
+			log.L().Warn("failed to notify migration",
+				zap.String("worker-id", victim.WorkerID),
+				zap.String("master-id", victim.MasterID), zap.Error(err))
+		}
+	}
+	return &pb.DrainExecutorResponse{RemainingWorkers: remaining}, nil
+}
+
+// QueryExecutors implements pb interface.
+func (s *Server) QueryExecutors(ctx context.Context, req *pb.QueryExecutorsRequest) (*pb.QueryExecutorsResponse, error) {
+	var (
+		resp2 *pb.QueryExecutorsResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	checkErr := s.apiPreCheck()
+	if checkErr != nil {
+		return &pb.QueryExecutorsResponse{Err: checkErr}, nil
+	}
+	ids := make([]model.ExecutorID, 0, len(req.GetExecutorIds()))
+	for _, id := range req.GetExecutorIds() {
+		ids = append(ids, model.ExecutorID(id))
+	}
+	execs, err := s.executorManager.QueryExecutors(ids)
+	if err != nil {
+		return &pb.QueryExecutorsResponse{Err: errors.ToPBError(err)}, nil
+	}
+	resp := &pb.QueryExecutorsResponse{Executors: make([]*pb.ExecutorResource, 0, len(execs))}
+	for _, exec := range execs {
+		workloads := make([]*pb.ExecWorkload, 0, len(exec.Workloads))
+		for tp, usage := range exec.Workloads {
+			workloads = append(workloads, &pb.ExecWorkload{Tp: pb.JobType(tp), Usage: int32(usage)})
+		}
+		sort.Slice(workloads, func(i, j int) bool {
+			return workloads[i].Tp < workloads[j].Tp
+		})
+		resp.Executors = append(resp.Executors, &pb.ExecutorResource{
+			ExecutorId: string(exec.ID),
+			Address:    exec.Addr,
+			Capacity:   exec.Capacity.ToPB(),
+			Reserved:   exec.Reserved.ToPB(),
+			Used:       exec.Usage().ToPB(),
+			Workloads:  workloads,
+			Cordoned:   exec.Cordoned,
+			WorkerIds:  exec.Workers,
+		})
 	}
 	return resp, nil
 }
@@ -293,10 +590,26 @@ func (s *Server) QueryMetaStore(
 func (s *Server) ReportExecutorWorkload(
 	ctx context.Context, req *pb.ExecWorkloadRequest,
 ) (*pb.ExecWorkloadResponse, error) {
-	// TODO: pass executor workload to resource manager
-	log.L().Debug("receive workload report", zap.String("executor", req.ExecutorId))
+	var (
+		resp2 *pb.ExecWorkloadResponse
+		err2  error
+	)
+	shouldRet := s.rpcForwardIfNeeded(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	checkErr := s.apiPreCheck()
+	if checkErr != nil {
+		return &pb.ExecWorkloadResponse{Err: checkErr}, nil
+	}
+	workloads := make(map[model.WorkloadType]model.RescUnit, len(req.GetWorkloads()))
 	for _, res := range req.GetWorkloads() {
-		log.L().Debug("workload", zap.Int32("type", int32(res.GetTp())), zap.Int32("usage", res.GetUsage()))
+		workloads[model.WorkloadType(res.GetTp())] += model.RescUnit(res.GetUsage())
+	}
+	err := s.executorManager.UpdateExecutorWorkload(model.ExecutorID(req.GetExecutorId()), workloads)
+	if err != nil {
+		return &pb.ExecWorkloadResponse{Err: errors.ToPBError(err)}, nil
 	}
 	return &pb.ExecWorkloadResponse{}, nil
 }
@@ -473,6 +786,7 @@ func (s *Server) reset(ctx context.Context) error {
 
 func (s *Server) runLeaderService(ctx context.Context) (err error) {
 	// rebuild states from existing meta if needed
+	s.executorManager.SetHAStore(ha.NewMetaStore(metadata.NewMetaEtcd(s.etcdClient)))
 	err = s.resetExecutor(ctx)
 	if err != nil {
 		return
@@ -480,6 +794,9 @@ func (s *Server) runLeaderService(ctx context.Context) (err error) {
 
 	// start background managers
 	s.executorManager.Start(ctx)
+	if s.cfg.Rebalance.Enable {
+		newRebalancer(&s.cfg.Rebalance, s.executorManager, s.notifyRebalance).Start(ctx)
+	}
 
 	clients := client.NewClientManager()
 	err = clients.AddMasterClient(ctx, []string{s.cfg.MasterAddr})
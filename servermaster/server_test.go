@@ -11,9 +11,14 @@ import (
 	"time"
 
 	"github.com/hanfei1991/microcosm/client"
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pb"
+	"github.com/hanfei1991/microcosm/pkg/version"
+	"github.com/hanfei1991/microcosm/servermaster/resource"
 	"github.com/phayes/freeport"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 )
 
 func init() {
@@ -211,3 +216,127 @@ func TestRunLeaderService(t *testing.T) {
 	cancel()
 	wg.Wait()
 }
+
+func TestScheduleTasksReleasePartialAllocation(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := resource.NewSchedulingStrategy(resource.DefaultStrategy)
+	require.Nil(t, err)
+	mgr := NewExecutorManagerImpl(time.Minute, time.Second, strategy, nil)
+	info, err := mgr.AllocateNewExec(&pb.RegisterExecutorRequest{
+		Address: "127.0.0.1:10001", Capability: 100, ProtocolVersion: version.ProtocolVersion,
+	})
+	require.Nil(t, err)
+	resp, err := mgr.HandleHeartbeat(&pb.HeartbeatRequest{
+		ExecutorId: string(info.ID),
+		Status:     int32(model.Running),
+		Ttl:        uint64(time.Minute.Milliseconds()),
+	})
+	require.Nil(t, err)
+	require.Nil(t, resp.Err)
+
+	s := &Server{executorManager: mgr}
+	ctx := context.Background()
+	newTask := func(id int64, workerID string, cost int64) *pb.ScheduleTask {
+		return &pb.ScheduleTask{Task: &pb.TaskRequest{Id: id}, Cost: cost, WorkerId: workerID}
+	}
+
+	// worker-1 fits but worker-2 doesn't, and there is nothing to preempt.
+	schedResp := s.scheduleTasks(ctx, &pb.TaskSchedulerRequest{
+		Tasks: []*pb.ScheduleTask{newTask(1, "worker-1", 60), newTask(2, "worker-2", 60)},
+	})
+	require.Equal(t, pb.ErrorCode_NotEnoughResource, schedResp.Err.Code)
+	require.Empty(t, schedResp.Schedule)
+
+	// The resource reserved for worker-1 has been released.
+	schedResp = s.scheduleTasks(ctx, &pb.TaskSchedulerRequest{
+		Tasks: []*pb.ScheduleTask{newTask(3, "worker-3", 100)},
+	})
+	require.Nil(t, schedResp.Err)
+	require.Equal(t, string(info.ID), schedResp.Schedule[3].ExecutorId)
+}
+
+func TestPreemptionWait(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, preemptionWaitTimeout, preemptionWait(context.Background()))
+
+	// The response is sent before the master's request times out.
+	ctx, cancel := context.WithTimeout(context.Background(), preemptionWaitTimeout)
+	defer cancel()
+	wait := preemptionWait(ctx)
+	require.Greater(t, wait, time.Duration(0))
+	require.LessOrEqual(t, wait, preemptionWaitTimeout-preemptionWaitMargin)
+
+	ctx, cancel = context.WithTimeout(context.Background(), preemptionWaitMargin/2)
+	defer cancel()
+	require.LessOrEqual(t, preemptionWait(ctx), time.Duration(0))
+}
+
+func TestQueryExecutors(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := resource.NewSchedulingStrategy(resource.DefaultStrategy)
+	require.Nil(t, err)
+	mgr := NewExecutorManagerImpl(time.Minute, time.Second, strategy, nil)
+	ids := make([]string, 0, 2)
+	for _, addr := range []string{"127.0.0.1:10001", "127.0.0.1:10002"} {
+		info, err := mgr.AllocateNewExec(&pb.RegisterExecutorRequest{
+			Address: addr, Capability: 100, ProtocolVersion: version.ProtocolVersion,
+		})
+		require.Nil(t, err)
+		ids = append(ids, string(info.ID))
+	}
+	_, err = mgr.HandleHeartbeat(&pb.HeartbeatRequest{
+		ExecutorId:    ids[0],
+		Status:        int32(model.Running),
+		ResourceUsage: 10,
+		Ttl:           uint64(time.Minute.Milliseconds()),
+		Workers: []*pb.RunningWorker{
+			{WorkerId: "worker-1", MasterId: "master-1", Resource: &pb.Resource{Cpu: 40}},
+		},
+	})
+	require.Nil(t, err)
+
+	cfg := NewConfig()
+	cfg.Etcd.Name = "server-master-1"
+	s := &Server{cfg: cfg, executorManager: mgr, rpcLogRL: rate.NewLimiter(rate.Inf, 1)}
+	s.leader.Store(&Member{Name: s.name()})
+	s.initialized.Store(true)
+	ctx := context.Background()
+
+	// the usage of each workload type is returned
+	workloadResp, err := s.ReportExecutorWorkload(ctx, &pb.ExecWorkloadRequest{
+		ExecutorId: ids[0],
+		Workloads: []*pb.ExecWorkload{
+			{Tp: pb.JobType_DM, Usage: 20},
+			{Tp: pb.JobType_CVSDemo, Usage: 10},
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, workloadResp.Err)
+	resp, err := s.QueryExecutors(ctx, &pb.QueryExecutorsRequest{ExecutorIds: ids[:1]})
+	require.Nil(t, err)
+	require.Nil(t, resp.Err)
+	require.Equal(t, []*pb.ExecutorResource{{
+		ExecutorId: ids[0],
+		Address:    "127.0.0.1:10001",
+		Capacity:   &pb.Resource{Cpu: 100},
+		Reserved:   &pb.Resource{Cpu: 40},
+		Used:       &pb.Resource{Cpu: 30},
+		Workloads: []*pb.ExecWorkload{
+			{Tp: pb.JobType_CVSDemo, Usage: 10},
+			{Tp: pb.JobType_DM, Usage: 20},
+		},
+		WorkerIds: []string{"worker-1"},
+	}}, resp.Executors)
+
+	// all of the executors are returned if none is specified
+	resp, err = s.QueryExecutors(ctx, &pb.QueryExecutorsRequest{})
+	require.Nil(t, err)
+	require.Len(t, resp.Executors, 2)
+
+	resp, err = s.QueryExecutors(ctx, &pb.QueryExecutorsRequest{ExecutorIds: []string{"executor-3"}})
+	require.Nil(t, err)
+	require.Equal(t, pb.ErrorCode_UnknownExecutor, resp.Err.Code)
+}
@@ -0,0 +1,27 @@
+package servermaster
+
+import (
+	"github.com/hanfei1991/microcosm/model"
+	"github.com/hanfei1991/microcosm/pkg/errors"
+	"github.com/hanfei1991/microcosm/pkg/version"
+)
+
+// compatibilityMatrix lists the protocol versions of the executors that the
+// server master of each protocol version works with. In a rolling upgrade the
+// server masters are upgraded before the executors, so a protocol version
+// should list the previous one as long as the messages are compatible.
+var compatibilityMatrix = map[int32][]int32{
+	1: {1},
+}
+
+// checkExecutorVersion returns ErrIncompatibleVersion if the executor can't
+// work with the server master.
+func checkExecutorVersion(info *model.NodeInfo) error {
+	for _, v := range compatibilityMatrix[version.ProtocolVersion] {
+		if v == info.ProtocolVersion {
+			return nil
+		}
+	}
+	return errors.ErrIncompatibleVersion.GenWithStackByArgs(
+		info.Version, info.ProtocolVersion, version.ProtocolVersion)
+}
@@ -51,14 +51,36 @@ func (s *masterServerConn) sendRequest(ctx context.Context, req interface{}) (in
 		return s.server.RegisterExecutor(ctx, x)
 	case *pb.PauseJobRequest:
 		return s.server.PauseJob(ctx, x)
+	case *pb.ResumeJobRequest:
+		return s.server.ResumeJob(ctx, x)
 	case *pb.SubmitJobRequest:
 		return s.server.SubmitJob(ctx, x)
 	case *pb.HeartbeatRequest:
 		return s.server.Heartbeat(ctx, x)
 	case *pb.TaskSchedulerRequest:
 		return s.server.ScheduleTask(ctx, x)
+	case *pb.ReleaseResourceRequest:
+		return s.server.ReleaseResource(ctx, x)
 	case *pb.CancelJobRequest:
 		return s.server.CancelJob(ctx, x)
+	case *pb.QueryJobRequest:
+		return s.server.QueryJob(ctx, x)
+	case *pb.ListJobsRequest:
+		return s.server.ListJobs(ctx, x)
+	case *pb.UpdateJobConfigRequest:
+		return s.server.UpdateJobConfig(ctx, x)
+	case *pb.CreateScheduleRequest:
+		return s.server.CreateSchedule(ctx, x)
+	case *pb.DeleteScheduleRequest:
+		return s.server.DeleteSchedule(ctx, x)
+	case *pb.ListSchedulesRequest:
+		return s.server.ListSchedules(ctx, x)
+	case *pb.CordonExecutorRequest:
+		return s.server.CordonExecutor(ctx, x)
+	case *pb.DrainExecutorRequest:
+		return s.server.DrainExecutor(ctx, x)
+	case *pb.QueryExecutorsRequest:
+		return s.server.QueryExecutors(ctx, x)
 	}
 	return nil, errors.New("unknown request")
 }
@@ -77,6 +99,11 @@ func (c *masterServerClient) PauseJob(ctx context.Context, req *pb.PauseJobReque
 	return resp.(*pb.PauseJobResponse), err
 }
 
+func (c *masterServerClient) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest, opts ...grpc.CallOption) (*pb.ResumeJobResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	return resp.(*pb.ResumeJobResponse), err
+}
+
 func (c *masterServerClient) SubmitJob(ctx context.Context, req *pb.SubmitJobRequest, opts ...grpc.CallOption) (*pb.SubmitJobResponse, error) {
 	resp, err := c.conn.sendRequest(ctx, req)
 	return resp.(*pb.SubmitJobResponse), err
@@ -87,6 +114,36 @@ func (c *masterServerClient) CancelJob(ctx context.Context, req *pb.CancelJobReq
 	return resp.(*pb.CancelJobResponse), err
 }
 
+func (c *masterServerClient) QueryJob(ctx context.Context, req *pb.QueryJobRequest, opts ...grpc.CallOption) (*pb.QueryJobResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	return resp.(*pb.QueryJobResponse), err
+}
+
+func (c *masterServerClient) ListJobs(ctx context.Context, req *pb.ListJobsRequest, opts ...grpc.CallOption) (*pb.ListJobsResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	return resp.(*pb.ListJobsResponse), err
+}
+
+func (c *masterServerClient) UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest, opts ...grpc.CallOption) (*pb.UpdateJobConfigResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	return resp.(*pb.UpdateJobConfigResponse), err
+}
+
+func (c *masterServerClient) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest, opts ...grpc.CallOption) (*pb.CreateScheduleResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	return resp.(*pb.CreateScheduleResponse), err
+}
+
+func (c *masterServerClient) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest, opts ...grpc.CallOption) (*pb.DeleteScheduleResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	return resp.(*pb.DeleteScheduleResponse), err
+}
+
+func (c *masterServerClient) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest, opts ...grpc.CallOption) (*pb.ListSchedulesResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	return resp.(*pb.ListSchedulesResponse), err
+}
+
 func (c *masterServerClient) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest, opts ...grpc.CallOption) (*pb.HeartbeatResponse, error) {
 	resp, err := c.conn.sendRequest(ctx, req)
 	return resp.(*pb.HeartbeatResponse), err
@@ -100,6 +157,46 @@ func (c *masterServerClient) ScheduleTask(ctx context.Context, req *pb.TaskSched
 	return resp.(*pb.TaskSchedulerResponse), nil
 }
 
+func (c *masterServerClient) ReleaseResource(
+	ctx context.Context, req *pb.ReleaseResourceRequest, opts ...grpc.CallOption,
+) (*pb.ReleaseResourceResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.ReleaseResourceResponse), nil
+}
+
+func (c *masterServerClient) CordonExecutor(
+	ctx context.Context, req *pb.CordonExecutorRequest, opts ...grpc.CallOption,
+) (*pb.CordonExecutorResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.CordonExecutorResponse), nil
+}
+
+func (c *masterServerClient) DrainExecutor(
+	ctx context.Context, req *pb.DrainExecutorRequest, opts ...grpc.CallOption,
+) (*pb.DrainExecutorResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.DrainExecutorResponse), nil
+}
+
+func (c *masterServerClient) QueryExecutors(
+	ctx context.Context, req *pb.QueryExecutorsRequest, opts ...grpc.CallOption,
+) (*pb.QueryExecutorsResponse, error) {
+	resp, err := c.conn.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.QueryExecutorsResponse), nil
+}
+
 func (c *masterServerClient) RegisterMetaStore(
 	ctx context.Context, req *pb.RegisterMetaStoreRequest, opts ...grpc.CallOption,
 ) (*pb.RegisterMetaStoreResponse, error) {